@@ -9,8 +9,13 @@ import(
 	"./../../../common/deckDB/deckData"
 	"./../../../common/deckDB/nameNorm"
 
+	"time"
+
 )
 
+// The default window performance and consensus cover, ending now
+const defaultArchetypeRange = time.Duration(90 * 24) * time.Hour
+
 
 // Register deck data for entire archetypes
 func (s *DeckService) registerArchetype() {
@@ -41,6 +46,43 @@ func (s *DeckService) registerArchetype() {
 		Returns(http.StatusInternalServerError, deckDBError, nil).
 		Returns(http.StatusBadRequest, BadArchetype, nil).
 		Returns(http.StatusOK, "Tagged decklist with metadata", deckData.TaggedDeck{}))
+
+	server.Route(server.
+		GET("/Archetype/{archetypeName}/Performance").To(s.getArchetypePerformance).
+		// Docs
+		Doc("How well an archetype finished at major events over a window").
+		Operation("getArchetypePerformance").
+		Param(server.PathParameter("archetypeName",
+			"Name of a Modern archetype we support").DataType("string")).
+		Param(server.QueryParameter("from",
+			"Unix time the window starts, 90 days before to by default").DataType("integer")).
+		Param(server.QueryParameter("to",
+			"Unix time the window ends, now by default").DataType("integer")).
+		Writes(deckData.ArchetypePerformance{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusBadRequest, BadArchetype, nil).
+		Returns(http.StatusBadRequest, BadRange, nil).
+		Returns(http.StatusOK, "Top 8 conversion, wins, and average finish",
+			deckData.ArchetypePerformance{}))
+
+	server.Route(server.
+		GET("/Archetype/{archetypeName}/Consensus").To(s.getArchetypeConsensus).
+		// Docs
+		Doc("The typical 60 card maindeck and 15 card sideboard of an archetype").
+		Operation("getArchetypeConsensus").
+		Param(server.PathParameter("archetypeName",
+			"Name of a Modern archetype we support").DataType("string")).
+		Param(server.QueryParameter("from",
+			"Unix time the window starts, 90 days before to by default").DataType("integer")).
+		Param(server.QueryParameter("to",
+			"Unix time the window ends, now by default").DataType("integer")).
+		Writes(deckData.ConsensusDeck{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusBadRequest, BadArchetype, nil).
+		Returns(http.StatusBadRequest, BadRange, nil).
+		Returns(http.StatusNotFound, NoDecks, nil).
+		Returns(http.StatusOK, "Consensus list with flex slots flagged, Short under 60 cards",
+			deckData.ConsensusDeck{}))
 }
 
 func (s *DeckService) getArchetypeContents(req *restful.Request,
@@ -81,4 +123,60 @@ func (s *DeckService) getArchetypeLatest(req *restful.Request,
 
 	resp.WriteEntity(cards)
 
+}
+
+func (s *DeckService) getArchetypePerformance(req *restful.Request,
+	resp *restful.Response) {
+
+	a:= req.PathParameter("archetypeName")
+	if !nameNorm.Valid(a) {
+		resp.WriteErrorString(http.StatusBadRequest, BadArchetype)
+		return
+	}
+
+	from, to, err:= queryWindow(req, defaultArchetypeRange)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadRange)
+		return
+	}
+
+	p, err:= deckDB.GetArchetypePerformance(s.pool, a, from, to)
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(p)
+
+}
+
+func (s *DeckService) getArchetypeConsensus(req *restful.Request,
+	resp *restful.Response) {
+
+	a:= req.PathParameter("archetypeName")
+	if !nameNorm.Valid(a) {
+		resp.WriteErrorString(http.StatusBadRequest, BadArchetype)
+		return
+	}
+
+	from, to, err:= queryWindow(req, defaultArchetypeRange)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadRange)
+		return
+	}
+
+	c, err:= deckDB.GetArchetypeConsensus(s.pool, a, from, to)
+	if err==deckDB.ErrNoDecks {
+		resp.WriteErrorString(http.StatusNotFound, NoDecks)
+		return
+	}
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(c)
+
 }
\ No newline at end of file
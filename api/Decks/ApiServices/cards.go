@@ -0,0 +1,46 @@
+package ApiServices
+
+import(
+
+	"net/http"
+	"github.com/emicklei/go-restful"
+
+	"./../../../common/deckDB"
+	"./../../../common/deckDB/deckData"
+
+)
+
+// Register deck data for individual cards
+func (s *DeckService) registerCard() {
+
+	server:= s.Service
+
+
+	server.Route(server.
+		GET("/Card/{cardName}/Archetypes").To(s.getCardArchetypes).
+		// Docs
+		Doc("Archetypes a card has appeared in and how each plays it").
+		Operation("getCardArchetypes").
+		Param(server.PathParameter("cardName",
+			"Full name of a Magic card").DataType("string")).
+		Writes([]*deckData.CardArchetype{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusOK, "Archetypes, most widely played first",
+			[]*deckData.CardArchetype{}))
+}
+
+func (s *DeckService) getCardArchetypes(req *restful.Request,
+	resp *restful.Response) {
+
+	c:= req.PathParameter("cardName")
+
+	archetypes, err:= deckDB.GetCardArchetypeStats(s.pool, c)
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(archetypes)
+
+}
@@ -13,6 +13,13 @@ import(
 // Unpleasant Responses
 const deckDBError string = "Deck DB lookup failed"
 const BadArchetype string = "Unknown Archetype"
+const BadPeriod string = "Unknown period, expected week or month"
+const BadRange string = "Invalid range, expected unix times with from before to"
+const BadTop string = "Invalid top, must be positive"
+const BadEvent string = "Unknown Event"
+const BadPage string = "Invalid page, limit must be from 1 to 100 and offset not negative"
+const NoDecks string = "No decks found in that range"
+const BadBoard string = "Unknown board, expected any, main, or side"
 
 type DeckService struct{
 	pool *pgx.ConnPool
@@ -60,6 +67,8 @@ func (s *DeckService) register() error {
 	s.registerMeta()
 	s.registerDeck()
 	s.registerArchetype()
+	s.registerCard()
+	s.registerEvent()
 
 	return nil
 }
\ No newline at end of file
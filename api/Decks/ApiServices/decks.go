@@ -7,11 +7,15 @@ import(
 
 	"./../../../common/deckDB"
 	"./../../../common/deckDB/deckData"
+	"./../../../common/deckDB/nameNorm"
 
 	"fmt"
 
 )
 
+// How many decks a page of results holds when no limit is asked for
+const defaultSearchPage int = 20
+
 
 // Register deck data for entire archetypes
 func (s *DeckService) registerDeck() {
@@ -30,6 +34,42 @@ func (s *DeckService) registerDeck() {
 		Returns(http.StatusInternalServerError, deckDBError, nil).
 		Returns(http.StatusBadRequest, BadArchetype, nil).
 		Returns(http.StatusOK, "Deck object", deckData.Deck{}))
+
+	server.Route(server.
+		GET("/Decks").To(s.searchDecks).
+		// Docs
+		Doc("Decks from major events matching a search, most recent first").
+		Operation("searchDecks").
+		Param(server.QueryParameter("include",
+			"A card every deck must play, may be repeated").DataType("string")).
+		Param(server.QueryParameter("exclude",
+			"A card no deck may play, may be repeated").DataType("string")).
+		Param(server.QueryParameter("includeBoard",
+			"Where included cards are looked for, any, main, or side. any by default").DataType("string")).
+		Param(server.QueryParameter("excludeBoard",
+			"Where excluded cards are looked for, any, main, or side. any by default").DataType("string")).
+		Param(server.QueryParameter("archetype",
+			"Name of a Modern archetype we support").DataType("string")).
+		Param(server.QueryParameter("player",
+			"Only decks whose player's name contains this, ignoring case").DataType("string")).
+		Param(server.QueryParameter("event",
+			"Only decks placing in this event").DataType("string")).
+		Param(server.QueryParameter("from",
+			"Only decks from events happening at or after this unix time").DataType("integer")).
+		Param(server.QueryParameter("to",
+			"Only decks from events happening before this unix time").DataType("integer")).
+		Param(server.QueryParameter("limit",
+			"The most decks per page, up to 100. 20 by default").DataType("integer")).
+		Param(server.QueryParameter("offset",
+			"How many matching decks to skip").DataType("integer")).
+		Writes([]*deckData.DeckSummary{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusBadRequest, BadArchetype, nil).
+		Returns(http.StatusBadRequest, BadBoard, nil).
+		Returns(http.StatusBadRequest, BadRange, nil).
+		Returns(http.StatusBadRequest, BadPage, nil).
+		Returns(http.StatusOK, "Decks without their contents",
+			[]*deckData.DeckSummary{}))
 }
 
 func (s *DeckService) getDeck(req *restful.Request,
@@ -47,4 +87,57 @@ func (s *DeckService) getDeck(req *restful.Request,
 
 	resp.WriteEntity(d)
 
+}
+
+func (s *DeckService) searchDecks(req *restful.Request,
+	resp *restful.Response) {
+
+	// Card names can hold commas so lists are repeated parameters
+	values:= req.Request.URL.Query()
+
+	q:= deckDB.DeckSearch{
+		Include: values["include"],
+		Exclude: values["exclude"],
+		IncludeBoard: req.QueryParameter("includeBoard"),
+		ExcludeBoard: req.QueryParameter("excludeBoard"),
+		Archetype: req.QueryParameter("archetype"),
+		Player: req.QueryParameter("player"),
+		EventID: req.QueryParameter("event"),
+		Limit: defaultSearchPage,
+	}
+
+	for _, board:= range []string{q.IncludeBoard, q.ExcludeBoard}{
+		if board != "" && !deckDB.ValidBoard(board) {
+			resp.WriteErrorString(http.StatusBadRequest, BadBoard)
+			return
+		}
+	}
+
+	if q.Archetype != "" && !nameNorm.Valid(q.Archetype) {
+		resp.WriteErrorString(http.StatusBadRequest, BadArchetype)
+		return
+	}
+
+	var err error
+	q.From, q.To, err = queryBounds(req)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadRange)
+		return
+	}
+
+	q.Limit, q.Offset, err = queryPage(req, q.Limit, deckDB.MaxSearchPage)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPage)
+		return
+	}
+
+	decks, err:= deckDB.SearchDecks(s.pool, q)
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(decks)
+
 }
\ No newline at end of file
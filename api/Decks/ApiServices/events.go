@@ -0,0 +1,106 @@
+package ApiServices
+
+import(
+
+	"net/http"
+	"github.com/emicklei/go-restful"
+
+	"./../../../common/deckDB"
+	"./../../../common/deckDB/deckData"
+
+)
+
+// How many events a page holds when no limit is asked for
+const defaultEventPage int = 20
+
+// Register deck data for entire events
+func (s *DeckService) registerEvent() {
+
+	server:= s.Service
+
+
+	server.Route(server.
+		GET("/Events").To(s.getEvents).
+		// Docs
+		Doc("Major events, most recent first").
+		Operation("getEvents").
+		Param(server.QueryParameter("from",
+			"Only events happening at or after this unix time").DataType("integer")).
+		Param(server.QueryParameter("to",
+			"Only events happening before this unix time").DataType("integer")).
+		Param(server.QueryParameter("name",
+			"Only events whose name contains this, ignoring case").DataType("string")).
+		Param(server.QueryParameter("limit",
+			"The most events per page, up to 100. 20 by default").DataType("integer")).
+		Param(server.QueryParameter("offset",
+			"How many matching events to skip").DataType("integer")).
+		Writes([]*deckData.Event{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusBadRequest, BadRange, nil).
+		Returns(http.StatusBadRequest, BadPage, nil).
+		Returns(http.StatusOK, "Events without their decks", []*deckData.Event{}))
+
+	server.Route(server.
+		GET("/Event/{eventID}").To(s.getEvent).
+		// Docs
+		Doc("A major event with every deck that placed in it").
+		Operation("getEvent").
+		Param(server.PathParameter("eventID",
+			"A valid event identifier").DataType("string")).
+		Writes(deckData.Event{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusNotFound, BadEvent, nil).
+		Returns(http.StatusOK, "Event with named decks", deckData.Event{}))
+}
+
+func (s *DeckService) getEvents(req *restful.Request,
+	resp *restful.Response) {
+
+	q:= deckDB.EventQuery{
+		Name: req.QueryParameter("name"),
+		Limit: defaultEventPage,
+	}
+
+	var err error
+	q.From, q.To, err = queryBounds(req)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadRange)
+		return
+	}
+
+	q.Limit, q.Offset, err = queryPage(req, q.Limit, deckDB.MaxEventPage)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPage)
+		return
+	}
+
+	events, err:= deckDB.ListEvents(s.pool, q)
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(events)
+
+}
+
+func (s *DeckService) getEvent(req *restful.Request,
+	resp *restful.Response) {
+
+	eventid:= req.PathParameter("eventID")
+
+	e, err:= deckDB.GetEvent(s.pool, eventid)
+	if err==deckDB.ErrNoEvent {
+		resp.WriteErrorString(http.StatusNotFound, BadEvent)
+		return
+	}
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(e)
+
+}
@@ -6,8 +6,11 @@ import(
 	"log"
 	"os"
 	"fmt"
+	"strconv"
+	"time"
 
 	"net/http"
+	"github.com/emicklei/go-restful"
 
 )
 
@@ -35,4 +38,90 @@ func GetLogger(fName, name string) (aLogger *log.Logger) {
 	aLogger = log.New(multi, name, log.Ldate|log.Ltime|log.Lshortfile)
 
 	return
+}
+
+// Acquires the window a request covers from its 'from' and 'to' unix
+// time parameters. 'to' defaults to now and 'from' to span before 'to'.
+func queryWindow(req *restful.Request,
+	span time.Duration) (from, to time.Time, err error) {
+
+	to = time.Now()
+	if raw:= req.QueryParameter("to"); raw != "" {
+		unix, err:= strconv.ParseInt(raw, 10, 64)
+		if err!=nil {
+			return from, to, err
+		}
+		to = time.Unix(unix, 0)
+	}
+
+	from = to.Add(-span)
+	if raw:= req.QueryParameter("from"); raw != "" {
+		unix, err:= strconv.ParseInt(raw, 10, 64)
+		if err!=nil {
+			return from, to, err
+		}
+		from = time.Unix(unix, 0)
+	}
+
+	if !from.Before(to) {
+		return from, to, fmt.Errorf("from not before to")
+	}
+
+	return from, to, nil
+
+}
+
+// Acquires optional bounds from a request's 'from' and 'to' unix time
+// parameters, each zero when unset.
+func queryBounds(req *restful.Request) (from, to time.Time, err error) {
+
+	for param, t:= range map[string]*time.Time{"from": &from, "to": &to}{
+		raw:= req.QueryParameter(param)
+		if raw == "" {
+			continue
+		}
+
+		unix, err:= strconv.ParseInt(raw, 10, 64)
+		if err!=nil {
+			return from, to, err
+		}
+		*t = time.Unix(unix, 0)
+	}
+
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return from, to, fmt.Errorf("from not before to")
+	}
+
+	return from, to, nil
+
+}
+
+// Acquires the page a request asks for from its 'limit' and 'offset'
+// parameters, limit defaulting to fallback and never exceeding max.
+func queryPage(req *restful.Request,
+	fallback, max int) (limit, offset int, err error) {
+
+	limit = fallback
+	if raw:= req.QueryParameter("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err!=nil {
+			return limit, offset, err
+		}
+		if limit < 1 || limit > max {
+			return limit, offset, fmt.Errorf("limit out of range")
+		}
+	}
+
+	if raw:= req.QueryParameter("offset"); raw != "" {
+		offset, err = strconv.Atoi(raw)
+		if err!=nil {
+			return limit, offset, err
+		}
+		if offset < 0 {
+			return limit, offset, fmt.Errorf("negative offset")
+		}
+	}
+
+	return limit, offset, nil
+
 }
\ No newline at end of file
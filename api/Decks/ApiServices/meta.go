@@ -5,10 +5,18 @@ import(
 	"net/http"
 	"github.com/emicklei/go-restful"
 
+	"./../../../common/deckDB"
+	"./../../../common/deckDB/deckData"
 	"./../../../common/deckDB/nameNorm"
 
+	"strconv"
+	"time"
+
 )
 
+// The default range a metagame covers, ending now
+const defaultMetagameRange = time.Duration(365 * 24) * time.Hour
+
 // Register deck data for entire archetypes
 func (s *DeckService) registerMeta() {
 	
@@ -22,6 +30,26 @@ func (s *DeckService) registerMeta() {
 		Operation("getArchetypeList").
 		Writes([]string{}).
 		Returns(http.StatusOK, "All available archetypes", []string{}))
+
+	server.Route(server.
+		GET("/Metagame").To(s.getMetagame).
+		// Docs
+		Doc("Each archetype's share of decks placing at major events per period").
+		Operation("getMetagame").
+		Param(server.QueryParameter("period",
+			"week or month, the default").DataType("string")).
+		Param(server.QueryParameter("from",
+			"Unix time the range starts, a year before it ends by default").DataType("integer")).
+		Param(server.QueryParameter("to",
+			"Unix time the range ends, now by default").DataType("integer")).
+		Param(server.QueryParameter("top",
+			"Only name this many of the most played archetypes, counting the rest as Other. Every archetype by default").DataType("integer")).
+		Writes([]*deckData.MetaPeriod{}).
+		Returns(http.StatusInternalServerError, deckDBError, nil).
+		Returns(http.StatusBadRequest, BadPeriod, nil).
+		Returns(http.StatusBadRequest, BadRange, nil).
+		Returns(http.StatusBadRequest, BadTop, nil).
+		Returns(http.StatusOK, "Periods, oldest first", []*deckData.MetaPeriod{}))
 }
 
 func (s *DeckService) getArchetypeList(req *restful.Request,
@@ -29,4 +57,42 @@ func (s *DeckService) getArchetypeList(req *restful.Request,
 
 	resp.WriteEntity(nameNorm.Names())
 
-}
\ No newline at end of file
+}
+
+func (s *DeckService) getMetagame(req *restful.Request,
+	resp *restful.Response) {
+
+	period:= req.QueryParameter("period")
+	if period == "" {
+		period = deckDB.PeriodMonth
+	}
+	if !deckDB.ValidPeriod(period) {
+		resp.WriteErrorString(http.StatusBadRequest, BadPeriod)
+		return
+	}
+
+	from, to, err:= queryWindow(req, defaultMetagameRange)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadRange)
+		return
+	}
+
+	top:= 0
+	if raw:= req.QueryParameter("top"); raw != "" {
+		top, err = strconv.Atoi(raw)
+		if err!=nil || top < 1 {
+			resp.WriteErrorString(http.StatusBadRequest, BadTop)
+			return
+		}
+	}
+
+	periods, err:= deckDB.GetMetagame(s.pool, period, from, to, top)
+	if err!=nil {
+		s.logger.Println(err)
+		resp.WriteErrorString(http.StatusInternalServerError, deckDBError)
+		return
+	}
+
+	resp.WriteEntity(periods)
+
+}
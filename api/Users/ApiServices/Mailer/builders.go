@@ -2,19 +2,29 @@ package mailer
 
 import(
 
-	"github.com/mailgun/mailgun-go"
-
-	"text/template"
-
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 )
 
-// A mailgun compatiable, send only client.
+// Which Sender a mailer's metadata selects
+const BackendMailgun string = "mailgun"
+const BackendSMTP string = "smtp"
+const BackendFile string = "file"
+
+// A send only client that fills templates and hands the results
+// to a Sender.
 type Mailer struct{
-	gun mailgun.Mailgun
+	sender Sender
 	source string // The address this mailer sends from
-	Templates map[string]*template.Template
+	// Template id to locale to body, see Prepare
+	Templates map[string]map[string]*Template
+}
+
+// Creates a mailer delivering through the provided sender.
+func NewMailer(sender Sender, sendingAddress string) *Mailer {
+	templateContainer:= make(map[string]map[string]*Template)
+	return &Mailer{sender, sendingAddress, templateContainer}
 }
 
 // Creates a mailgun client that we can use.
@@ -22,28 +32,43 @@ type Mailer struct{
 // Priv and public are the keys handed out by mailgun.
 // Domain is the domain assigned to the keypair.
 func GetMailer(priv, pub, domain, sendingAddress string) *Mailer {
-	gun:= mailgun.NewMailgun(domain, priv, pub)
-	templateContainer:= make(map[string]*template.Template)
-	return &Mailer{gun, sendingAddress, templateContainer}
+	return NewMailer(NewMailgunSender(priv, pub, domain), sendingAddress)
 }
 
 // Acquires a mailer metadata from a file located on disk.
 //
-// Must be json encoded and match the format of MailGunMeta
+// Must be json encoded and match the format of MailerMeta
 func GetMailerFromFile(loc string) (*Mailer, error) {
 	metaRaw, err:= ioutil.ReadFile(loc)
 	if err!=nil {
 		return nil, err
 	}
 
-	var meta MailGunMeta
+	var meta MailerMeta
 	err = json.Unmarshal(metaRaw, &meta)
 	if err!=nil {
 		return nil, err	
 	}
 
-	mailer:= GetMailer(meta.PrivateKey, meta.PublicKey,
-		meta.Domain, meta.SendingAddress)
+	var sender Sender
+	switch meta.Backend {
+	// Metadata predating backends is always mailgun
+	case BackendMailgun, "":
+		sender = NewMailgunSender(meta.PrivateKey, meta.PublicKey,
+			meta.Domain)
+	case BackendSMTP:
+		sender = NewSMTPSender(meta.SMTP.Host, meta.SMTP.Port,
+			meta.SMTP.Username, meta.SMTP.Password)
+	case BackendFile:
+		sender, err = NewFileSender(meta.File.Dir)
+		if err!=nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown mail backend %s", meta.Backend)
+	}
+
+	mailer:= NewMailer(sender, meta.SendingAddress)
 
 	// Make sure we prepare all templates whose location are encoded
 	// in the metadata.
@@ -55,4 +80,4 @@ func GetMailerFromFile(loc string) (*Mailer, error) {
 	}
 
 	return mailer, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,60 @@
+package mailer
+
+import(
+
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// The locale every template is provided in and all others fall back to.
+const DefaultLocale string = "en"
+
+// A language optionally followed by a region or script, eg. en or pt-br
+var localeFormat = regexp.MustCompile("^[a-z]{2,3}(-[a-z0-9]{2,8})?$")
+
+// Lowercases a locale and swaps underscores for hyphens so en_US and
+// en-us refer to the same thing.
+func NormalizeLocale(locale string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(locale)),
+		"_", "-", -1)
+}
+
+// Normalizes a locale, ensuring it is well formed.
+func ParseLocale(locale string) (string, error) {
+	normalized:= NormalizeLocale(locale)
+	if !localeFormat.MatchString(normalized) {
+		return "", fmt.Errorf("malformed locale %s", locale)
+	}
+
+	return normalized, nil
+}
+
+// Finds the template for id best matching locale.
+//
+// A regional locale falls back to its language then to DefaultLocale,
+// so pt-br tries pt-br, pt, then en.
+func (mailer *Mailer) lookup(templateId, locale string) (*Template, error) {
+
+	localized, ok:= mailer.Templates[templateId]
+	if !ok {
+		return nil, fmt.Errorf("no template prepared for %s", templateId)
+	}
+
+	locale = NormalizeLocale(locale)
+	candidates:= []string{locale}
+	if i:= strings.Index(locale, "-"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+	candidates = append(candidates, DefaultLocale)
+
+	for _, candidate:= range candidates{
+		t, ok:= localized[candidate]
+		if ok {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no %s template for %s", DefaultLocale, templateId)
+
+}
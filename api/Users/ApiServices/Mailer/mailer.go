@@ -5,39 +5,75 @@ import(
 	"bytes"
 )
 
-// Sends plaintext via mailgun.
+// Sends plaintext via the mailer's sender.
 //
 // body must be plaintext, no html. Format as desired.
 // to should be form NAME <EMAIL>
 // subject should be succinct.
 func (mailer *Mailer) Send(body, to, subject string) error {
 	
-	var err error
+	return mailer.sender.Send(mailer.source, to, subject, body, "")
 
-	m:= mailer.gun.NewMessage(mailer.source, subject, body)
-	err = m.AddRecipient(to)
+}
+
+// Sends plaintext with an html alternative via the mailer's sender.
+//
+// An empty html sends plaintext alone, as Send.
+func (mailer *Mailer) SendMultipart(text, html, to, subject string) error {
+
+	return mailer.sender.Send(mailer.source, to, subject, text, html)
+
+}
+
+// Sends a prepared template via the mailer's sender.
+//
+// This allows easy access to the prepared templates
+// associated with this mailer. The template is chosen for
+// locale, see Render.
+func (mailer *Mailer) SendPrepared(templateId, locale string,
+	content interface{}, to, subject string) error {
+
+	text, html, err:= mailer.Render(templateId, locale, content)
 	if err!=nil {
 		return err
 	}
 
-	_,_, err = mailer.gun.Send(m)
-	
-	return err
-
+	return mailer.SendMultipart(text, html, to, subject)
 }
 
-// Sends plaintext via mailgun.
+// Renders a prepared template without sending it.
 //
-// This allows easy access to the prepared templates
-// associated with this mailer
-func (mailer *Mailer) SendPrepared(templateId string, content interface{},
-	to, subject string) error {
+// Lets a body be built now and delivered later via SendMultipart.
+// html is empty when the template has no html alternative. Locales
+// without a template fall back towards DefaultLocale.
+func (mailer *Mailer) Render(templateId, locale string,
+	content interface{}) (string, string, error) {
+
+	t, err:= mailer.lookup(templateId, locale)
+	if err!=nil {
+		return "", "", err
+	}
+
+	var textBuffer bytes.Buffer
+	err = t.Text.Execute(&textBuffer, content)
+	if err!=nil {
+		return "", "", err
+	}
 
-	return mailer.SendTemplated(mailer.Templates[templateId], content,
-		to, subject)
+	if t.HTML == nil {
+		return textBuffer.String(), "", nil
+	}
+
+	var htmlBuffer bytes.Buffer
+	err = t.HTML.Execute(&htmlBuffer, content)
+	if err!=nil {
+		return "", "", err
+	}
+
+	return textBuffer.String(), htmlBuffer.String(), nil
 }
 
-// Sends plaintext via mailgun.
+// Sends plaintext via the mailer's sender.
 //
 // This one supports efficient text templating for the body.
 func (mailer *Mailer) SendTemplated(bodyTemplate *template.Template,
@@ -53,4 +89,4 @@ func (mailer *Mailer) SendTemplated(bodyTemplate *template.Template,
 
     return mailer.Send(body, to, subject)
 
-}
\ No newline at end of file
+}
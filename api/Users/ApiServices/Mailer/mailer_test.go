@@ -0,0 +1,213 @@
+package mailer
+
+import(
+
+	"testing"
+
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const testTemplateDir string = "../../templates/"
+const testSource string = "Preorda.in<noreply@preorda.in>"
+
+// Builds a mailer writing to a fresh directory with every template
+// the Users API relies upon prepared.
+func getTestMailer(t *testing.T) (*Mailer, string) {
+
+	dir, err:= ioutil.TempDir("", "mailerTest")
+	if err!=nil {
+		t.Fatal("failed to make sink directory", err)
+	}
+
+	sender, err:= NewFileSender(dir)
+	if err!=nil {
+		t.Fatal("failed to make file sender", err)
+	}
+
+	mailer:= NewMailer(sender, testSource)
+
+	templates:= map[string]string{
+		"reset": "resetCode.txt.template",
+		"subSuccess": "subSuccess.txt.template",
+		"unSubSuccess": "unSubSuccess.txt.template",
+	}
+	for id, loc:= range templates{
+		err = mailer.Prepare(id, testTemplateDir + loc)
+		if err!=nil {
+			t.Fatal("failed to prepare", loc, err)
+		}
+	}
+
+	return mailer, dir
+
+}
+
+// Sends a templated message and returns what landed in the sink
+func sendAndRead(t *testing.T, templateId, locale string,
+	content interface{}, subject string) Message {
+
+	mailer, dir:= getTestMailer(t)
+	defer os.RemoveAll(dir)
+
+	to:= FormatAddress("everlag", "everlag@example.com")
+	err:= mailer.SendPrepared(templateId, locale, content, to, subject)
+	if err!=nil {
+		t.Fatal("failed to send", templateId, err)
+	}
+
+	messages, err:= ReadMessages(dir)
+	if err!=nil {
+		t.Fatal("failed to read sink", err)
+	}
+	if len(messages) != 1 {
+		t.Fatal("expected a single message, got", len(messages))
+	}
+
+	m:= messages[0]
+	if m.From != testSource || m.To != to || m.Subject != subject {
+		t.Fatal("message headers were mangled", m)
+	}
+
+	return m
+
+}
+
+func TestResetFlow(t *testing.T) {
+
+	m:= sendAndRead(t, "reset", DefaultLocale, struct{
+		Name, ResetCode string
+	}{"everlag", "someResetCode"}, "Password Reset - Preorda.in")
+
+	if !strings.Contains(m.Body, "Hey everlag") ||
+		!strings.Contains(m.Body, "someResetCode") {
+		t.Fatal("reset template was not filled", m.Body)
+	}
+
+	if !strings.Contains(m.HTML, "<p>Hey everlag") ||
+		!strings.Contains(m.HTML, "someResetCode") {
+		t.Fatal("reset html alternative was not sent", m.HTML)
+	}
+
+}
+
+func TestSubscribeFlow(t *testing.T) {
+
+	m:= sendAndRead(t, "subSuccess", DefaultLocale, struct{
+		Name, Plan string
+	}{"everlag", "Sensei's Top"}, "Subscribed! - Preorda.in")
+
+	if !strings.Contains(m.Body, "Hey everlag, thanks for subscribing!") ||
+		!strings.Contains(m.Body, "Sensei's Top") {
+		t.Fatal("subscribe template was not filled", m.Body)
+	}
+
+	// html/template escapes what it fills in
+	if !strings.Contains(m.HTML, "Sensei&#39;s Top") {
+		t.Fatal("subscribe html was not escaped", m.HTML)
+	}
+
+}
+
+func TestUnSubscribeFlow(t *testing.T) {
+
+	m:= sendAndRead(t, "unSubSuccess", DefaultLocale, struct{
+		Name, Plan string
+	}{"everlag", "Preordain"}, "unSubscribed! - Preorda.in")
+
+	if !strings.Contains(m.Body,
+		"Hey everlag, you've successfully unsubscribed from Preordain") {
+		t.Fatal("unsubscribe template was not filled", m.Body)
+	}
+
+}
+
+// Sending an unprepared template is an error rather than a panic
+func TestMissingTemplate(t *testing.T) {
+
+	mailer, dir:= getTestMailer(t)
+	defer os.RemoveAll(dir)
+
+	err:= mailer.SendPrepared("notATemplate", DefaultLocale, nil,
+		FormatAddress("everlag", "everlag@example.com"), "Nope")
+	if err == nil {
+		t.Fatal("sent an unprepared template")
+	}
+
+}
+
+// Regional locales fall back to their language then to DefaultLocale
+func TestLocaleFallback(t *testing.T) {
+
+	content:= struct{
+		Name, Plan string
+	}{"everlag", "Preordain"}
+
+	m:= sendAndRead(t, "subSuccess", "es_MX", content,
+		"Subscribed! - Preorda.in")
+	if !strings.Contains(m.Body, "gracias por suscribirte") ||
+		!strings.Contains(m.HTML, "gracias por suscribirte") {
+		t.Fatal("regional locale did not fall back to its language", m)
+	}
+
+	m = sendAndRead(t, "subSuccess", "fr", content,
+		"Subscribed! - Preorda.in")
+	if !strings.Contains(m.Body, "thanks for subscribing") {
+		t.Fatal("unknown locale did not fall back to default", m.Body)
+	}
+
+}
+
+// Templates without an html alternative go out as plaintext alone
+func TestPlaintextOnly(t *testing.T) {
+
+	mailer, dir:= getTestMailer(t)
+	defer os.RemoveAll(dir)
+
+	err:= mailer.Prepare("verify", testTemplateDir + "verifyEmail.txt.template")
+	if err!=nil {
+		t.Fatal("failed to prepare", err)
+	}
+
+	err = mailer.SendPrepared("verify", DefaultLocale, struct{
+		Name, VerificationCode string
+	}{"everlag", "someCode"},
+		FormatAddress("everlag", "everlag@example.com"), "Verify")
+	if err!=nil {
+		t.Fatal("failed to send", err)
+	}
+
+	messages, err:= ReadMessages(dir)
+	if err!=nil || len(messages) != 1 {
+		t.Fatal("failed to read sink", err)
+	}
+	if messages[0].HTML != "" ||
+		!strings.Contains(messages[0].Body, "someCode") {
+		t.Fatal("plaintext message was mangled", messages[0])
+	}
+
+}
+
+func TestParseLocale(t *testing.T) {
+
+	valid:= map[string]string{
+		"en": "en",
+		"es_MX": "es-mx",
+		" PT-br ": "pt-br",
+	}
+	for raw, expected:= range valid{
+		locale, err:= ParseLocale(raw)
+		if err!=nil || locale != expected {
+			t.Fatal("failed to parse", raw, locale, err)
+		}
+	}
+
+	for _, raw:= range []string{"", "english", "e", "en-", "../en"} {
+		_, err:= ParseLocale(raw)
+		if err==nil {
+			t.Fatal("accepted malformed locale", raw)
+		}
+	}
+
+}
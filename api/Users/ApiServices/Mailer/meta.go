@@ -2,18 +2,55 @@ package mailer
 
 import(
 	"text/template"
+	htmlTemplate "html/template"
+
+	"os"
+	"path/filepath"
+	"strings"
 )
 
+// Plaintext bodies are required, html alternatives are optional.
+const textExtension string = ".txt.template"
+const htmlExtension string = ".html.template"
+
+// A prepared body in a single locale.
+//
+// HTML is nil when the template has no html alternative.
+type Template struct{
+	Text *template.Template
+	HTML *htmlTemplate.Template
+}
+
 
 // Takes a name and email and sets it to form name <email>
 func FormatAddress(name, email string) string {
 	return name + "<" + email + ">"
 }
 
-type MailGunMeta struct{
-	PrivateKey, PublicKey string
-	Domain, SendingAddress string
+// How a mailer is configured.
+//
+// Backend selects where mail goes, one of BackendMailgun, BackendSMTP,
+// or BackendFile. Only the fields of the chosen backend need be set.
+type MailerMeta struct{
+	Backend string
+	SendingAddress string
+	// id to the DefaultLocale plaintext template, see Prepare
 	Templates map[string]string
+
+	// mailgun
+	PrivateKey, PublicKey string
+	Domain string
+
+	SMTP struct{
+		Host string
+		Port int
+		Username, Password string
+	}
+
+	File struct{
+		// Where each message is written, created if missing
+		Dir string
+	}
 }
 
 func FetchTemplate(loc string) (*template.Template, error) {
@@ -22,14 +59,69 @@ func FetchTemplate(loc string) (*template.Template, error) {
 
 // Prepares a template given a location on disk.
 //
-//It may be access by mailer.Templates[id]
+// loc is the plaintext body in DefaultLocale, the extension may be
+// omitted. Other files sharing its base name are picked up alongside:
+//
+//	resetCode.txt.template - plaintext, required
+//	resetCode.html.template - html alternative, optional
+//	resetCode.es.txt.template - plaintext for the es locale
+//	resetCode.es.html.template - html alternative for the es locale
+//
+// It may be accessed by mailer.Templates[id][locale]
 func (mailer *Mailer) Prepare(id, loc string) error {
-	template, err:= FetchTemplate(loc)
+	base:= strings.TrimSuffix(loc, textExtension)
+
+	localized:= make(map[string]*Template)
+
+	t, err:= fetchLocalized(base, "")
 	if err!=nil {
 		return err
 	}
+	localized[DefaultLocale] = t
 
-	mailer.Templates[id] = template
+	others, err:= filepath.Glob(base + ".*" + textExtension)
+	if err!=nil {
+		return err
+	}
+	for _, other:= range others{
+		locale:= strings.TrimSuffix(
+			strings.TrimPrefix(other, base + "."), textExtension)
+		// Belongs to some other template sharing a prefix
+		if strings.Contains(locale, ".") {
+			continue
+		}
+
+		t, err:= fetchLocalized(base, "." + locale)
+		if err!=nil {
+			return err
+		}
+		localized[NormalizeLocale(locale)] = t
+	}
+
+	mailer.Templates[id] = localized
 
 	return nil
-}
\ No newline at end of file
+}
+
+// Fetches the plaintext and, if present, html bodies for a locale.
+func fetchLocalized(base, locale string) (*Template, error) {
+
+	text, err:= FetchTemplate(base + locale + textExtension)
+	if err!=nil {
+		return nil, err
+	}
+	t:= &Template{Text: text}
+
+	htmlLoc:= base + locale + htmlExtension
+	_, err = os.Stat(htmlLoc)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+
+	t.HTML, err = htmlTemplate.ParseFiles(htmlLoc)
+	if err!=nil {
+		return nil, err
+	}
+
+	return t, nil
+}
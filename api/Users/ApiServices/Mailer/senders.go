@@ -0,0 +1,286 @@
+package mailer
+
+import(
+
+	"github.com/mailgun/mailgun-go"
+
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Delivers a single, already rendered, message.
+//
+// from and to are of the form NAME <EMAIL> or a bare address.
+// html is an optional alternative to the plaintext body, when present
+// the message is sent as multipart/alternative.
+type Sender interface{
+	Send(from, to, subject, text, html string) error
+}
+
+// Sends through mailgun's api.
+type MailgunSender struct{
+	gun mailgun.Mailgun
+}
+
+func NewMailgunSender(priv, pub, domain string) *MailgunSender {
+	return &MailgunSender{mailgun.NewMailgun(domain, priv, pub)}
+}
+
+func (s *MailgunSender) Send(from, to, subject, text, html string) error {
+
+	m:= s.gun.NewMessage(from, subject, text)
+	err:= m.AddRecipient(to)
+	if err!=nil {
+		return err
+	}
+	if html != "" {
+		m.SetHtml(html)
+	}
+
+	_,_, err = s.gun.Send(m)
+
+	return err
+
+}
+
+// Sends through a plain SMTP relay.
+//
+// Auth is PLAIN and only attempted when a username is provided.
+type SMTPSender struct{
+	addr string
+	auth smtp.Auth
+}
+
+func NewSMTPSender(host string, port int,
+	username, password string) *SMTPSender {
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPSender{
+		addr: host + ":" + strconv.Itoa(port),
+		auth: auth,
+	}
+}
+
+func (s *SMTPSender) Send(from, to, subject, text, html string) error {
+
+	fromAddr, err:= mail.ParseAddress(from)
+	if err!=nil {
+		return err
+	}
+	toAddr, err:= mail.ParseAddress(to)
+	if err!=nil {
+		return err
+	}
+
+	msg, err:= formatMessage(from, to, subject, text, html, time.Now())
+	if err!=nil {
+		return err
+	}
+
+	return smtp.SendMail(s.addr, s.auth, fromAddr.Address,
+		[]string{toAddr.Address}, msg)
+
+}
+
+// Writes every message to its own file in a directory rather than
+// sending it anywhere, for development and testing.
+type FileSender struct{
+	sync.Mutex
+	dir string
+	count int
+}
+
+// The extension every message FileSender writes has
+const messageExtension string = ".eml"
+
+func NewFileSender(dir string) (*FileSender, error) {
+	err:= os.MkdirAll(dir, 0700)
+	if err!=nil {
+		return nil, err
+	}
+
+	return &FileSender{dir: dir}, nil
+}
+
+func (s *FileSender) Send(from, to, subject, text, html string) error {
+
+	s.Lock()
+	s.count++
+	now:= time.Now()
+	name:= fmt.Sprintf("%d-%06d%s", now.UnixNano(), s.count, messageExtension)
+	s.Unlock()
+
+	msg, err:= formatMessage(from, to, subject, text, html, now)
+	if err!=nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(s.dir, name), msg, 0600)
+
+}
+
+// A message as written by FileSender
+//
+// Body is the plaintext, HTML is empty when none was sent.
+type Message struct{
+	From, To, Subject, Body, HTML string
+}
+
+// Reads back every message a FileSender wrote to dir, oldest first.
+func ReadMessages(dir string) ([]Message, error) {
+
+	names, err:= filepath.Glob(filepath.Join(dir, "*" + messageExtension))
+	if err!=nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	messages:= make([]Message, 0, len(names))
+	for _, name:= range names{
+		raw, err:= ioutil.ReadFile(name)
+		if err!=nil {
+			return nil, err
+		}
+
+		m, err:= mail.ReadMessage(bytes.NewReader(raw))
+		if err!=nil {
+			return nil, err
+		}
+
+		message:= Message{
+			From: m.Header.Get("From"),
+			To: m.Header.Get("To"),
+			Subject: m.Header.Get("Subject"),
+		}
+		err = readBodies(&message, m.Header.Get("Content-Type"), m.Body)
+		if err!=nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+
+}
+
+// Fills in the plaintext and html of a message from its body.
+func readBodies(message *Message, contentType string, body io.Reader) error {
+
+	mediaType, params, err:= mime.ParseMediaType(contentType)
+	if err!=nil {
+		return err
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		raw, err:= ioutil.ReadAll(body)
+		if err!=nil {
+			return err
+		}
+		message.Body = strings.Replace(string(raw), "\r\n", "\n", -1)
+		return nil
+	}
+
+	parts:= multipart.NewReader(body, params["boundary"])
+	for {
+		part, err:= parts.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err!=nil {
+			return err
+		}
+
+		raw, err:= ioutil.ReadAll(part)
+		if err!=nil {
+			return err
+		}
+		content:= strings.Replace(string(raw), "\r\n", "\n", -1)
+
+		partType, _, err:= mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err!=nil {
+			return err
+		}
+		switch partType {
+		case "text/plain":
+			message.Body = content
+		case "text/html":
+			message.HTML = content
+		}
+	}
+
+}
+
+// Builds an RFC 822 message, multipart/alternative if html is provided
+func formatMessage(from, to, subject, text, html string,
+	at time.Time) ([]byte, error) {
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", at.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+
+	if html == "" {
+		msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+		msg.WriteString("\r\n")
+		msg.WriteString(crlf(text))
+		return msg.Bytes(), nil
+	}
+
+	parts:= multipart.NewWriter(&msg)
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n",
+		parts.Boundary())
+	msg.WriteString("\r\n")
+
+	// Clients prefer the last part they understand so html goes last
+	alternatives:= []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	}
+	for _, alt:= range alternatives{
+		header:= make(textproto.MIMEHeader)
+		header.Set("Content-Type", alt.contentType)
+		w, err:= parts.CreatePart(header)
+		if err!=nil {
+			return nil, err
+		}
+		_, err = w.Write([]byte(crlf(alt.body)))
+		if err!=nil {
+			return nil, err
+		}
+	}
+
+	err:= parts.Close()
+	if err!=nil {
+		return nil, err
+	}
+
+	return msg.Bytes(), nil
+
+}
+
+// Normalizes every line ending to CRLF as RFC 822 requires.
+func crlf(body string) string {
+	return strings.Replace(
+		strings.Replace(body, "\r\n", "\n", -1), "\n", "\r\n", -1)
+}
@@ -3,6 +3,7 @@ package ApiServices
 import(
 
 	"./../../../common/mtgjson"
+	"./userDBHandler"
 
 	"strings"
 	"sort"
@@ -18,6 +19,8 @@ var sets = make(map[string]bool)
 // ever printed
 var cards = make(map[string]bool)
 
+// Maps each card to the sets it was printed in and whether a foil
+// printing exists in that set.
 var cardsToSets = make(map[string]map[string]bool)
 
 // This is specifically geared towards being capable of providing per-set
@@ -95,14 +98,12 @@ func populateCardsTranslationMap(validSets map[string]bool) (map[string]bool,
 			
 			_, ok:= validSets[aPrinting]
 			if ok{
-				cardsToSets[aCardName][aPrinting] = true
-
-				// Make a check to add the foil as well if such
+				// Foils are tracked as their own set, note if such
 				// a printing exists
-				foilCandidate:= aPrinting + " Foil"
-				if sort.SearchStrings(setList, foilCandidate)!=-1{
-					cardsToSets[aCardName][foilCandidate] = true
-				}
+				foilCandidate:= aPrinting + userDB.FoilSuffix
+				i:= sort.SearchStrings(setList, foilCandidate)
+				cardsToSets[aCardName][aPrinting] = i < len(setList) &&
+					setList[i] == foilCandidate
 			}
 
 		}
@@ -31,6 +31,7 @@ func GetMerchantFromFile(loc string) (*Merch, error) {
 	}
 
 	merch:= GetMerchant(meta.PrivateKey)
+	merch.webhookSecret = meta.WebhookSecret
 
 	return merch, nil
 }
@@ -38,5 +39,7 @@ func GetMerchantFromFile(loc string) (*Merch, error) {
 type MerchMeta struct{
 
 	PrivateKey string
+	// The signing secret of our webhook endpoint, 'whsec_...'
+	WebhookSecret string
 
 }
\ No newline at end of file
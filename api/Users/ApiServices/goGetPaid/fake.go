@@ -0,0 +1,248 @@
+package getPaid
+
+import(
+
+	"fmt"
+	"sync"
+	"time"
+
+)
+
+// Payment token FakeMerch treats as a declined card, mirroring
+// stripe's own testing token.
+const DeclinedToken string = "tok_chargeDeclined"
+
+var ErrDeclined = fmt.Errorf("card declined")
+
+// A customer as FakeMerch remembers them
+type FakeCustomer struct{
+	ID, Email, Coupon, Token string
+}
+
+// A subscription as FakeMerch remembers it
+type FakeSub struct{
+	ID, Customer, Plan, Coupon string
+	Cancelled bool
+	// When billing periods are anchored, after any trial
+	PeriodStart time.Time
+	TrialEnd time.Time
+}
+
+// How long every FakeMerch billing period lasts
+const FakePeriod = time.Duration(30 * 24) * time.Hour
+
+// A deterministic, in-memory Merchant.
+//
+// Ids are handed out sequentially, 'cus_fake_1', 'sub_fake_1' and so on,
+// so tests can predict them.
+type FakeMerch struct{
+	sync.Mutex
+
+	webhookSecret string
+
+	// When the merchant believes it is, defaults to time.Now
+	Clock func() time.Time
+	// Monthly price of each plan in cents, missing plans are free
+	Prices map[string]int64
+
+	customerCount, subCount int
+	customers map[string]FakeCustomer
+	subs map[string]FakeSub
+}
+
+// Returns a fresh FakeMerch accepting webhooks signed with secret
+func NewFakeMerch(webhookSecret string) *FakeMerch {
+	return &FakeMerch{
+		webhookSecret: webhookSecret,
+		Clock: time.Now,
+		Prices: make(map[string]int64),
+		customers: make(map[string]FakeCustomer),
+		subs: make(map[string]FakeSub),
+	}
+}
+
+func (merch *FakeMerch) AddCustomer(token, email,
+	coupon string) (string, error) {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	if token == DeclinedToken {
+		return "", ErrDeclined
+	}
+
+	merch.customerCount++
+	id:= fmt.Sprintf("cus_fake_%d", merch.customerCount)
+	merch.customers[id] = FakeCustomer{
+		ID: id,
+		Email: email,
+		Coupon: coupon,
+		Token: token,
+	}
+
+	return id, nil
+
+}
+
+func (merch *FakeMerch) UpdateCustomer(customerID, token string) error {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	c, ok:= merch.customers[customerID]
+	if !ok {
+		return fmt.Errorf("no such customer")
+	}
+	if token == DeclinedToken {
+		return ErrDeclined
+	}
+
+	c.Token = token
+	merch.customers[customerID] = c
+
+	return nil
+
+}
+
+func (merch *FakeMerch) SubCustomer(customer, plan, coupon string,
+	trialEnd time.Time) (string, error) {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	c, ok:= merch.customers[customer]
+	if !ok {
+		return "", fmt.Errorf("no such customer")
+	}
+	if c.Token == DeclinedToken {
+		return "", ErrDeclined
+	}
+
+	merch.subCount++
+	id:= fmt.Sprintf("sub_fake_%d", merch.subCount)
+	periodStart:= merch.Clock()
+	if trialEnd.After(periodStart) {
+		periodStart = trialEnd
+	}
+
+	merch.subs[id] = FakeSub{
+		ID: id,
+		Customer: customer,
+		Plan: plan,
+		Coupon: coupon,
+		PeriodStart: periodStart,
+		TrialEnd: trialEnd,
+	}
+
+	return id, nil
+
+}
+
+func (merch *FakeMerch) UpdateSubCustomer(customerID, subID,
+	plan string) error {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	s, err:= merch.activeSub(customerID, subID)
+	if err!=nil {
+		return err
+	}
+
+	s.Plan = plan
+	merch.subs[subID] = s
+
+	return nil
+
+}
+
+func (merch *FakeMerch) UnSubCustomer(subID, customerID string) error {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	s, err:= merch.activeSub(customerID, subID)
+	if err!=nil {
+		return err
+	}
+
+	s.Cancelled = true
+	merch.subs[subID] = s
+
+	return nil
+
+}
+
+// Prorates the difference in Prices over what remains of the
+// current FakePeriod. Trials cost nothing to change.
+func (merch *FakeMerch) PreviewSubChange(customerID, subID,
+	plan string, at time.Time) (*Proration, error) {
+
+	merch.Lock()
+	defer merch.Unlock()
+
+	s, err:= merch.activeSub(customerID, subID)
+	if err!=nil {
+		return nil, err
+	}
+
+	p:= Proration{
+		Currency: "usd",
+		ProrationDate: at,
+	}
+	if at.Before(s.PeriodStart) {
+		return &p, nil
+	}
+
+	elapsed:= at.Sub(s.PeriodStart) % FakePeriod
+	remaining:= int64((FakePeriod - elapsed) / time.Second)
+	period:= int64(FakePeriod / time.Second)
+
+	delta:= merch.Prices[plan] - merch.Prices[s.Plan]
+	p.Amount = delta * remaining / period
+
+	return &p, nil
+
+}
+
+func (merch *FakeMerch) ParseWebhook(payload []byte,
+	signature string, at time.Time) (*Event, error) {
+
+	return parseWebhook(payload, signature, merch.webhookSecret, at)
+
+}
+
+// Acquires a customer for inspection
+func (merch *FakeMerch) Customer(customerID string) (FakeCustomer, bool) {
+	merch.Lock()
+	defer merch.Unlock()
+
+	c, ok:= merch.customers[customerID]
+	return c, ok
+}
+
+// Acquires a subscription for inspection
+func (merch *FakeMerch) Sub(subID string) (FakeSub, bool) {
+	merch.Lock()
+	defer merch.Unlock()
+
+	s, ok:= merch.subs[subID]
+	return s, ok
+}
+
+// Acquires a subscription that exists, belongs to the customer,
+// and hasn't been cancelled. Must hold the lock.
+func (merch *FakeMerch) activeSub(customerID,
+	subID string) (FakeSub, error) {
+
+	s, ok:= merch.subs[subID]
+	if !ok || s.Customer != customerID {
+		return s, fmt.Errorf("no such subscription")
+	}
+	if s.Cancelled {
+		return s, fmt.Errorf("subscription already cancelled")
+	}
+
+	return s, nil
+
+}
@@ -0,0 +1,117 @@
+package getPaid
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Ensure the fake hands out predictable ids and enforces the same
+// constraints stripe would.
+func TestFakeMerch(t *testing.T) {
+
+	var merch Merchant = NewFakeMerch(testSecret)
+	fake:= merch.(*FakeMerch)
+
+	custID, err:= merch.AddCustomer("tok_visa", "foo@bar.com", "")
+	if err!=nil || custID != "cus_fake_1" {
+		t.Fatal("failed to add customer", custID, err)
+	}
+
+	_, err = merch.AddCustomer(DeclinedToken, "foo@bar.com", "")
+	if err != ErrDeclined {
+		t.Fatal("accepted a declined card", err)
+	}
+
+	subID, err:= merch.SubCustomer(custID, "Preordain", "", time.Time{})
+	if err!=nil || subID != "sub_fake_1" {
+		t.Fatal("failed to subscribe", subID, err)
+	}
+
+	err = merch.UpdateSubCustomer(custID, subID, "Sensei's Top")
+	if err!=nil {
+		t.Fatal("failed to update subscription", err)
+	}
+	s, _:= fake.Sub(subID)
+	if s.Plan != "Sensei's Top" {
+		t.Fatal("plan change did not stick", s)
+	}
+
+	err = merch.UpdateSubCustomer("cus_fake_2", subID, "Preordain")
+	if err == nil {
+		t.Fatal("updated another customer's subscription")
+	}
+
+	err = merch.UnSubCustomer(subID, custID)
+	if err!=nil {
+		t.Fatal("failed to cancel", err)
+	}
+	err = merch.UnSubCustomer(subID, custID)
+	if err == nil {
+		t.Fatal("cancelled a subscription twice")
+	}
+
+	err = merch.UpdateCustomer(custID, DeclinedToken)
+	if err != ErrDeclined {
+		t.Fatal("accepted a declined card", err)
+	}
+
+}
+
+// Ensure the fake prorates over the remaining period and honours trials
+func TestFakeProration(t *testing.T) {
+
+	start:= time.Unix(1500000000, 0)
+	fake:= NewFakeMerch(testSecret)
+	fake.Clock = func() time.Time { return start }
+	fake.Prices["Preordain"] = 500
+	fake.Prices["Sensei's Top"] = 1000
+
+	custID, err:= fake.AddCustomer("tok_visa", "foo@bar.com", "")
+	if err!=nil {
+		t.Fatal("failed to add customer", err)
+	}
+	subID, err:= fake.SubCustomer(custID, "Preordain", "LAUNCH", time.Time{})
+	if err!=nil {
+		t.Fatal("failed to subscribe", err)
+	}
+	s, _:= fake.Sub(subID)
+	if s.Coupon != "LAUNCH" {
+		t.Fatal("coupon was not recorded")
+	}
+
+	// Halfway through a period upgrading costs half the difference
+	p, err:= fake.PreviewSubChange(custID, subID, "Sensei's Top",
+		start.Add(FakePeriod / 2))
+	if err!=nil {
+		t.Fatal("failed to preview", err)
+	}
+	if p.Amount != 250 {
+		t.Fatal("upgrade prorated incorrectly", p.Amount)
+	}
+
+	// Downgrading is a credit, and periods roll over
+	p, err = fake.PreviewSubChange(custID, subID, "Peek",
+		start.Add(FakePeriod + FakePeriod / 2))
+	if err!=nil {
+		t.Fatal("failed to preview", err)
+	}
+	if p.Amount != -250 {
+		t.Fatal("downgrade prorated incorrectly", p.Amount)
+	}
+
+	// Changing during a trial is free
+	trialEnd:= start.Add(14 * 24 * time.Hour)
+	subID, err = fake.SubCustomer(custID, "Preordain", "", trialEnd)
+	if err!=nil {
+		t.Fatal("failed to subscribe with trial", err)
+	}
+	p, err = fake.PreviewSubChange(custID, subID, "Sensei's Top",
+		start.Add(time.Hour))
+	if err!=nil || p.Amount != 0 {
+		t.Fatal("trial change was not free", err)
+	}
+
+}
@@ -4,25 +4,37 @@ import(
 	"github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/sub"
 	"github.com/stripe/stripe-go/customer"
+	"github.com/stripe/stripe-go/invoice"
+
+	"time"
 )
 
 // A merchant that allows us the ability to charge
 // users.
 //
 // Mostly just a dummy that allows us to retain state as an object.
-type Merch struct{}
+type Merch struct{
+	webhookSecret string
+}
 
 // Subscribes a given customer to a plan.
 //
 // Customer must be an customer id provided by stripe.
 // Plan must be a plan id chosen at plan creation time.
+// Coupon, if not empty, must also exist on stripe.
+// A zero trialEnd starts billing immediately.
 //
 // Returns a valid subscription id is successful
-func (merch *Merch) SubCustomer(customer, plan string) (string, error) {
+func (merch *Merch) SubCustomer(customer, plan, coupon string,
+	trialEnd time.Time) (string, error) {
 
 	subParams:= &stripe.SubParams{
 		Customer: customer,
 		Plan: plan,
+		Coupon: coupon,
+	}
+	if !trialEnd.IsZero() {
+		subParams.TrialEnd = trialEnd.Unix()
 	}
 
 	s, err := sub.New(subParams)
@@ -106,4 +118,38 @@ func (merch *Merch) UpdateCustomer(customerID, token string) error {
 
 	return err
 
+}
+
+// Previews what moving a subscription to a plan at a given time would
+// cost immediately.
+//
+// Only the prorated lines of the upcoming invoice are the cost of
+// switching, the rest would be owed regardless.
+func (merch *Merch) PreviewSubChange(customerID, subID,
+	plan string, at time.Time) (*Proration, error) {
+
+	invoiceParams:= &stripe.InvoiceParams{
+		Customer: customerID,
+		Sub: subID,
+		SubPlan: plan,
+		SubProrationDate: at.Unix(),
+	}
+
+	upcoming, err:= invoice.GetNext(invoiceParams)
+	if err!=nil {
+		return nil, err
+	}
+
+	p:= Proration{
+		Currency: string(upcoming.Currency),
+		ProrationDate: at,
+	}
+	for _, line:= range upcoming.Lines.Values{
+		if line.Proration {
+			p.Amount += line.Amount
+		}
+	}
+
+	return &p, nil
+
 }
\ No newline at end of file
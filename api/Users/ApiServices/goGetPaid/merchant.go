@@ -0,0 +1,49 @@
+package getPaid
+
+import(
+
+	"time"
+
+)
+
+// Everything the Users API needs from a payment processor.
+//
+// Merch talks to stripe, FakeMerch is an in-memory stand in for testing.
+type Merchant interface{
+
+	// Adds a new customer with a given email and payment token,
+	// returning their customer id.
+	AddCustomer(token, email, coupon string) (string, error)
+	// Updates a customer to a new payment token.
+	UpdateCustomer(customerID, token string) error
+
+	// Subscribes a customer to a plan with an optional coupon and
+	// trial, returning the subscription id.
+	SubCustomer(customer, plan, coupon string,
+		trialEnd time.Time) (string, error)
+	// Moves a customer's subscription to the provided plan.
+	UpdateSubCustomer(customerID, subID, plan string) error
+	// Removes a customer's subscription.
+	UnSubCustomer(subID, customerID string) error
+	// Previews the immediate cost of moving a subscription to a plan.
+	PreviewSubChange(customerID, subID, plan string,
+		at time.Time) (*Proration, error)
+
+	// Verifies and parses a webhook delivery.
+	ParseWebhook(payload []byte, signature string,
+		at time.Time) (*Event, error)
+
+}
+
+// The immediate cost of changing plans.
+type Proration struct{
+	// In the smallest unit of Currency, negative for a credit
+	Amount int64
+	Currency string
+	// When the change was priced at
+	ProrationDate time.Time
+}
+
+// Both merchants must remain interchangeable
+var _ Merchant = &Merch{}
+var _ Merchant = &FakeMerch{}
@@ -0,0 +1,190 @@
+package getPaid
+
+import(
+
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+)
+
+// The header stripe signs webhook deliveries in.
+const SignatureHeader string = "Stripe-Signature"
+
+// How old a signed delivery may be before we consider it a replay.
+const SignatureTolerance = time.Duration(5) * time.Minute
+
+// The events we act upon, everything else is acknowledged and ignored.
+const SubUpdated string = "customer.subscription.updated"
+const SubDeleted string = "customer.subscription.deleted"
+const InvoicePaymentFailed string = "invoice.payment_failed"
+
+// Subscription statuses stripe can report
+const StatusActive string = "active"
+const StatusTrialing string = "trialing"
+const StatusPastDue string = "past_due"
+const StatusCanceled string = "canceled"
+const StatusUnpaid string = "unpaid"
+
+var ErrBadSignature = fmt.Errorf("webhook signature invalid")
+
+// A webhook delivery from stripe with only the fields we use.
+type Event struct{
+	ID, Type string
+	Created int64
+	Data struct{
+		Object json.RawMessage
+	}
+}
+
+// When stripe created the event
+func (e *Event) CreatedAt() time.Time {
+	return time.Unix(e.Created, 0)
+}
+
+// The subscription an event concerns
+type EventSub struct{
+	ID, Customer, Status string
+	Plan struct{
+		ID string
+	}
+}
+
+// The invoice an event concerns
+type EventInvoice struct{
+	ID, Customer, Subscription string
+	Paid bool
+	// Null once stripe has given up on collecting payment
+	NextPaymentAttempt *int64 `json:"next_payment_attempt"`
+}
+
+// Acquires the subscription inside a customer.subscription.* event
+func (e *Event) Sub() (*EventSub, error) {
+	var s EventSub
+	err:= json.Unmarshal(e.Data.Object, &s)
+	if err!=nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Acquires the invoice inside an invoice.* event
+func (e *Event) Invoice() (*EventInvoice, error) {
+	var i EventInvoice
+	err:= json.Unmarshal(e.Data.Object, &i)
+	if err!=nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+// Verifies a webhook delivery was signed with our secret and
+// parses the event it contains.
+//
+// signature is the contents of the Stripe-Signature header.
+func (merch *Merch) ParseWebhook(payload []byte,
+	signature string, at time.Time) (*Event, error) {
+
+	return parseWebhook(payload, signature, merch.webhookSecret, at)
+
+}
+
+func parseWebhook(payload []byte,
+	signature, secret string, at time.Time) (*Event, error) {
+
+	if secret == "" {
+		return nil, fmt.Errorf("no webhook secret configured")
+	}
+
+	err:= VerifySignature(payload, signature, secret, at)
+	if err!=nil {
+		return nil, err
+	}
+
+	var e Event
+	err = json.Unmarshal(payload, &e)
+	if err!=nil {
+		return nil, err
+	}
+	if e.ID == "" || e.Type == "" {
+		return nil, fmt.Errorf("malformed event")
+	}
+
+	return &e, nil
+
+}
+
+// Checks a Stripe-Signature header of the form
+// 't=<unix time>,v1=<hex hmac>[,v1=...]' against the payload.
+func VerifySignature(payload []byte, signature, secret string,
+	at time.Time) error {
+
+	var timestamp string
+	var candidates []string
+	for _, part:= range strings.Split(signature, ","){
+		kv:= strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			candidates = append(candidates, kv[1])
+		}
+	}
+	if timestamp == "" || len(candidates) == 0 {
+		return ErrBadSignature
+	}
+
+	signedAt, err:= strconv.ParseInt(timestamp, 10, 64)
+	if err!=nil {
+		return ErrBadSignature
+	}
+	age:= at.Sub(time.Unix(signedAt, 0))
+	if age > SignatureTolerance || age < -SignatureTolerance {
+		return ErrBadSignature
+	}
+
+	expected:= computeSignature(payload, timestamp, secret)
+	for _, c:= range candidates{
+		provided, err:= hex.DecodeString(c)
+		if err!=nil {
+			continue
+		}
+		if hmac.Equal(expected, provided) {
+			return nil
+		}
+	}
+
+	return ErrBadSignature
+
+}
+
+// Produces a Stripe-Signature header for a payload.
+//
+// Useful for replaying recorded fixtures against a local server.
+func SignWebhook(payload []byte, secret string, at time.Time) string {
+	timestamp:= strconv.FormatInt(at.Unix(), 10)
+	signature:= computeSignature(payload, timestamp, secret)
+
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(signature))
+}
+
+func computeSignature(payload []byte, timestamp, secret string) []byte {
+	mac:= hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,128 @@
+package getPaid
+
+import(
+
+	"testing"
+
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+)
+
+const testSecret string = "whsec_testing"
+
+func readFixture(t *testing.T, name string) []byte {
+	payload, err:= ioutil.ReadFile(filepath.Join("testdata", name))
+	if err!=nil {
+		t.Fatal("failed to read fixture", name, err)
+	}
+
+	return payload
+}
+
+// Sign and verify each recorded event then ensure the fields we
+// rely upon come out the other side.
+func TestWebhookFixtures(t *testing.T) {
+
+	merch:= &Merch{webhookSecret: testSecret}
+	now:= time.Now()
+
+	payload:= readFixture(t, "subUpdated.json")
+	e, err:= merch.ParseWebhook(payload,
+		SignWebhook(payload, testSecret, now), now)
+	if err!=nil {
+		t.Fatal("failed to parse signed event", err)
+	}
+	if e.Type != SubUpdated || e.CreatedAt().Unix() != 1445300000 {
+		t.Fatal("event metadata parsed incorrectly", e)
+	}
+	s, err:= e.Sub()
+	if err!=nil {
+		t.Fatal("failed to parse subscription", err)
+	}
+	if s.ID != "sub_7EL8ba7ZK7zOkk" || s.Customer != "cus_7EL8OTC4cc3Lyj" ||
+		s.Status != StatusActive || s.Plan.ID != "Preordain" {
+		t.Fatal("subscription parsed incorrectly", s)
+	}
+
+	payload = readFixture(t, "subDeleted.json")
+	e, err = merch.ParseWebhook(payload,
+		SignWebhook(payload, testSecret, now), now)
+	if err!=nil {
+		t.Fatal("failed to parse signed event", err)
+	}
+	s, err = e.Sub()
+	if err!=nil || e.Type != SubDeleted || s.Status != StatusCanceled {
+		t.Fatal("deleted subscription parsed incorrectly", err)
+	}
+
+	payload = readFixture(t, "invoicePaymentFailed.json")
+	e, err = merch.ParseWebhook(payload,
+		SignWebhook(payload, testSecret, now), now)
+	if err!=nil {
+		t.Fatal("failed to parse signed event", err)
+	}
+	i, err:= e.Invoice()
+	if err!=nil || e.Type != InvoicePaymentFailed {
+		t.Fatal("invoice parsed incorrectly", err)
+	}
+	if i.Paid || i.NextPaymentAttempt != nil ||
+		i.Subscription != "sub_7EL8ba7ZK7zOkk" {
+		t.Fatal("final failed invoice parsed incorrectly", i)
+	}
+
+	payload = readFixture(t, "invoicePaymentRetrying.json")
+	e, err = merch.ParseWebhook(payload,
+		SignWebhook(payload, testSecret, now), now)
+	if err!=nil {
+		t.Fatal("failed to parse signed event", err)
+	}
+	i, err = e.Invoice()
+	if err!=nil || i.NextPaymentAttempt == nil {
+		t.Fatal("retrying invoice parsed incorrectly", err)
+	}
+
+}
+
+// Ensure deliveries we didn't sign, or that are too old, are rejected.
+func TestWebhookSignature(t *testing.T) {
+
+	merch:= &Merch{webhookSecret: testSecret}
+	now:= time.Now()
+	payload:= readFixture(t, "subUpdated.json")
+
+	_, err:= merch.ParseWebhook(payload,
+		SignWebhook(payload, "whsec_someoneElse", now), now)
+	if err != ErrBadSignature {
+		t.Fatal("accepted a foreign signature", err)
+	}
+
+	tampered:= append([]byte{}, payload...)
+	tampered[len(tampered) - 3] = ' '
+	_, err = merch.ParseWebhook(tampered,
+		SignWebhook(payload, testSecret, now), now)
+	if err != ErrBadSignature {
+		t.Fatal("accepted a tampered payload", err)
+	}
+
+	stale:= now.Add(-2 * SignatureTolerance)
+	_, err = merch.ParseWebhook(payload,
+		SignWebhook(payload, testSecret, stale), now)
+	if err != ErrBadSignature {
+		t.Fatal("accepted a stale signature", err)
+	}
+
+	_, err = merch.ParseWebhook(payload, "", now)
+	if err != ErrBadSignature {
+		t.Fatal("accepted a missing signature", err)
+	}
+
+	// Stripe sends multiple signatures while rolling secrets
+	rolling:= SignWebhook(payload, testSecret, now) + ",v1=deadbeef"
+	_, err = merch.ParseWebhook(payload, rolling, now)
+	if err!=nil {
+		t.Fatal("rejected a valid signature among several", err)
+	}
+
+}
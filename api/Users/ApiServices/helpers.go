@@ -4,6 +4,8 @@ import(
 
 	"io/ioutil"
 	"strings"
+	"strconv"
+	"time"
 
 	"io"
 	"log"
@@ -12,6 +14,7 @@ import(
 
 	"github.com/emicklei/go-restful"
 
+	"net"
 	"net/http"
 
 )
@@ -31,10 +34,20 @@ func RecoverHandler(issue interface{}, writer http.ResponseWriter) {
 
 }
 
+// Acquires the user's name and their session key.
+//
+// A key authenticated by the sessionFilter is preferred, otherwise
+// the deprecated SessionKeyBody is read from the request.
 func getUserNameAndSessionKey(req *restful.Request) (userName string,
 	sessionKey []byte, err error) {
 
 	userName = req.PathParameter("userName")
+
+	sessionKey = getFilteredSessionKey(req)
+	if sessionKey != nil {
+		return
+	}
+
 	var sessionKeyContainer SessionKeyBody
 	err = req.ReadEntity(&sessionKeyContainer)
 	if err!=nil {
@@ -79,8 +92,21 @@ func getManagerName() (string, error) {
 	return cleanedName, nil
 }
 
+// Tells a client how long to wait before trying again
+func setRetryAfter(resp *restful.Response, wait time.Duration) {
+	seconds:= int(wait / time.Second) + 1
+	resp.AddHeader("Retry-After", strconv.Itoa(seconds))
+}
+
+// The address a request came from without its port, IPv6 addresses
+// included.
 func getIP(req *restful.Request) string {
-	return strings.Split(req.Request.RemoteAddr, ":")[0]
+	host, _, err:= net.SplitHostPort(req.Request.RemoteAddr)
+	if err!=nil {
+		return req.Request.RemoteAddr
+	}
+
+	return host
 }
 
 func GetLogger(fName, name string) (aLogger *log.Logger) {
@@ -1,4 +1,4 @@
-// Provides validation for recaptcha 2.0
+// Provides validation for recaptcha 2.0 and compatible captchas
 package recaptcha
 
 import(
@@ -8,23 +8,55 @@ import(
 
 	"io/ioutil"
 	"encoding/json"
+	"fmt"
 
 )
 
 const verifyEndpoint string = "https://www.google.com/recaptcha/api/siteverify"
+const hCaptchaEndpoint string = "https://hcaptcha.com/siteverify"
 
-// A recaptcha 2.0 validator.
-type Validator struct{
+// Which Validator a captcha's metadata selects
+const ProviderRecaptcha string = "recaptcha"
+const ProviderHCaptcha string = "hcaptcha"
+const ProviderPass string = "pass"
+const ProviderFail string = "fail"
+
+// Decides whether a captcha response proves a human is present.
+type Validator interface{
+	Validate(response string) (bool, error)
+}
+
+// A validator for any provider speaking recaptcha 2.0's siteverify
+// protocol, which hCaptcha deliberately mirrors.
+type SiteVerifier struct{
+	endpoint string
 	priv string
 }
 
 // Builds a recaptcha validator using a provided private key
-func GetValidator(priv string) *Validator {
-	return &Validator{priv}
+func GetValidator(priv string) *SiteVerifier {
+	return &SiteVerifier{verifyEndpoint, priv}
+}
+
+// Builds an hCaptcha validator using a provided secret key
+func GetHCaptchaValidator(priv string) *SiteVerifier {
+	return &SiteVerifier{hCaptchaEndpoint, priv}
+}
+
+// A validator that never asks anyone, for development and testing.
+type Stub struct{
+	Pass bool
+}
+
+func (stub Stub) Validate(response string) (bool, error) {
+	return stub.Pass, nil
 }
 
-// Builds a recaptcha validator from a json file of structure recaptchaMeta
-func GetValidatorFromFile(loc string) (*Validator, error) {
+var _ Validator = &SiteVerifier{}
+var _ Validator = Stub{}
+
+// Builds a validator from a json file of structure recaptchaMeta
+func GetValidatorFromFile(loc string) (Validator, error) {
 	metaRaw, err:= ioutil.ReadFile(loc)
 	if err!=nil {
 		return nil, err
@@ -36,18 +68,33 @@ func GetValidatorFromFile(loc string) (*Validator, error) {
 		return nil, err	
 	}
 
-	return GetValidator(meta.Private), nil
+	switch meta.Provider {
+	// Metadata predating providers is always recaptcha
+	case ProviderRecaptcha, "":
+		return GetValidator(meta.Private), nil
+	case ProviderHCaptcha:
+		return GetHCaptchaValidator(meta.Private), nil
+	case ProviderPass:
+		return Stub{true}, nil
+	case ProviderFail:
+		return Stub{false}, nil
+	}
+
+	return nil, fmt.Errorf("unknown captcha provider %s", meta.Provider)
 }
 
+// Provider is one of ProviderRecaptcha, ProviderHCaptcha, ProviderPass,
+// or ProviderFail. Private is unused by the stubs.
 type recaptchaMeta struct{
+	Provider string
 	Private string
 }
 
-// Returns whether or not a recaptcha response was valid.
+// Returns whether or not a captcha response was valid.
 //
 // Defaults to the ip as localhost so we need not tie a domain name to this.
-func (validator *Validator) Validate(response string) (bool, error) {
-	resp, err:= http.PostForm(verifyEndpoint, url.Values{
+func (validator *SiteVerifier) Validate(response string) (bool, error) {
+	resp, err:= http.PostForm(validator.endpoint, url.Values{
 		"secret": {validator.priv},
 		"response": {response},
 		})
@@ -0,0 +1,105 @@
+package recaptcha
+
+import(
+
+	"testing"
+
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+)
+
+// Stands in for a siteverify endpoint accepting a single response
+func fakeSiteVerify(secret, valid string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			success:= r.PostFormValue("secret") == secret &&
+				r.PostFormValue("response") == valid
+			if success {
+				w.Write([]byte(`{"success": true}`))
+			}else{
+				w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+			}
+		}))
+}
+
+func TestSiteVerifier(t *testing.T) {
+
+	server:= fakeSiteVerify("secret", "human")
+	defer server.Close()
+
+	validator:= &SiteVerifier{server.URL, "secret"}
+
+	valid, err:= validator.Validate("human")
+	if err!=nil || !valid {
+		t.Fatal("rejected a valid response", err)
+	}
+
+	valid, err = validator.Validate("robot")
+	if err!=nil || valid {
+		t.Fatal("accepted an invalid response", err)
+	}
+
+	validator.priv = "wrong"
+	valid, err = validator.Validate("human")
+	if err!=nil || valid {
+		t.Fatal("accepted a response with the wrong secret", err)
+	}
+
+}
+
+func TestValidatorFromFile(t *testing.T) {
+
+	dir, err:= ioutil.TempDir("", "captchaMeta")
+	if err!=nil {
+		t.Fatal("failed to make directory", err)
+	}
+	defer os.RemoveAll(dir)
+
+	load:= func(meta string) (Validator, error) {
+		loc:= filepath.Join(dir, "meta.json")
+		err:= ioutil.WriteFile(loc, []byte(meta), 0600)
+		if err!=nil {
+			t.Fatal("failed to write meta", err)
+		}
+		return GetValidatorFromFile(loc)
+	}
+
+	v, err:= load(`{"Private": "foo"}`)
+	if s, ok:= v.(*SiteVerifier); err!=nil || !ok ||
+		s.endpoint != verifyEndpoint {
+		t.Fatal("legacy meta did not select recaptcha", v, err)
+	}
+
+	v, err = load(`{"Provider": "hcaptcha", "Private": "foo"}`)
+	if s, ok:= v.(*SiteVerifier); err!=nil || !ok ||
+		s.endpoint != hCaptchaEndpoint {
+		t.Fatal("failed to select hcaptcha", v, err)
+	}
+
+	v, err = load(`{"Provider": "pass"}`)
+	if err!=nil {
+		t.Fatal("failed to select stub", err)
+	}
+	valid, _:= v.Validate("")
+	if !valid {
+		t.Fatal("pass stub failed")
+	}
+
+	v, err = load(`{"Provider": "fail"}`)
+	if err!=nil {
+		t.Fatal("failed to select stub", err)
+	}
+	valid, _ = v.Validate("human")
+	if valid {
+		t.Fatal("fail stub passed")
+	}
+
+	_, err = load(`{"Provider": "nope"}`)
+	if err==nil {
+		t.Fatal("accepted an unknown provider")
+	}
+
+}
@@ -0,0 +1,210 @@
+// Handler level tests driving the service over http against a real
+// database and a fake merchant.
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+	"./mailer"
+	"./goGetPaid"
+	"./recaptcha"
+
+	"github.com/emicklei/go-restful"
+
+	"testing"
+
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+)
+
+const testWebhookSecret string = "whsec_testing"
+
+const testTemplateDir string = "../templates/"
+
+var testService *UserService
+var testContainer *restful.Container
+var testMerch *getPaid.FakeMerch
+var testMailDir string
+
+func TestMain(m *testing.M){
+
+	pool, err:= userDB.Connect()
+	if err!=nil {
+		fmt.Println("encountered error initializing connection pool,", err)
+		os.Exit(1)
+	}
+
+	testMailer, err:= getTestMailer()
+	if err!=nil {
+		fmt.Println("encountered error preparing templates,", err)
+		os.Exit(1)
+	}
+
+	testMerch = getPaid.NewFakeMerch(testWebhookSecret)
+	testMerch.Prices["Preordain"] = 500
+	testMerch.Prices["Sensei's Top"] = 1000
+
+	testService = &UserService{
+		pool: pool,
+		logger: log.New(ioutil.Discard, "", 0),
+		mailer: testMailer,
+		validator: recaptcha.Stub{Pass: true},
+		merch: testMerch,
+	}
+
+	err = testService.register()
+	if err!=nil {
+		fmt.Println("encountered error registering service,", err)
+		os.Exit(1)
+	}
+
+	err = testService.registerAdmin()
+	if err!=nil {
+		fmt.Println("encountered error registering admin service,", err)
+		os.Exit(1)
+	}
+
+	testContainer = restful.NewContainer()
+	testContainer.Add(testService.Service)
+	testContainer.Add(testService.Admin)
+
+	code:= m.Run()
+	os.RemoveAll(testMailDir)
+	os.Exit(code)
+
+}
+
+// A mailer with every template prepared that writes each message
+// to testMailDir.
+func getTestMailer() (*mailer.Mailer, error) {
+	var err error
+	testMailDir, err = ioutil.TempDir("", "userServiceMail")
+	if err!=nil {
+		return nil, err
+	}
+
+	sender, err:= mailer.NewFileSender(testMailDir)
+	if err!=nil {
+		return nil, err
+	}
+
+	m:= mailer.NewMailer(sender, "testing@example.invalid")
+
+	templates:= map[string]string{
+		"reset": "resetCode.txt.template",
+		"subSuccess": "subSuccess.txt.template",
+		"unSubSuccess": "unSubSuccess.txt.template",
+		"verify": "verifyEmail.txt.template",
+		"accountDeleted": "accountDeleted.txt.template",
+	}
+	for id, loc:= range templates{
+		err:= m.Prepare(id, testTemplateDir + loc)
+		if err!=nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Performs a request against the service, authenticated if sessionKey
+// is non-nil.
+func doRequest(t *testing.T, method, path string, sessionKey []byte,
+	body interface{}) *httptest.ResponseRecorder {
+	return doRequestOn(t, testContainer, method, path, sessionKey, body)
+}
+
+// Performs a request as doRequest against some other container.
+func doRequestOn(t *testing.T, container *restful.Container,
+	method, path string, sessionKey []byte,
+	body interface{}) *httptest.ResponseRecorder {
+
+	var encoded []byte
+	if body != nil {
+		var err error
+		encoded, err = json.Marshal(body)
+		if err!=nil {
+			t.Fatal("failed to encode body", err)
+		}
+	}
+
+	req, err:= http.NewRequest(method, "/api/Users" + path,
+		bytes.NewReader(encoded))
+	if err!=nil {
+		t.Fatal("failed to build request", err)
+	}
+	req.Header.Set("Content-Type", restful.MIME_JSON)
+	req.RemoteAddr = randAddr()
+	if sessionKey != nil {
+		req.Header.Set(authHeader,
+			bearerPrefix + base64.StdEncoding.EncodeToString(sessionKey))
+	}
+
+	recorder:= httptest.NewRecorder()
+	container.ServeHTTP(recorder, req)
+
+	return recorder
+
+}
+
+// A url safe random name
+func randName() string {
+	b:= make([]byte, 12)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// A random IPv6 client address, so throttle tests can't lock each
+// other out through a shared ip
+func randAddr() string {
+	b:= make([]byte, 4)
+	rand.Read(b)
+	return "[2001:db8::" + hex.EncodeToString(b[:2]) + ":" +
+		hex.EncodeToString(b[2:]) + "]:443"
+}
+
+// Adds a fresh user, returning their name and a session key
+func addTestUser(t *testing.T) (string, []byte) {
+	name:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", randName())
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	return name, sessionKey
+}
+
+// Acquires every message sent to a user so far
+//
+// Anything still due in the outbox is delivered first.
+func sentTo(t *testing.T, name string) []mailer.Message {
+	err:= testService.deliverMail()
+	if err!=nil {
+		t.Fatal("failed to deliver outbox", err)
+	}
+
+	messages, err:= mailer.ReadMessages(testMailDir)
+	if err!=nil {
+		t.Fatal("failed to read sent mail", err)
+	}
+
+	var theirs []mailer.Message
+	for _, m:= range messages{
+		if strings.HasPrefix(m.To, name + "<") {
+			theirs = append(theirs, m)
+		}
+	}
+
+	return theirs
+}
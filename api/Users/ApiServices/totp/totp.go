@@ -0,0 +1,132 @@
+// Provides time based one time passwords as described by RFC 6238.
+//
+// Codes are compatible with common authenticator apps; SHA1, six digits,
+// and a thirty second period.
+package totp
+
+import(
+
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/rand"
+	"crypto/subtle"
+
+	"encoding/base32"
+	"encoding/binary"
+
+	"net/url"
+
+	"strings"
+	"fmt"
+	"io"
+	"time"
+
+)
+
+// How many digits a code contains
+const Digits int = 6
+
+// How long a single code is valid for
+const Period time.Duration = 30 * time.Second
+
+// How many periods either side of now we accept to allow for clock drift
+const Skew int = 1
+
+// Length of a generated secret, 160 bits as recommended by RFC 4226
+const secretLength int = 20
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generates a fresh base32 encoded secret suitable for enrolment.
+func GenerateSecret() (string, error) {
+	raw:= make([]byte, secretLength)
+	_, err:= io.ReadFull(rand.Reader, raw)
+	if err!=nil {
+		return "", fmt.Errorf("failed to acquire entropy")
+	}
+
+	return encoding.EncodeToString(raw), nil
+}
+
+// Decodes a base32 secret as provided by GenerateSecret or typed
+// in by a human.
+func decodeSecret(secret string) ([]byte, error) {
+	cleaned:= strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	cleaned = strings.TrimRight(cleaned, "=")
+	return encoding.DecodeString(cleaned)
+}
+
+// Returns the counter, the number of periods since epoch, for a time.
+func Counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(Period / time.Second))
+}
+
+// Computes the code for a key at a given counter as per RFC 4226
+func codeAt(key []byte, counter uint64) string {
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac:= hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum:= mac.Sum(nil)
+
+	// Dynamic truncation
+	offset:= sum[len(sum)-1] & 0xf
+	value:= binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod:= uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value % mod)
+}
+
+// Returns the code valid for a secret at a given time.
+func Code(secret string, t time.Time) (string, error) {
+	key, err:= decodeSecret(secret)
+	if err!=nil {
+		return "", err
+	}
+
+	return codeAt(key, Counter(t)), nil
+}
+
+// Checks whether a code is valid for the secret at the given time.
+//
+// The matching counter is returned so callers can refuse to accept
+// the same code twice.
+func Validate(secret, code string, t time.Time) (uint64, bool) {
+
+	key, err:= decodeSecret(secret)
+	if err!=nil || len(code) != Digits {
+		return 0, false
+	}
+
+	now:= Counter(t)
+	for i := -Skew; i <= Skew; i++ {
+		counter:= uint64(int64(now) + int64(i))
+		expected:= codeAt(key, counter)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+// Builds an otpauth:// uri that authenticator apps can import,
+// usually through a QR code.
+func ProvisioningURI(secret, issuer, account string) string {
+
+	label:= url.PathEscape(issuer + ":" + account)
+
+	params:= url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int(Period / time.Second)))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
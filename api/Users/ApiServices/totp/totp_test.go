@@ -0,0 +1,122 @@
+package totp
+
+import(
+
+	"testing"
+
+	"strings"
+	"time"
+
+)
+
+// The RFC 6238 SHA1 seed, "12345678901234567890", as base32
+const rfcSecret string = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 appendix B vectors truncated to our six digits
+var rfcVectors = []struct{
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func TestCodeVectors(t *testing.T) {
+
+	for _, v:= range rfcVectors{
+		code, err:= Code(rfcSecret, time.Unix(v.unix, 0))
+		if err!=nil {
+			t.Fatal("failed to generate code", err)
+		}
+		if code != v.code {
+			t.Fatal("code mismatch at", v.unix, code, "=/=", v.code)
+		}
+	}
+
+}
+
+func TestValidateSkew(t *testing.T) {
+
+	clock:= time.Unix(1111111109, 0)
+
+	code, err:= Code(rfcSecret, clock)
+	if err!=nil {
+		t.Fatal("failed to generate code", err)
+	}
+
+	// A period either side is accepted
+	for _, offset:= range []time.Duration{-Period, 0, Period}{
+		counter, ok:= Validate(rfcSecret, code, clock.Add(offset))
+		if !ok {
+			t.Fatal("rejected code within skew at offset", offset)
+		}
+		if counter != Counter(clock) {
+			t.Fatal("validated against the wrong counter")
+		}
+	}
+
+	// But no further
+	for _, offset:= range []time.Duration{-2 * Period, 2 * Period}{
+		_, ok:= Validate(rfcSecret, code, clock.Add(offset))
+		if ok {
+			t.Fatal("accepted code outside of skew at offset", offset)
+		}
+	}
+
+}
+
+func TestValidateGarbage(t *testing.T) {
+
+	clock:= time.Unix(59, 0)
+
+	for _, code:= range []string{"", "28708", "2870822", "abcdef"}{
+		_, ok:= Validate(rfcSecret, code, clock)
+		if ok {
+			t.Fatal("accepted malformed code", code)
+		}
+	}
+
+	_, ok:= Validate("not base32!", "287082", clock)
+	if ok {
+		t.Fatal("accepted code for malformed secret")
+	}
+
+}
+
+func TestGeneratedSecret(t *testing.T) {
+
+	secret, err:= GenerateSecret()
+	if err!=nil {
+		t.Fatal("failed to generate secret", err)
+	}
+
+	clock:= time.Unix(1234567890, 0)
+	code, err:= Code(secret, clock)
+	if err!=nil {
+		t.Fatal("generated secret failed to decode", err)
+	}
+
+	_, ok:= Validate(strings.ToLower(secret), code, clock)
+	if !ok {
+		t.Fatal("failed to validate code for generated secret")
+	}
+
+}
+
+func TestProvisioningURI(t *testing.T) {
+
+	uri:= ProvisioningURI(rfcSecret, "Preorda.in", "everlag")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/Preorda.in:everlag?") {
+		t.Fatal("malformed uri label", uri)
+	}
+	if !strings.Contains(uri, "secret="+rfcSecret) ||
+	   !strings.Contains(uri, "issuer=Preorda.in") {
+		t.Fatal("uri missing parameters", uri)
+	}
+
+}
@@ -0,0 +1,137 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"./mailer"
+
+	"github.com/emicklei/go-restful"
+
+	"net/http"
+	"time"
+
+)
+
+// The contents of an account deletion email formatted to match the template.
+type deletedEmailContents struct{
+	Name string
+}
+
+// Hands a user everything we hold about them as a JSON archive.
+//
+// Only plans with export access may.
+func (aService *UserService) exportUser(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	p, err:= userDB.GetUserPlan(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+	if !p.Export {
+		resp.WriteErrorString(http.StatusForbidden, ExportNotIncluded)
+		return
+	}
+
+	export, err:= userDB.ExportUser(aService.pool, userName)
+	if err!=nil {
+		aService.logger.Println("failed to export user", userName, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	resp.AddHeader("Content-Disposition",
+		"attachment; filename=\"preordain-" + userName + ".json\"")
+	resp.WriteEntity(export)
+
+}
+
+// Deletes a user's account after cancelling their billing.
+//
+// A stolen session alone isn't enough, the password is required as
+// it is for an email change. The confirmation email is queued along
+// with the deletion so it only goes out once everything is gone.
+func (aService *UserService) deleteUser(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	var passwordContainer PasswordBody
+	err:= req.ReadEntity(&passwordContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	// Guesses at the password count towards the login throttle
+	ip:= getIP(req)
+	now:= time.Now()
+	if !aService.loginAllowed(resp, userName, ip, now) {
+		return
+	}
+	valid, err:= userDB.PasswordAuthUser(aService.pool,
+		userName, passwordContainer.Password)
+	if err!=nil || !valid {
+		aService.recordLoginFailure(userName, ip, now)
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
+	// Compose before touching billing, once that's cancelled the user
+	// must hear from us.
+	contents:= deletedEmailContents{
+		Name: userName,
+	}
+	targetAddress:= mailer.FormatAddress(userName, u.Email)
+	m, err:= aService.composeMail("accountDeleted", u.Locale, contents,
+		targetAddress, "Account Deleted - Preorda.in")
+	if err!=nil {
+		aService.logger.Println("failed to compose email", err)
+		resp.WriteErrorString(http.StatusInternalServerError, MailFailure)
+		return
+	}
+
+	sub, err:= userDB.GetSub(aService.pool, userName, nil)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
+	// Stop charging them before they disappear from our side
+	if sub.SubID != userDB.DefaultID {
+		err = aService.merch.UnSubCustomer(sub.SubID, sub.CustomerID)
+		if err!=nil {
+			resp.WriteErrorString(http.StatusBadRequest, StripeSubFailure)
+			return
+		}
+	}
+
+	err = userDB.DeleteUserMail(aService.pool, userName, m)
+	if err!=nil {
+		aService.logger.Println("failed to delete user", userName, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	aService.logger.Println("deleted user", userName)
+
+	resp.WriteEntity(true)
+
+}
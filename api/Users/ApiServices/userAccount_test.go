@@ -0,0 +1,126 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"testing"
+
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Export a subscribed user then delete them, ensuring billing is
+// cancelled and a confirmation is sent.
+func TestExportAndDeleteUser(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	resp:= doRequest(t, "GET", "/" + name + "/Export", sessionKey, nil)
+	if resp.Code != http.StatusForbidden {
+		t.Fatal("exported on a plan without exports", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to subscribe", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "GET", "/" + name + "/Export", sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to export", resp.Code, resp.Body.String())
+	}
+	if !strings.Contains(resp.Header().Get("Content-Disposition"),
+		"attachment") {
+		t.Fatal("export was not an attachment")
+	}
+	var export userDB.Export
+	err = json.Unmarshal(resp.Body.Bytes(), &export)
+	if err!=nil {
+		t.Fatal("failed to decode export", err)
+	}
+	if export.Name != name || export.Sub == nil ||
+		export.Sub.Plan != "Preordain" {
+		t.Fatal("export was incomplete", export)
+	}
+
+	resp = doRequest(t, "DELETE", "/" + name, sessionKey,
+		PasswordBody{Password: "notTheirPassword"})
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("deleted without the password", resp.Code)
+	}
+
+	resp = doRequest(t, "DELETE", "/" + name, sessionKey,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to delete", resp.Code, resp.Body.String())
+	}
+
+	fakeSub, _:= testMerch.Sub(export.Sub.SubID)
+	if !fakeSub.Cancelled {
+		t.Fatal("deletion did not cancel billing")
+	}
+
+	_, err = userDB.GetUser(testService.pool, name)
+	if err==nil {
+		t.Fatal("user still exists")
+	}
+
+	sent:= sentTo(t, name)
+	if len(sent) == 0 ||
+		sent[len(sent) - 1].Subject != "Account Deleted - Preorda.in" {
+		t.Fatal("deletion was not confirmed by email", sent)
+	}
+
+	// Their session died with them
+	resp = doRequest(t, "GET", "/" + name + "/Export", sessionKey, nil)
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("exported a deleted user", resp.Code)
+	}
+
+}
+
+// Deleting checks the password so it shares the login throttle,
+// otherwise a session would allow unlimited guesses at it.
+func TestDeleteUserThrottled(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	for i:= int32(0); i < userDB.NameLockoutThreshold; i++ {
+		resp:= doRequest(t, "DELETE", "/" + name, sessionKey,
+			PasswordBody{Password: "notTheirPassword"})
+		if resp.Code != http.StatusUnauthorized {
+			t.Fatal("deleted without the password", i, resp.Code)
+		}
+	}
+
+	resp:= doRequest(t, "DELETE", "/" + name, sessionKey,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusTooManyRequests {
+		t.Fatal("password guesses were not throttled", resp.Code)
+	}
+
+	_, err = userDB.GetUser(testService.pool, name)
+	if err!=nil {
+		t.Fatal("user deleted while locked out", err)
+	}
+
+}
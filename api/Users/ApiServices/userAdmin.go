@@ -0,0 +1,615 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"./mailer"
+
+	"github.com/emicklei/go-restful"
+
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+)
+
+// Where the admin the adminFilter authenticated is stashed on the
+// request for handlers to retrieve.
+const adminAttribute string = "admin"
+
+const adminAuthHeaderDoc string = "Bearer <admin key>, entirely separate from user sessions"
+
+// How many users a search returns without asking for a specific amount.
+const defaultUserSearch int = 20
+
+// Builds a filter authenticating requests carrying a bearer admin key
+// whose role permits perm.
+//
+// Admin keys are checked against users.admins only, a user session
+// is never accepted here.
+func (aService *UserService) adminFilter(perm string) restful.FilterFunction {
+
+	return func(req *restful.Request, resp *restful.Response,
+		chain *restful.FilterChain) {
+
+		header:= req.HeaderParameter(authHeader)
+		if !strings.HasPrefix(header, bearerPrefix) {
+			resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+			return
+		}
+
+		key:= strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+		admin, err:= userDB.AdminAuth(aService.pool, key)
+		if err!=nil {
+			resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+			return
+		}
+
+		if !admin.Can(perm) {
+			resp.WriteErrorString(http.StatusForbidden, NotPermitted)
+			return
+		}
+
+		// Nothing seen by staff belongs in a shared cache
+		setPrivateHeader(resp)
+
+		req.SetAttribute(adminAttribute, admin)
+
+		chain.ProcessFilter(req, resp)
+
+	}
+
+}
+
+// Returns the admin the adminFilter authenticated.
+func getFilteredAdmin(req *restful.Request) *userDB.Admin {
+	admin, ok:= req.Attribute(adminAttribute).(*userDB.Admin)
+	if !ok {
+		return nil
+	}
+
+	return admin
+}
+
+// Records an admin action before it's taken.
+//
+// Unlike audit, failing to record is fatal to the request; nothing
+// is done through the admin service without a trace.
+func (aService *UserService) adminAudit(req *restful.Request,
+	resp *restful.Response, action, target string,
+	metadata map[string]string) bool {
+
+	admin:= getFilteredAdmin(req)
+	if admin == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return false
+	}
+
+	err:= userDB.RecordAdminAction(aService.pool, userDB.AdminAction{
+		Admin: admin.Name,
+		Action: action,
+		Target: target,
+		Metadata: metadata,
+		At: time.Now(),
+	})
+	if err!=nil {
+		aService.logger.Println("failed to record admin action", action,
+			"by", admin.Name, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return false
+	}
+
+	return true
+
+}
+
+// Records a change made by staff in the affected user's own audit log.
+func (aService *UserService) auditAdmin(req *restful.Request,
+	userName, event string, metadata map[string]string) {
+
+	aService.auditAs(userDB.ActorAdmin, getIP(req), userName, event,
+		metadata)
+
+}
+
+// Finds users whose name or email contain the provided query.
+func (aService *UserService) adminSearchUsers(req *restful.Request,
+	resp *restful.Response) {
+
+	query:= strings.TrimSpace(req.QueryParameter("q"))
+	if query == "" {
+		resp.WriteErrorString(http.StatusBadRequest, BadQuery)
+		return
+	}
+
+	limit:= defaultUserSearch
+	if raw:= req.QueryParameter("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err!=nil || limit < 1 || limit > userDB.MaxUserSearch {
+			resp.WriteErrorString(http.StatusBadRequest, BadLimit)
+			return
+		}
+	}
+
+	if !aService.adminAudit(req, resp, userDB.AdminSearch, query, nil) {
+		return
+	}
+
+	users, err:= userDB.SearchUsers(aService.pool, query, limit)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	resp.WriteEntity(users)
+
+}
+
+// Shows support a user alongside their subscription and collections.
+func (aService *UserService) adminGetUser(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+
+	if !aService.adminAudit(req, resp, userDB.AdminView, userName, nil) {
+		return
+	}
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, BadUserName)
+		return
+	}
+
+	view:= AdminUserView{
+		Name: u.Name,
+		Email: u.Email,
+		Verified: u.Verified,
+		Disabled: u.Disabled,
+		Locale: u.Locale,
+		MaxCollections: u.MaxCollections,
+		Longestview: u.Longestview,
+	}
+
+	view.TwoFactorEnabled, err = userDB.TwoFactorEnabled(aService.pool,
+		userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	view.Sub, err = userDB.GetSub(aService.pool, userName, nil)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	view.Collections, err = userDB.GetCollectionList(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	resp.WriteEntity(view)
+
+}
+
+// Moves a user to another plan.
+//
+// Users billed through stripe have their subscription changed there,
+// or cancelled for the default plan. Anyone else is given the plan
+// without being billed for it.
+func (aService *UserService) adminSetPlan(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	var planContainer AdminPlanBody
+	err:= req.ReadEntity(&planContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	_, err = userDB.GetPlan(aService.pool, planContainer.Plan)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
+	sub, err:= userDB.GetSub(aService.pool, userName, nil)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, BadUserName)
+		return
+	}
+
+	// Make sure stripe isn't told about a change that does nothing
+	validChoice, err:= userDB.DifferentPlan(aService.pool,
+		userName, planContainer.Plan)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+	if !validChoice {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
+	if !aService.adminAudit(req, resp, userDB.AdminSetPlan, userName,
+		map[string]string{
+			"plan": planContainer.Plan,
+			"previous": sub.Plan,
+		}) {
+		return
+	}
+
+	subID:= sub.SubID
+	if subID != userDB.DefaultID {
+		if planContainer.Plan == userDB.DefaultSubLevel {
+			err = aService.merch.UnSubCustomer(sub.SubID, sub.CustomerID)
+			subID = userDB.DefaultID
+		}else{
+			err = aService.merch.UpdateSubCustomer(sub.CustomerID,
+				sub.SubID, planContainer.Plan)
+		}
+		if err!=nil {
+			resp.WriteErrorString(http.StatusBadRequest, StripeSubFailure)
+			return
+		}
+	}
+
+	err = userDB.ModSub(aService.pool, userName, planContainer.Plan,
+		sub.CustomerID, subID, nil)
+	if err!=nil {
+		aService.logger.Println("admin plan change diverged from stripe",
+			userName, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	aService.auditAdmin(req, userName, userDB.AuditSubChanged,
+		map[string]string{"plan": planContainer.Plan})
+
+	resp.WriteEntity(true)
+
+}
+
+// Overrides how many collections a user may have.
+//
+// Changing their plan afterwards resets this to the plan's limit.
+func (aService *UserService) adminSetMaxCollections(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	var maxContainer MaxCollectionsBody
+	err:= req.ReadEntity(&maxContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	if maxContainer.MaxCollections < 0 {
+		resp.WriteErrorString(http.StatusBadRequest, BadMaxCollections)
+		return
+	}
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, BadUserName)
+		return
+	}
+
+	metadata:= map[string]string{
+		"maxCollections": strconv.Itoa(int(maxContainer.MaxCollections)),
+		"previous": strconv.Itoa(int(u.MaxCollections)),
+	}
+	if !aService.adminAudit(req, resp, userDB.AdminSetMaxCollections,
+		userName, metadata) {
+		return
+	}
+
+	err = userDB.SetMaxCollections(aService.pool, userName,
+		maxContainer.MaxCollections)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	aService.auditAdmin(req, userName, userDB.AuditMaxCollectionsChanged,
+		map[string]string{"maxCollections": metadata["maxCollections"]})
+
+	resp.WriteEntity(true)
+
+}
+
+// Locks a user out of their account until they complete a reset
+// emailed to them.
+//
+// Their password is replaced and every session ended, for accounts
+// support believes are compromised.
+func (aService *UserService) adminForceReset(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, BadUserName)
+		return
+	}
+
+	if !aService.adminAudit(req, resp, userDB.AdminForceReset,
+		userName, nil) {
+		return
+	}
+
+	// The email is queued alongside the reset it carries
+	targetAddress:= mailer.FormatAddress(userName, u.Email)
+	compose:= func(code string) (*userDB.Mail, error) {
+		contents:= resetEmailContents{
+			Name: userName,
+			ResetCode: code,
+		}
+		return aService.composeMail("reset", u.Locale, contents,
+			targetAddress, "Password Reset - Preorda.in")
+	}
+
+	err = userDB.ForcePasswordReset(aService.pool, userName, compose)
+	if err!=nil {
+		aService.logger.Println("failed to force reset", userName, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	aService.auditAdmin(req, userName, userDB.AuditResetForced, nil)
+
+	resp.WriteEntity(true)
+
+}
+
+// Bars a user from logging in, ending their sessions, or lets them
+// back in.
+func (aService *UserService) adminSetDisabled(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	var disabledContainer DisabledBody
+	err:= req.ReadEntity(&disabledContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	_, err = userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, BadUserName)
+		return
+	}
+
+	disabled:= strconv.FormatBool(disabledContainer.Disabled)
+	if !aService.adminAudit(req, resp, userDB.AdminSetDisabled, userName,
+		map[string]string{"disabled": disabled}) {
+		return
+	}
+
+	err = userDB.SetDisabled(aService.pool, userName,
+		disabledContainer.Disabled)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	event:= userDB.AuditEnabled
+	if disabledContainer.Disabled {
+		event = userDB.AuditDisabled
+	}
+	aService.auditAdmin(req, userName, event, nil)
+
+	resp.WriteEntity(true)
+
+}
+
+// Adds another admin, returning the key they authenticate with.
+//
+// The key is shown exactly once; lose it and the admin needs adding
+// again under another name.
+func (aService *UserService) adminAddAdmin(req *restful.Request,
+	resp *restful.Response) {
+
+	adminName:= req.PathParameter("adminName")
+	var roleContainer AdminRoleBody
+	err:= req.ReadEntity(&roleContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	if !userDB.ValidRole(roleContainer.Role) {
+		resp.WriteErrorString(http.StatusBadRequest, BadRole)
+		return
+	}
+
+	if !aService.adminAudit(req, resp, userDB.AdminAddAdmin, adminName,
+		map[string]string{"role": roleContainer.Role}) {
+		return
+	}
+
+	key, err:= userDB.AddAdmin(aService.pool, adminName, roleContainer.Role)
+	if err==userDB.ErrAdminExists {
+		resp.WriteErrorString(http.StatusBadRequest, BadUserName)
+		return
+	}
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	resp.WriteEntity(key)
+
+}
+
+// Builds the admin service, kept apart from the user facing service
+// so no route there can ever be reached with a user session.
+func (aService *UserService) registerAdmin() error {
+
+	adminService:= new(restful.WebService)
+	adminService.
+		Path("/api/Admin").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+
+	adminService.Route(adminService.
+		GET("/Users").To(aService.adminSearchUsers).
+		Filter(aService.adminFilter(userDB.PermViewUsers)).
+		// Docs
+		Doc("Finds users whose name or email contain the query").
+		Operation("adminSearchUsers").
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Param(adminService.QueryParameter("q",
+			"Matched literally against names and emails").DataType("string")).
+		Param(adminService.QueryParameter("limit",
+			"How many users to return, 20 by default").DataType("integer")).
+		Returns(http.StatusBadRequest, BadQuery, nil).
+		Returns(http.StatusBadRequest, BadLimit, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes([]userDB.UserSummary{}).
+		Returns(http.StatusOK, "Matching users ordered by name", nil))
+
+	adminService.Route(adminService.
+		GET("/Users/{userName}").To(aService.adminGetUser).
+		Filter(aService.adminFilter(userDB.PermViewUsers)).
+		// Docs
+		Doc("Shows a user alongside their subscription and collections").
+		Operation("adminGetUser").
+		Param(adminService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusNotFound, BadUserName, nil).
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes(AdminUserView{}).
+		Returns(http.StatusOK, "The user", nil))
+
+	adminService.Route(adminService.
+		PUT("/Users/{userName}/Plan").To(aService.adminSetPlan).
+		Filter(aService.adminFilter(userDB.PermManageBilling)).
+		// Docs
+		Doc("Moves a user to another plan, through stripe if they're billed there").
+		Operation("adminSetPlan").
+		Param(adminService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Reads(AdminPlanBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadPlanChoice, nil).
+		Returns(http.StatusBadRequest, StripeSubFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusNotFound, BadUserName, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Plan changed", nil))
+
+	adminService.Route(adminService.
+		PUT("/Users/{userName}/MaxCollections").To(aService.adminSetMaxCollections).
+		Filter(aService.adminFilter(userDB.PermManageBilling)).
+		// Docs
+		Doc("Overrides how many collections a user may have until their plan changes").
+		Operation("adminSetMaxCollections").
+		Param(adminService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Reads(MaxCollectionsBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadMaxCollections, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusNotFound, BadUserName, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Maximum changed", nil))
+
+	adminService.Route(adminService.
+		POST("/Users/{userName}/ForceReset").To(aService.adminForceReset).
+		Filter(aService.adminFilter(userDB.PermManageAccounts)).
+		// Docs
+		Doc("Replaces a user's password, ends their sessions, and emails them a reset").
+		Operation("adminForceReset").
+		Param(adminService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusNotFound, BadUserName, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Reset forced and queued", nil))
+
+	adminService.Route(adminService.
+		PUT("/Users/{userName}/Disabled").To(aService.adminSetDisabled).
+		Filter(aService.adminFilter(userDB.PermManageAccounts)).
+		// Docs
+		Doc("Bars a user from logging in, ending their sessions, or lets them back in").
+		Operation("adminSetDisabled").
+		Param(adminService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Reads(DisabledBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusNotFound, BadUserName, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Disabled status changed", nil))
+
+	adminService.Route(adminService.
+		GET("/Outbox/Status").To(aService.getOutboxStatus).
+		Filter(aService.adminFilter(userDB.PermViewUsers)).
+		// Docs
+		Doc("Reports how many queued emails are pending, sent, and dead-lettered").
+		Operation("getOutboxStatus").
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes([]userDB.OutboxStatus{}).
+		Returns(http.StatusOK, "Counts and oldest queue time per status", nil))
+
+	adminService.Route(adminService.
+		POST("/Admins/{adminName}").To(aService.adminAddAdmin).
+		Filter(aService.adminFilter(userDB.PermManageAdmins)).
+		// Docs
+		Doc("Adds another admin, their key is returned exactly once").
+		Operation("adminAddAdmin").
+		Param(adminService.PathParameter("adminName",
+			"The name the new admin is recorded under").DataType("string")).
+		Param(adminService.HeaderParameter(authHeader,
+			adminAuthHeaderDoc).DataType("string")).
+		Reads(AdminRoleBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadRole, nil).
+		Returns(http.StatusBadRequest, BadUserName, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, NotPermitted, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes("string").
+		Returns(http.StatusOK, "The new admin's key", nil))
+
+	aService.Admin = adminService
+
+	return nil
+
+}
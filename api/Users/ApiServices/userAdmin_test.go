@@ -0,0 +1,210 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"testing"
+
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+)
+
+// Performs a request against the admin service, authenticated if key
+// is non-empty.
+func doAdminRequest(t *testing.T, method, path, key string,
+	body interface{}) *httptest.ResponseRecorder {
+
+	var encoded []byte
+	if body != nil {
+		var err error
+		encoded, err = json.Marshal(body)
+		if err!=nil {
+			t.Fatal("failed to encode body", err)
+		}
+	}
+
+	req, err:= http.NewRequest(method, "/api/Admin" + path,
+		bytes.NewReader(encoded))
+	if err!=nil {
+		t.Fatal("failed to build request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(authHeader, bearerPrefix + key)
+	}
+
+	recorder:= httptest.NewRecorder()
+	testContainer.ServeHTTP(recorder, req)
+
+	return recorder
+
+}
+
+// Adds a fresh admin with the provided role, returning their key
+func addTestAdmin(t *testing.T, role string) string {
+	key, err:= userDB.AddAdmin(testService.pool, randName(), role)
+	if err!=nil {
+		t.Fatal("failed to add admin", err)
+	}
+
+	return key
+}
+
+// Ensure user sessions never reach the admin service and each role
+// is held to its permissions.
+func TestAdminRoles(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+
+	resp:= doRequest(t, "GET", "/" + name + "/SecurityEvents", sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("user session failed where it belongs", resp.Code)
+	}
+	resp = doAdminRequest(t, "GET", "/Users/" + name,
+		base64.StdEncoding.EncodeToString(sessionKey), nil)
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("user session reached the admin service", resp.Code)
+	}
+
+	support:= addTestAdmin(t, userDB.RoleSupport)
+
+	resp = doAdminRequest(t, "GET", "/Users?q=" + name, support, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to search", resp.Code, resp.Body.String())
+	}
+	var found []userDB.UserSummary
+	err:= json.Unmarshal(resp.Body.Bytes(), &found)
+	if err!=nil || len(found) != 1 || found[0].Name != name {
+		t.Fatal("search missed the user", err, found)
+	}
+
+	resp = doRequest(t, "GET", "/Outbox/Status", nil, nil)
+	if resp.Code == http.StatusOK {
+		t.Fatal("outbox status reachable without an admin", resp.Code)
+	}
+	resp = doAdminRequest(t, "GET", "/Outbox/Status", support, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("support failed to view the outbox", resp.Code)
+	}
+
+	resp = doAdminRequest(t, "PUT", "/Users/" + name + "/Plan", support,
+		AdminPlanBody{Plan: "Preordain"})
+	if resp.Code != http.StatusForbidden {
+		t.Fatal("support changed a plan", resp.Code)
+	}
+
+	resp = doAdminRequest(t, "POST", "/Admins/" + randName(), support,
+		AdminRoleBody{Role: userDB.RoleSuperuser})
+	if resp.Code != http.StatusForbidden {
+		t.Fatal("support added an admin", resp.Code)
+	}
+
+}
+
+// Give a user a plan and more collections, then view them.
+func TestAdminBilling(t *testing.T) {
+	t.Parallel()
+
+	name, _:= addTestUser(t)
+	billing:= addTestAdmin(t, userDB.RoleBilling)
+
+	resp:= doAdminRequest(t, "PUT", "/Users/" + name + "/Plan", billing,
+		AdminPlanBody{Plan: "Preordain"})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to change plan", resp.Code, resp.Body.String())
+	}
+
+	resp = doAdminRequest(t, "PUT", "/Users/" + name + "/MaxCollections",
+		billing, MaxCollectionsBody{MaxCollections: 7})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to change maximum", resp.Code, resp.Body.String())
+	}
+
+	resp = doAdminRequest(t, "GET", "/Users/" + name, billing, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to view user", resp.Code, resp.Body.String())
+	}
+	var view AdminUserView
+	err:= json.Unmarshal(resp.Body.Bytes(), &view)
+	if err!=nil {
+		t.Fatal("failed to decode user", err)
+	}
+	if view.Sub == nil || view.Sub.Plan != "Preordain" ||
+		view.Sub.SubID != userDB.DefaultID || view.MaxCollections != 7 {
+		t.Fatal("changes were not applied", view)
+	}
+
+	events, err:= userDB.GetAuditLog(testService.pool, name, 0)
+	if err!=nil || len(events) != 2 ||
+		events[0].Actor != userDB.ActorAdmin {
+		t.Fatal("changes missing from the user's audit log", err, events)
+	}
+
+}
+
+// Disable then re-enable a user before forcing them to reset.
+func TestAdminAccounts(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	support:= addTestAdmin(t, userDB.RoleSupport)
+
+	resp:= doAdminRequest(t, "PUT", "/Users/" + name + "/Disabled", support,
+		DisabledBody{Disabled: true})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to disable", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "GET", "/" + name + "/Export", sessionKey, nil)
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("disabled user kept their session", resp.Code)
+	}
+	resp = doRequest(t, "POST", "/" + name + "/Login", nil,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusForbidden {
+		t.Fatal("disabled user logged in", resp.Code)
+	}
+
+	resp = doAdminRequest(t, "PUT", "/Users/" + name + "/Disabled", support,
+		DisabledBody{Disabled: false})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to enable", resp.Code, resp.Body.String())
+	}
+
+	resp = doAdminRequest(t, "POST", "/Users/" + name + "/ForceReset",
+		support, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to force reset", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Login", nil,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("old password survived a forced reset", resp.Code)
+	}
+
+	sent:= sentTo(t, name)
+	if len(sent) != 1 || sent[0].Subject != "Password Reset - Preorda.in" {
+		t.Fatal("forced reset was not emailed", sent)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/PasswordReset", nil,
+		PasswordResetBody{Password: password,
+			ResetRequestToken: codeFrom(t, sent[0].Body)})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to use forced reset", resp.Code, resp.Body.String())
+	}
+
+}
@@ -0,0 +1,76 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"github.com/emicklei/go-restful"
+
+	"net/http"
+	"strconv"
+	"time"
+
+)
+
+// How many events a user sees without asking for a specific amount.
+const defaultAuditEvents int = 50
+
+// Records a security event performed by the user it concerns.
+//
+// Failing to record is logged rather than failing the request.
+func (aService *UserService) audit(req *restful.Request,
+	userName, event string, metadata map[string]string) {
+
+	aService.auditAs(userName, getIP(req), userName, event, metadata)
+
+}
+
+// Records a security event performed by someone else, such as an
+// anonymous login attempt.
+func (aService *UserService) auditAs(actor, ip, userName, event string,
+	metadata map[string]string) {
+
+	err:= userDB.RecordAudit(aService.pool, userDB.AuditEvent{
+		Name: userName,
+		Actor: actor,
+		IP: ip,
+		Event: event,
+		Metadata: metadata,
+		At: time.Now(),
+	})
+	if err!=nil {
+		aService.logger.Println("failed to record audit event",
+			event, "for", userName, err)
+	}
+
+}
+
+// Lets a user see their own recent security events, newest first.
+func (aService *UserService) getSecurityEvents(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	limit:= defaultAuditEvents
+	if raw:= req.QueryParameter("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err!=nil || limit < 1 || limit > userDB.MaxAuditEvents {
+			resp.WriteErrorString(http.StatusBadRequest, BadLimit)
+			return
+		}
+	}
+
+	events, err:= userDB.GetAuditLog(aService.pool, userName, limit)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	resp.WriteEntity(events)
+
+}
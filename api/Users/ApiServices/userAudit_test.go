@@ -0,0 +1,62 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"testing"
+
+	"encoding/json"
+	"net/http"
+)
+
+// Fail then succeed at logging in, ensuring both show up in the
+// user's security events.
+func TestSecurityEvents(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	resp:= doRequest(t, "POST", "/" + name + "/Login", nil,
+		PasswordBody{Password: "notTheirPassword"})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("logged in without the password", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Login", nil,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to log in", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "GET", "/" + name + "/SecurityEvents", sessionKey,
+		nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get security events", resp.Code,
+			resp.Body.String())
+	}
+	var events []userDB.AuditEvent
+	err = json.Unmarshal(resp.Body.Bytes(), &events)
+	if err!=nil {
+		t.Fatal("failed to decode security events", err)
+	}
+	if len(events) != 2 ||
+		events[0].Event != userDB.AuditLogin ||
+		events[1].Event != userDB.AuditLoginFailed ||
+		events[1].Actor != userDB.ActorAnonymous {
+		t.Fatal("security events missing or out of order", events)
+	}
+
+	resp = doRequest(t, "GET", "/" + name + "/SecurityEvents?limit=0",
+		sessionKey, nil)
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("accepted an invalid limit", resp.Code)
+	}
+
+}
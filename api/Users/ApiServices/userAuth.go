@@ -0,0 +1,169 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"github.com/emicklei/go-restful"
+
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"fmt"
+
+	"bytes"
+	"io/ioutil"
+	"io"
+
+	"net/http"
+	"time"
+
+)
+
+// The header an authenticated request carries its session key in.
+//
+// Form is 'Authorization: Bearer <key>' where key is base64 encoded exactly
+// as it would be inside a json body.
+const authHeader string = "Authorization"
+const bearerPrefix string = "Bearer "
+
+// Where a session key the filter has authenticated is stashed on
+// the request for handlers to retrieve.
+const sessionKeyAttribute string = "sessionKey"
+
+const authHeaderDoc string = "Bearer <session key>, preferred over a SessionKey in the body"
+
+// Acquires the session key from a bearer authorization header.
+//
+// present is false when no such header was provided at all.
+func getBearerSessionKey(req *restful.Request) (sessionKey []byte,
+	present bool, err error) {
+
+	header:= req.HeaderParameter(authHeader)
+	if header == "" {
+		return
+	}
+
+	present = true
+
+	if !strings.HasPrefix(header, bearerPrefix) {
+		err = fmt.Errorf("unsupported authorization scheme")
+		return
+	}
+
+	encoded:= strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+	sessionKey, err = base64.StdEncoding.DecodeString(encoded)
+	if err!=nil {
+		return
+	}
+	if len(sessionKey) == 0 {
+		err = fmt.Errorf("empty session key")
+	}
+
+	return
+
+}
+
+// Bodies carrying the deprecated SessionKey field are small, larger
+// ones aren't searched for it.
+const maxSessionBodySize int64 = 16 * 1024
+
+// Acquires the session key from the deprecated SessionKey body field
+// without consuming the body, nil when there is none.
+func getBodySessionKey(req *restful.Request) []byte {
+
+	if req.Request.Body == nil {
+		return nil
+	}
+
+	// Only a bounded prefix is buffered, whatever is past it is left
+	// for the handler to read
+	body, err:= ioutil.ReadAll(io.LimitReader(req.Request.Body,
+		maxSessionBodySize + 1))
+	req.Request.Body = ioutil.NopCloser(io.MultiReader(
+		bytes.NewReader(body), req.Request.Body))
+	if err!=nil || int64(len(body)) > maxSessionBodySize {
+		return nil
+	}
+
+	var sessionKeyContainer SessionKeyBody
+	err = json.Unmarshal(body, &sessionKeyContainer)
+	if err!=nil {
+		return nil
+	}
+
+	return sessionKeyContainer.SessionKey
+
+}
+
+// Authenticates requests carrying a session key for the user named in
+// the path, metering them against the plan's daily quota.
+//
+// A bearer header must authenticate. The deprecated SessionKey body
+// field is metered once it authenticates, otherwise the request passes
+// through untouched for the handler to refuse.
+func (aService *UserService) sessionFilter(req *restful.Request,
+	resp *restful.Response, chain *restful.FilterChain) {
+
+	userName:= req.PathParameter("userName")
+
+	sessionKey, present, err:= getBearerSessionKey(req)
+	if present {
+		if err==nil {
+			err = userDB.SessionAuth(aService.pool, userName, sessionKey)
+		}
+		if err!=nil {
+			resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+			return
+		}
+	}else{
+		sessionKey = getBodySessionKey(req)
+		if sessionKey == nil ||
+			userDB.SessionAuth(aService.pool, userName, sessionKey) != nil {
+			chain.ProcessFilter(req, resp)
+			return
+		}
+	}
+
+	// Authenticated requests count against their plan's quota
+	allowed, err:= userDB.UseAPIQuota(aService.pool, userName, time.Now())
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+	if !allowed {
+		resp.WriteErrorString(http.StatusTooManyRequests, QuotaExceeded)
+		return
+	}
+
+	// Authenticated responses must never end up in a shared cache
+	setPrivateHeader(resp)
+
+	req.SetAttribute(sessionKeyAttribute, sessionKey)
+
+	chain.ProcessFilter(req, resp)
+
+}
+
+// Returns the session key the sessionFilter authenticated, if any.
+func getFilteredSessionKey(req *restful.Request) []byte {
+	sessionKey, ok:= req.Attribute(sessionKeyAttribute).([]byte)
+	if !ok {
+		return nil
+	}
+
+	return sessionKey
+}
+
+// Prefers a session key from the authorization header over one
+// provided in the body.
+func preferFilteredSessionKey(req *restful.Request,
+	bodyKey []byte) []byte {
+
+	sessionKey:= getFilteredSessionKey(req)
+	if sessionKey != nil {
+		return sessionKey
+	}
+
+	return bodyKey
+}
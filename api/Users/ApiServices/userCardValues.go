@@ -0,0 +1,117 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+)
+
+// How often collection values are refreshed from the prices api
+const cardValueInterval = time.Duration(6) * time.Hour
+
+// A price as the prices api hands it out
+type remotePrice struct{
+	Name, Set string
+	// Unix time
+	Time int64
+	// In cents
+	Price int32
+}
+
+// Keeps the values collections are sorted by current, meant to be run
+// as its own goroutine.
+//
+// Prices live behind the prices api, found on the local port in
+// PRICE_API. Without it collections are never valued.
+func (aService *UserService) runCardValues() {
+
+	pricesPort:= os.Getenv("PRICE_API")
+	if len(pricesPort) == 0 {
+		aService.logger.Println("PRICE_API unset, collections won't be valued")
+		return
+	}
+
+	for {
+		aService.refreshCardValues(pricesPort)
+		time.Sleep(cardValueInterval)
+	}
+
+}
+
+// Replaces the value of every printing in each set we know of with its
+// latest price.
+//
+// A set that fails is left as it was, the next pass tries again.
+func (aService *UserService) refreshCardValues(pricesPort string) {
+
+	for aSet:= range sets{
+		if aSet == "" {
+			continue
+		}
+
+		values, err:= fetchSetValues(pricesPort, aSet)
+		if err!=nil {
+			aService.logger.Println("failed to fetch values for", aSet, err)
+			continue
+		}
+
+		err = userDB.SetCardValues(aService.pool, values)
+		if err!=nil {
+			aService.logger.Println("failed to set values for", aSet, err)
+		}
+	}
+
+}
+
+// Acquires the latest price of every card in a set from the prices api
+func fetchSetValues(pricesPort, setName string) ([]userDB.CardValue, error) {
+
+	loc:= fmt.Sprintf("http://127.0.0.1:%s/api/Prices/Set/%s/Latest",
+		pricesPort, url.PathEscape(setName))
+
+	resp, err:= http.Get(loc)
+	if err!=nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prices api returned %d", resp.StatusCode)
+	}
+
+	raw, err:= ioutil.ReadAll(resp.Body)
+	if err!=nil {
+		return nil, err
+	}
+
+	var prices []remotePrice
+	err = json.Unmarshal(raw, &prices)
+	if err!=nil {
+		return nil, err
+	}
+
+	values:= make([]userDB.CardValue, 0, len(prices))
+	for _, p:= range prices{
+		// Failed lookups are handed out as negative prices
+		if p.Price < 0 {
+			continue
+		}
+
+		values = append(values, userDB.CardValue{
+			Name: p.Name,
+			Set: p.Set,
+			Price: p.Price,
+			Updated: time.Unix(p.Time, 0),
+		})
+	}
+
+	return values, nil
+
+}
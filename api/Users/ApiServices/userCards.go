@@ -7,12 +7,106 @@ import(
 	"github.com/emicklei/go-restful"
 
 	"net/http"
-
-	"fmt"
+	"strconv"
+	"strings"
 
 )
 
-// Acquires the complete collection for a user
+// Reads the filters, sort, and page a collection route was asked for.
+//
+// Returns a message suitable for the client if any are invalid.
+func readCollectionQuery(req *restful.Request) (current,
+	history userDB.CollectionQuery, withHistory bool, problem string) {
+
+	current = userDB.CollectionQuery{
+		Set: req.QueryParameter("set"),
+		Name: req.QueryParameter("name"),
+		Quality: req.QueryParameter("quality"),
+		Lang: req.QueryParameter("lang"),
+		Comment: req.QueryParameter("comment"),
+		Location: req.QueryParameter("location"),
+		Sort: req.QueryParameter("sort"),
+		Cursor: req.QueryParameter("cursor"),
+	}
+
+	if current.Sort != "" && !userDB.ValidSort(current.Sort) {
+		problem = BadSort
+		return
+	}
+
+	switch req.QueryParameter("order") {
+	case "", "asc":
+	case "desc":
+		current.Descending = true
+	default:
+		problem = BadSort
+		return
+	}
+
+	if raw:= req.QueryParameter("limit"); raw != "" {
+		limit, err:= strconv.Atoi(raw)
+		if err!=nil || limit < 1 || limit > userDB.MaxCollectionPage {
+			problem = BadLimit
+			return
+		}
+		current.Limit = limit
+	}
+
+	withHistory = true
+	if raw:= req.QueryParameter("history"); raw != "" {
+		var err error
+		withHistory, err = strconv.ParseBool(raw)
+		if err!=nil {
+			problem = BodyReadFailure
+			return
+		}
+	}
+
+	// History shares the filters and page size but has its own cursor
+	history = current
+	history.Cursor = req.QueryParameter("historyCursor")
+
+	return
+
+}
+
+// Acquires the requested pages of a collection's contents and, if
+// withHistory, its history.
+//
+// A nil sessionKey performs no authentication.
+func (aService *UserService) queryCollection(sessionKey []byte,
+	userName, collectionName string,
+	current, history userDB.CollectionQuery,
+	withHistory bool) (*CollectionContents, error) {
+
+	var aColl CollectionContents
+
+	if withHistory {
+		page, err:= userDB.QueryCollectionHistory(aService.pool,
+			sessionKey, userName, collectionName, history)
+		if err!=nil {
+			return nil, err
+		}
+		aColl.Historical = page.Cards
+		aColl.NextHistorical = page.Next
+	}
+
+	page, err:= userDB.QueryCollectionContents(aService.pool,
+		sessionKey, userName, collectionName, current)
+	if err!=nil {
+		return nil, err
+	}
+	aColl.Current = page.Cards
+	aColl.NextCurrent = page.Next
+
+	return &aColl, nil
+
+}
+
+// Acquires the collection for a user, filtered, sorted, and paginated
+// as requested.
+//
+// Without any query parameters the complete collection is returned.
 func (aService *UserService) getCollection(req *restful.Request,
 	resp *restful.Response) {
 
@@ -28,25 +122,23 @@ func (aService *UserService) getCollection(req *restful.Request,
 		return
 	}
 
-	history, err:= userDB.GetCollectionHistory(aService.pool,
-		sessionKey, userName, collectionName)
-	if err!=nil {
-		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+	current, history, withHistory, problem:= readCollectionQuery(req)
+	if problem != "" {
+		resp.WriteErrorString(http.StatusBadRequest, problem)
 		return
 	}
 
-	current, err:= userDB.GetCollectionContents(aService.pool,
-		sessionKey, userName, collectionName)
+	aColl, err:= aService.queryCollection(sessionKey,
+		userName, collectionName, current, history, withHistory)
+	if err==userDB.ErrBadCursor {
+		resp.WriteErrorString(http.StatusBadRequest, BadCursor)
+		return
+	}
 	if err!=nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
 	}
 
-	aColl:= CollectionContents{
-		Current: current,
-		Historical: history,
-	}
-
 	resp.WriteEntity(aColl)
 
 }
@@ -65,37 +157,31 @@ func (aService *UserService) getCollectionPublic(req *restful.Request,
 		return
 	}
 
-	fmt.Println(meta)
-
 	if meta.Privacy == "Private" {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return	
 	}
 
-	var history []userDB.Card
-	if meta.Privacy == "History" {
-		history, err = userDB.GetCollectionHistory(aService.pool,
-		nil, userName, collectionName)
-		if err!=nil {
-			resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
-			return
-		}	
+	current, history, withHistory, problem:= readCollectionQuery(req)
+	if problem != "" {
+		resp.WriteErrorString(http.StatusBadRequest, problem)
+		return
 	}
 
-	fmt.Println(history)
+	// Only some collections share their history
+	withHistory = withHistory && meta.Privacy == "History"
 
-	current, err:= userDB.GetCollectionContents(aService.pool,
-		nil, userName, collectionName)
+	aColl, err:= aService.queryCollection(nil,
+		userName, collectionName, current, history, withHistory)
+	if err==userDB.ErrBadCursor {
+		resp.WriteErrorString(http.StatusBadRequest, BadCursor)
+		return
+	}
 	if err!=nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
 	}
 
-	aColl:= CollectionContents{
-		Current: current,
-		Historical: history,
-	}
-
 	resp.WriteEntity(aColl)
 
 }
@@ -117,6 +203,8 @@ func (aService *UserService) addTrade(req *restful.Request,
 		return
 	}
 
+	tradeContainer.SessionKey = preferFilteredSessionKey(req,
+		tradeContainer.SessionKey)
 	if tradeContainer.SessionKey == nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
@@ -124,21 +212,33 @@ func (aService *UserService) addTrade(req *restful.Request,
 
 	// Ensure we have received a trade consisting of valid Magic cards
 	// inside their specific sets
-	for _, aCard:= range tradeContainer.Trade{
+	for i, aCard:= range tradeContainer.Trade{
+		// Older clients mark foils by their set's name
+		if strings.HasSuffix(aCard.Set, userDB.FoilSuffix) {
+			aCard.Set = strings.TrimSuffix(aCard.Set, userDB.FoilSuffix)
+			aCard.Foil = true
+			tradeContainer.Trade[i] = aCard
+		}
+
 		validSets, validCard:= cardsToSets[aCard.Name]
 		if !validCard {
 			resp.WriteErrorString(http.StatusBadRequest, BadTradeContents)
 			return
 		}
-		_, validSet:= validSets[aCard.Set]
-		if !validSet {
+		hasFoil, validSet:= validSets[aCard.Set]
+		if !validSet || (aCard.Foil && !hasFoil) {
 			resp.WriteErrorString(http.StatusBadRequest, BadTradeContents)
 			return
 		}
 
+		if !userDB.ValidGrading(aCard.Grader, aCard.Grade) {
+			resp.WriteErrorString(http.StatusBadRequest, BadGrading)
+			return
+		}
+
 	}
 
-	err = userDB.AddCards(aService.pool,
+	tradeID, err:= userDB.AddTrade(aService.pool,
 		tradeContainer.SessionKey,
 		userName, collectionName,
 		tradeContainer.Trade)
@@ -148,6 +248,46 @@ func (aService *UserService) addTrade(req *restful.Request,
 		return
 	}
 
-	resp.WriteEntity(true)
+	resp.WriteEntity(TradeReceipt{TradeID: tradeID})
 
-}
\ No newline at end of file
+}
+
+// Undoes a recent trade by applying its opposite to the collection.
+func (aService *UserService) revertTrade(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	collectionName:= req.PathParameter("collectionName")
+
+	sessionKey:= getFilteredSessionKey(req)
+	if sessionKey == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	tradeID, err:= strconv.ParseInt(req.PathParameter("tradeID"), 10, 64)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusNotFound, NoSuchTrade)
+		return
+	}
+
+	revertID, err:= userDB.RevertTrade(aService.pool, sessionKey,
+		userName, collectionName, tradeID)
+	if err==userDB.ErrNoTrade {
+		resp.WriteErrorString(http.StatusNotFound, NoSuchTrade)
+		return
+	}
+	if err==userDB.ErrTradeReverted || err==userDB.ErrTradeIsRevert ||
+		err==userDB.ErrTradeExpired {
+		resp.WriteErrorString(http.StatusConflict, TradeNotRevertable)
+		return
+	}
+	if err!=nil {
+		aService.logger.Println(err)
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
+
+	resp.WriteEntity(TradeReceipt{TradeID: revertID})
+
+}
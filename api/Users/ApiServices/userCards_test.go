@@ -0,0 +1,189 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"testing"
+
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Page through a collection over http, ensuring invalid queries are
+// refused before reaching the database.
+func TestGetCollectionPages(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+	collection:= randName()
+	err:= userDB.AddCollection(testService.pool, sessionKey, name, collection)
+	if err!=nil {
+		t.Fatal("failed to add collection", err)
+	}
+
+	now:= time.Now().Round(time.Second)
+	err = userDB.AddCards(testService.pool, sessionKey, name, collection,
+		[]userDB.Card{
+			{Name: "Sol Ring", Set: "Legends", Quality: "NM", Lang: "EN",
+				Quantity: 1, LastUpdate: now},
+			{Name: "Scroll Rack", Set: "Legends", Quality: "LP", Lang: "EN",
+				Quantity: 3, LastUpdate: now},
+			{Name: "Skred", Set: "Mirrodin", Quality: "NM", Lang: "FR",
+				Quantity: 2, LastUpdate: now},
+		})
+	if err!=nil {
+		t.Fatal("failed to add cards", err)
+	}
+
+	path:= "/" + name + "/Collections/" + collection + "/Get"
+
+	resp:= doRequest(t, "GET", path + "?sort=quantity&order=desc&limit=2",
+		sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get page", resp.Code, resp.Body.String())
+	}
+	var first CollectionContents
+	err = json.Unmarshal(resp.Body.Bytes(), &first)
+	if err!=nil {
+		t.Fatal("failed to decode page", err)
+	}
+	if len(first.Current) != 2 || first.Current[0].Name != "Scroll Rack" ||
+		first.NextCurrent == "" {
+		t.Fatal("first page wrong", first)
+	}
+
+	resp = doRequest(t, "GET", path +
+		"?sort=quantity&order=desc&limit=2&history=false&cursor=" +
+		first.NextCurrent, sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get page", resp.Code, resp.Body.String())
+	}
+	var second CollectionContents
+	err = json.Unmarshal(resp.Body.Bytes(), &second)
+	if err!=nil {
+		t.Fatal("failed to decode page", err)
+	}
+	if len(second.Current) != 1 || second.Current[0].Name != "Sol Ring" ||
+		second.NextCurrent != "" || second.Historical != nil {
+		t.Fatal("second page wrong", second)
+	}
+
+	resp = doRequest(t, "GET", path + "?set=Mirrodin", sessionKey, nil)
+	var filtered CollectionContents
+	err = json.Unmarshal(resp.Body.Bytes(), &filtered)
+	if err!=nil || len(filtered.Current) != 1 ||
+		len(filtered.Historical) != 1 {
+		t.Fatal("set filter not applied", err, filtered)
+	}
+
+	for _, query:= range []string{"?sort=price", "?order=up", "?limit=0",
+		"?limit=501", "?sort=name&cursor=" + first.NextCurrent}{
+		resp = doRequest(t, "GET", path + query, sessionKey, nil)
+		if resp.Code != http.StatusBadRequest {
+			t.Fatal("accepted invalid query", query, resp.Code)
+		}
+	}
+
+}
+
+// Revert a trade over http, ensuring it can only happen once.
+func TestRevertTradeRoute(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+	collection:= randName()
+	err:= userDB.AddCollection(testService.pool, sessionKey, name, collection)
+	if err!=nil {
+		t.Fatal("failed to add collection", err)
+	}
+
+	tradeID, err:= userDB.AddTrade(testService.pool, sessionKey,
+		name, collection, []userDB.Card{
+			{Name: "Sol Ring", Set: "Legends", Quality: "NM", Lang: "EN",
+				Quantity: 4, LastUpdate: time.Now().Round(time.Second)},
+		})
+	if err!=nil {
+		t.Fatal("failed to add trade", err)
+	}
+
+	path:= "/" + name + "/Collections/" + collection + "/Trades/" +
+		strconv.FormatInt(tradeID, 10) + "/Revert"
+
+	resp:= doRequest(t, "POST", path, nil, nil)
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("reverted without a session", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", path, sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to revert", resp.Code, resp.Body.String())
+	}
+	var receipt TradeReceipt
+	err = json.Unmarshal(resp.Body.Bytes(), &receipt)
+	if err!=nil || receipt.TradeID == tradeID {
+		t.Fatal("bad revert receipt", err, receipt)
+	}
+
+	contents, err:= userDB.GetCollectionContents(testService.pool,
+		sessionKey, name, collection)
+	if err!=nil || len(contents) != 1 || contents[0].Quantity != 0 {
+		t.Fatal("revert not applied", err, contents)
+	}
+
+	resp = doRequest(t, "POST", path, sessionKey, nil)
+	if resp.Code != http.StatusConflict {
+		t.Fatal("reverted a trade twice", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Collections/" + collection +
+		"/Trades/nonsense/Revert", sessionKey, nil)
+	if resp.Code != http.StatusNotFound {
+		t.Fatal("reverted a nonsense trade", resp.Code)
+	}
+
+}
+
+// Values are taken from the prices api, leaving out prices it failed
+// to acquire.
+func TestFetchSetValues(t *testing.T) {
+	t.Parallel()
+
+	set:= "Magic 2015 Foil"
+	server:= httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		if r.URL.Path != "/api/Prices/Set/" + set + "/Latest" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"Name":"Shivan Dragon","Set":"` + set +
+			`","Time":1400000000,"Price":250,"Source":"mtgprice"},` +
+			`{"Name":"Unknown","Set":"Unknown","Time":0,"Price":-1}]`))
+	}))
+	defer server.Close()
+
+	loc, err:= url.Parse(server.URL)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	values, err:= fetchSetValues(loc.Port(), set)
+	if err!=nil {
+		t.Fatal("failed to fetch values", err)
+	}
+	if len(values) != 1 || values[0].Name != "Shivan Dragon" ||
+		values[0].Set != set || values[0].Price != 250 ||
+		values[0].Updated.Unix() != 1400000000 {
+		t.Fatal("wrong values", values)
+	}
+
+	_, err = fetchSetValues(loc.Port(), "Not A Set")
+	if err==nil {
+		t.Fatal("fetched values for a missing set")
+	}
+
+}
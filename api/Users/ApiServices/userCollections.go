@@ -52,6 +52,8 @@ func (aService *UserService) setCollectionPermissions(req *restful.Request,
 		return
 	}
 
+	permissionsContainer.SessionKey = preferFilteredSessionKey(req,
+		permissionsContainer.SessionKey)
 	if permissionsContainer.SessionKey == nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
@@ -66,6 +68,12 @@ func (aService *UserService) setCollectionPermissions(req *restful.Request,
 		return
 	}
 
+	aService.audit(req, userName, userDB.AuditPermissionsChanged,
+		map[string]string{
+			"collection": collectionName,
+			"privacy": permissionsContainer.Privacy,
+		})
+
 	resp.WriteEntity(true)
 
 }
@@ -0,0 +1,220 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+	"fmt"
+)
+
+// Everything we hold about a user, as handed back to them on request.
+//
+// Secrets such as password hashes, session keys, and second factor
+// secrets are deliberately left out; they're of no use to the user
+// and would be dangerous in an archive sitting in their downloads.
+type Export struct{
+	Name, Email string
+	Verified bool
+	Locale string
+	MaxCollections int32
+	Longestview time.Duration
+
+	TwoFactorEnabled bool
+
+	Sub *Subscription
+	Sessions []SessionPeriod
+	Collections []CollectionExport
+	SecurityEvents []AuditEvent
+
+	Generated time.Time
+}
+
+// When a session was valid
+type SessionPeriod struct{
+	StartValid, EndValid time.Time
+}
+
+// A collection alongside its contents and entire history.
+type CollectionExport struct{
+	Name, Privacy string
+	Contents []Card
+	History []Card
+}
+
+// Gathers everything tied to a user into an Export.
+//
+// History is exported in full, regardless of how much of it their
+// plan currently lets them view.
+func ExportUser(pool *pgx.ConnPool, user string) (*Export, error) {
+
+	u, err:= GetUser(pool, user)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to fetch user")
+	}
+
+	e:= Export{
+		Name: u.Name,
+		Email: u.Email,
+		Verified: u.Verified,
+		Locale: u.Locale,
+		MaxCollections: u.MaxCollections,
+		Longestview: u.Longestview,
+		Generated: time.Now(),
+	}
+
+	e.TwoFactorEnabled, err = TwoFactorEnabled(pool, user)
+	if err!=nil {
+		return nil, err
+	}
+
+	e.Sub, err = GetSub(pool, user, nil)
+	if err!=nil {
+		return nil, err
+	}
+
+	e.Sessions, err = getAllSessions(pool, user)
+	if err!=nil {
+		return nil, err
+	}
+
+	e.SecurityEvents, err = GetAuditLog(pool, user, 0)
+	if err!=nil {
+		return nil, err
+	}
+
+	collections, err:= GetCollectionList(pool, user)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to list collections")
+	}
+
+	e.Collections = make([]CollectionExport, 0, len(collections))
+	for _, c:= range collections{
+		contents, err:= GetCollectionContents(pool, nil, user, c.Name)
+		if err!=nil {
+			return nil, err
+		}
+
+		history, err:= getFullCollectionHistory(pool, user, c.Name)
+		if err!=nil {
+			return nil, err
+		}
+
+		e.Collections = append(e.Collections, CollectionExport{
+			Name: c.Name,
+			Privacy: c.Privacy,
+			Contents: contents,
+			History: history,
+		})
+	}
+
+	return &e, nil
+
+}
+
+// Acquires every session a user has had.
+func getAllSessions(pool *pgx.ConnPool, user string) ([]SessionPeriod, error) {
+
+	rows, err:= pool.Query("getAllSessions", user)
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions:= make([]SessionPeriod, 0)
+	for rows.Next() {
+		var s SessionPeriod
+		err = rows.Scan(&s.StartValid, &s.EndValid)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+		sessions = append(sessions, s)
+	}
+
+	return sessions, rows.Err()
+
+}
+
+// Acquires every change to a collection, ignoring plan limits.
+func getFullCollectionHistory(pool *pgx.ConnPool,
+	user, collection string) ([]Card, error) {
+
+	rows, err:= pool.Query("getCollectionHistory", user, collection,
+		time.Time{})
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cards:= make([]Card, 0)
+	for rows.Next(){
+		c:= Card{}
+		err = rows.Scan(&c.Name, &c.Set,
+			&c.Quality, &c.Quantity,
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		cards = append(cards, c)
+	}
+
+	return cards, rows.Err()
+
+}
+
+// The order rows tied to a user are removed in so nothing is left
+// referencing a row that's already gone.
+var userRemovals = []string{
+	"removeCollectionHistory", "removeTrades", "removeCollectionContents",
+	"removeCollections",
+	"removeSessions", "removeResets", "removeVerifications",
+	"removeChallenges", "removeRecoveryCodes", "removeTOTP",
+	"removeAPIUsage", "removeAuditLog", "removeSub", "removeUser",
+	"redactOutbox",
+}
+
+// Removes a user and every row tied to them.
+//
+// Billing must already have been cancelled, the subscription is
+// dropped regardless of its state.
+func DeleteUser(pool *pgx.ConnPool, user string) error {
+	return DeleteUserMail(pool, user, nil)
+}
+
+// Removes a user as DeleteUser and queues the provided email in the
+// same transaction, the email goes out only if the removal sticks.
+//
+// The queued email survives the removal; any mail already sent to the
+// user has its address and contents blanked.
+func DeleteUserMail(pool *pgx.ConnPool, user string, m *Mail) error {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	for _, statement:= range userRemovals{
+		_, err = tx.Exec(statement, user)
+		if err!=nil {
+			return errorHandle(err, "failed to remove user, "+statement)
+		}
+	}
+
+	_, err = tx.Exec("clearLoginFailures", attemptName, user)
+	if err!=nil {
+		return errorHandle(err, "failed to clear login failures")
+	}
+
+	err = enqueueMail(tx, m, time.Now())
+	if err!=nil {
+		return err
+	}
+
+	return tx.Commit()
+
+}
@@ -0,0 +1,82 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Export a user with a collection, delete them, then ensure nothing
+// tied to them is left and their name can be reused.
+func TestExportAndDelete(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(int(randByte()) % 31)
+	key, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	// Wait for the db to catch up
+	time.Sleep(stepSleepTime)
+
+	collection:= randString(int(randByte()))
+	err = AddCollection(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	cards:= randomCards(CardsPerCollection)
+	err = AddCards(pool, key, user, collection, cards)
+	if err!= nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	e, err:= ExportUser(pool, user)
+	if err!=nil {
+		t.Fatal("failed to export", err)
+	}
+	if e.Name != user || e.Email != "bar" || e.Sub == nil ||
+		e.Sub.Plan != DefaultSubLevel || len(e.Sessions) != 1 {
+		t.Fatal("export missing user details", e)
+	}
+	if len(e.Collections) != 1 || e.Collections[0].Name != collection {
+		t.Fatal("export missing collection", e.Collections)
+	}
+	if !equalCardContents(cards, e.Collections[0].History, t) {
+		t.Fatal("export missing collection history")
+	}
+
+	err = DeleteUser(pool, user)
+	if err!=nil {
+		t.Fatal("failed to delete user", err)
+	}
+
+	_, err = GetUser(pool, user)
+	if err==nil {
+		t.Fatal("deleted user still exists")
+	}
+	err = SessionAuth(pool, user, key)
+	if err==nil {
+		t.Fatal("deleted user's session still valid")
+	}
+	history, err:= getFullCollectionHistory(pool, user, collection)
+	if err!=nil || len(history) != 0 {
+		t.Fatal("deleted user's history remains", err, len(history))
+	}
+
+	// The name is free again with none of the old data
+	key, err = AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to reuse deleted name", err)
+	}
+	collections, err:= GetCollectionList(pool, user)
+	if err!=nil || len(collections) != 0 {
+		t.Fatal("reused name inherited collections", err, collections)
+	}
+
+}
@@ -0,0 +1,269 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"time"
+
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// Roles an admin may hold
+const(
+	// Looks after accounts, can force resets and disable users
+	RoleSupport string = "support"
+	// Looks after money, can change plans and collection limits
+	RoleBilling string = "billing"
+	// Can do everything, including adding other admins
+	RoleSuperuser string = "superuser"
+)
+
+// What an admin may be permitted to do
+const(
+	PermViewUsers string = "viewUsers"
+	PermManageAccounts string = "manageAccounts"
+	PermManageBilling string = "manageBilling"
+	PermManageAdmins string = "manageAdmins"
+)
+
+var rolePermissions = map[string][]string{
+	RoleSupport: []string{PermViewUsers, PermManageAccounts},
+	RoleBilling: []string{PermViewUsers, PermManageBilling},
+	RoleSuperuser: []string{PermViewUsers, PermManageAccounts,
+		PermManageBilling, PermManageAdmins},
+}
+
+// Actions recorded in users.adminLog
+const(
+	AdminSearch string = "search"
+	AdminView string = "view"
+	AdminSetPlan string = "setPlan"
+	AdminSetMaxCollections string = "setMaxCollections"
+	AdminForceReset string = "forceReset"
+	AdminSetDisabled string = "setDisabled"
+	AdminAddAdmin string = "addAdmin"
+)
+
+// How many characters an admin key should be.
+const AdminKeyLength int = 40
+
+// The most users a single search returns.
+const MaxUserSearch int = 100
+
+// Returned when a disabled user provides their correct password.
+var ErrDisabled = fmt.Errorf("account disabled")
+
+// Returned when adding an admin under a name that's already taken.
+var ErrAdminExists = fmt.Errorf("admin already exists")
+
+type Admin struct{
+	Name, Role string
+}
+
+// Whether the admin's role permits the provided action.
+func (a *Admin) Can(perm string) bool {
+	for _, allowed:= range rolePermissions[a.Role]{
+		if allowed == perm {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Whether the provided role is one we recognize.
+func ValidRole(role string) bool {
+	_, ok:= rolePermissions[role]
+	return ok
+}
+
+// Adds an admin with the provided role and returns the key they
+// authenticate with.
+//
+// The key is only ever returned here, we keep its hash.
+func AddAdmin(pool *pgx.ConnPool, name, role string) (string, error) {
+
+	if !ValidRole(role) {
+		return "", fmt.Errorf("unknown role")
+	}
+
+	key:= randString(AdminKeyLength)
+	hashed:= sha256.Sum256([]byte(key))
+
+	tag, err:= pool.Exec("addAdmin", name, role, hashed[:], time.Now())
+	if err!=nil {
+		return "", errorHandle(err, "failed to send admin off to db")
+	}
+	if tag.RowsAffected() != 1 {
+		return "", ErrAdminExists
+	}
+
+	return key, nil
+
+}
+
+// Acquires the admin holding the provided key.
+func AdminAuth(pool *pgx.ConnPool, key string) (*Admin, error) {
+
+	hashed:= sha256.Sum256([]byte(key))
+
+	a:= Admin{}
+	var keyHash []byte
+	err:= pool.QueryRow("getAdmin", hashed[:]).Scan(&a.Name, &a.Role,
+		&keyHash)
+	if err!=nil {
+		return nil, fmt.Errorf("invalid Authentication")
+	}
+
+	if subtle.ConstantTimeCompare(hashed[:], keyHash) != 1 {
+		return nil, fmt.Errorf("invalid Authentication")
+	}
+
+	return &a, nil
+
+}
+
+// A single action taken through the admin service.
+//
+// Target is who or what the action was taken against, the search
+// query for searches.
+type AdminAction struct{
+	Admin, Action, Target string
+	Metadata map[string]string
+	At time.Time
+}
+
+// Appends an action to the admin log.
+func RecordAdminAction(pool *pgx.ConnPool, a AdminAction) error {
+
+	metadata, err:= encodeMetadata(a.Metadata)
+	if err!=nil {
+		return err
+	}
+
+	_, err = pool.Exec("recordAdminAction", a.Admin, a.Action, a.Target,
+		metadata, a.At)
+	if err!=nil {
+		return errorHandle(err, "failed to record admin action")
+	}
+
+	return nil
+
+}
+
+// Enough about a user to pick them out of a search.
+type UserSummary struct{
+	Name, Email string
+	Verified, Disabled bool
+	Plan string
+}
+
+// Acquires up to limit users whose name or email contain the query.
+func SearchUsers(pool *pgx.ConnPool, query string,
+	limit int) ([]UserSummary, error) {
+
+	// The query is matched literally
+	rows, err:= pool.Query("searchUsers", substringPattern(query), limit)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to search users")
+	}
+	defer rows.Close()
+
+	users:= make([]UserSummary, 0)
+	for rows.Next() {
+		var u UserSummary
+		err = rows.Scan(&u.Name, &u.Email, &u.Verified, &u.Disabled,
+			&u.Plan)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+
+}
+
+// Bars or allows a user logging in with no authentication.
+//
+// Disabling a user also ends every session and pending login they have.
+func SetDisabled(pool *pgx.ConnPool, user string, disabled bool) error {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("setDisabled", user, disabled)
+	if err!=nil {
+		return errorHandle(err, "failed to set disabled")
+	}
+
+	if disabled {
+		err = endSessions(tx, user)
+		if err!=nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+
+}
+
+// Replaces a user's password with one nobody knows, ends their sessions,
+// and queues a fresh reset with no authentication.
+//
+// Any resets they already had are dropped so only the queued one works.
+func ForcePasswordReset(pool *pgx.ConnPool, user string,
+	compose MailComposer) error {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	err = SetPassword(tx, user, randString(AdminKeyLength))
+	if err!=nil {
+		return err
+	}
+
+	err = endSessions(tx, user)
+	if err!=nil {
+		return err
+	}
+
+	_, err = tx.Exec("removeResets", user)
+	if err!=nil {
+		return errorHandle(err, "failed to remove resets")
+	}
+
+	_, err = addReset(tx, user, compose, time.Now())
+	if err!=nil {
+		return err
+	}
+
+	return tx.Commit()
+
+}
+
+// Removes every session and pending login challenge a user has.
+func endSessions(tx *pgx.Tx, user string) error {
+
+	for _, statement:= range []string{"removeSessions", "removeChallenges"}{
+		_, err:= tx.Exec(statement, user)
+		if err!=nil {
+			return errorHandle(err, "failed to end sessions, "+statement)
+		}
+	}
+
+	return nil
+
+}
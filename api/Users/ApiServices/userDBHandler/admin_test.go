@@ -0,0 +1,124 @@
+package userDB
+
+import(
+
+	"testing"
+
+)
+
+// Add an admin then ensure their key, and only their key, identifies
+// them with the permissions of their role.
+func TestAdminAuth(t *testing.T) {
+	t.Parallel()
+
+	_, err:= AddAdmin(pool, randString(30), "janitor")
+	if err==nil {
+		t.Fatal("added admin with unknown role")
+	}
+
+	name:= randString(30)
+	key, err:= AddAdmin(pool, name, RoleSupport)
+	if err!=nil {
+		t.Fatal("failed to add admin", err)
+	}
+
+	_, err = AddAdmin(pool, name, RoleSuperuser)
+	if err!=ErrAdminExists {
+		t.Fatal("added an admin under a taken name", err)
+	}
+
+	a, err:= AdminAuth(pool, key)
+	if err!=nil || a.Name != name || a.Role != RoleSupport {
+		t.Fatal("failed to authenticate admin", err, a)
+	}
+	if !a.Can(PermManageAccounts) || a.Can(PermManageBilling) {
+		t.Fatal("support has the wrong permissions")
+	}
+
+	_, err = AdminAuth(pool, randString(AdminKeyLength))
+	if err==nil {
+		t.Fatal("authenticated with a made up key")
+	}
+
+	err = RecordAdminAction(pool, AdminAction{
+		Admin: name,
+		Action: AdminSearch,
+		Target: "anything",
+	})
+	if err!=nil {
+		t.Fatal("failed to record admin action", err)
+	}
+
+}
+
+// Disable a user then force a reset, ensuring each ends their sessions
+// and keeps them out until undone.
+func TestDisableAndForceReset(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(30)
+	password:= randString(20)
+	key, err:= AddUser(pool, user, user + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	found, err:= SearchUsers(pool, user[5:20], MaxUserSearch)
+	if err!=nil || len(found) != 1 || found[0].Name != user ||
+		found[0].Plan != DefaultSubLevel {
+		t.Fatal("failed to find user", err, found)
+	}
+
+	found, err = SearchUsers(pool, "%", MaxUserSearch)
+	if err!=nil || len(found) != 0 {
+		t.Fatal("search treated the query as a pattern", err, found)
+	}
+
+	err = SetDisabled(pool, user, true)
+	if err!=nil {
+		t.Fatal("failed to disable user", err)
+	}
+
+	err = SessionAuth(pool, user, key)
+	if err==nil {
+		t.Fatal("disabled user kept their session")
+	}
+	_, _, err = BeginLogin(pool, user, password)
+	if err!=ErrDisabled {
+		t.Fatal("disabled user logged in", err)
+	}
+
+	err = SetDisabled(pool, user, false)
+	if err!=nil {
+		t.Fatal("failed to enable user", err)
+	}
+
+	key, _, err = BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("enabled user could not log in", err)
+	}
+
+	var resetKey string
+	err = ForcePasswordReset(pool, user, func(k string) (*Mail, error) {
+		resetKey = k
+		return nil, nil
+	})
+	if err!=nil {
+		t.Fatal("failed to force reset", err)
+	}
+
+	err = SessionAuth(pool, user, key)
+	if err==nil {
+		t.Fatal("forced reset kept their session")
+	}
+	_, _, err = BeginLogin(pool, user, password)
+	if err==nil {
+		t.Fatal("forced reset kept their password")
+	}
+
+	err = ChangePassword(pool, user, password, resetKey)
+	if err!=nil {
+		t.Fatal("failed to use forced reset", err)
+	}
+
+}
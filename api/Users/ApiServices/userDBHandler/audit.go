@@ -0,0 +1,142 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+	"encoding/json"
+)
+
+// Security relevant events recorded in users.auditLog
+const(
+	AuditLogin string = "login"
+	AuditLoginFailed string = "loginFailed"
+	AuditSecondFactorFailed string = "secondFactorFailed"
+	AuditLockout string = "lockout"
+	AuditResetRequested string = "resetRequested"
+	AuditPasswordReset string = "passwordReset"
+	AuditEmailChangeRequested string = "emailChangeRequested"
+	AuditEmailVerified string = "emailVerified"
+	AuditTwoFactorEnabled string = "twoFactorEnabled"
+	AuditTwoFactorDisabled string = "twoFactorDisabled"
+	AuditSubChanged string = "subChanged"
+	AuditPermissionsChanged string = "permissionsChanged"
+	AuditMaxCollectionsChanged string = "maxCollectionsChanged"
+	AuditResetForced string = "resetForced"
+	AuditDisabled string = "disabled"
+	AuditEnabled string = "enabled"
+)
+
+// Actors for events the user didn't perform themselves
+const(
+	// Unauthenticated requests, such as failed logins
+	ActorAnonymous string = "anonymous"
+	// Changes stripe told us about through its webhook
+	ActorStripe string = "stripe"
+	// Staff acting through the admin service, who is in users.adminLog
+	ActorAdmin string = "admin"
+)
+
+// The most events a user can request at once.
+const MaxAuditEvents int = 200
+
+// A single security relevant event.
+//
+// Name is who the event concerns while Actor is who performed it.
+type AuditEvent struct{
+	Name, Actor, IP, Event string
+	Metadata map[string]string
+	At time.Time
+}
+
+// Appends an event to the audit log.
+//
+// Events for names without a user are silently dropped.
+func RecordAudit(pool *pgx.ConnPool, e AuditEvent) error {
+
+	metadata, err:= encodeMetadata(e.Metadata)
+	if err!=nil {
+		return err
+	}
+
+	_, err = pool.Exec("recordAudit", e.Name, e.Actor, e.IP, e.Event,
+		metadata, e.At)
+	if err!=nil {
+		return errorHandle(err, "failed to record audit event")
+	}
+
+	return nil
+
+}
+
+// Appends an event to the audit log as part of a larger transaction.
+func recordAudit(tx *pgx.Tx, e AuditEvent) error {
+
+	metadata, err:= encodeMetadata(e.Metadata)
+	if err!=nil {
+		return err
+	}
+
+	_, err = tx.Exec("recordAudit", e.Name, e.Actor, e.IP, e.Event,
+		metadata, e.At)
+	if err!=nil {
+		return errorHandle(err, "failed to record audit event")
+	}
+
+	return nil
+
+}
+
+func encodeMetadata(metadata map[string]string) (string, error) {
+	if metadata == nil {
+		return "{}", nil
+	}
+
+	encoded, err:= json.Marshal(metadata)
+	if err!=nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// Acquires up to limit of a user's most recent events, newest first.
+//
+// A limit of zero or less acquires every event.
+func GetAuditLog(pool *pgx.ConnPool, user string,
+	limit int) ([]AuditEvent, error) {
+
+	var limitParam interface{}
+	if limit > 0 {
+		limitParam = limit
+	}
+
+	rows, err:= pool.Query("getAuditLog", user, limitParam)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to get audit log")
+	}
+	defer rows.Close()
+
+	events:= make([]AuditEvent, 0)
+	for rows.Next() {
+		var e AuditEvent
+		var metadata string
+		err = rows.Scan(&e.Name, &e.Actor, &e.IP, &e.Event,
+			&metadata, &e.At)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		err = json.Unmarshal([]byte(metadata), &e.Metadata)
+		if err!=nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+
+}
@@ -0,0 +1,72 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Record a few events, ensuring they come back newest first with
+// their metadata and that events for missing users are dropped.
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(int(randByte()))
+	_, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	now:= time.Now()
+	events:= []string{AuditLoginFailed, AuditLogin, AuditTwoFactorEnabled}
+	for i, event:= range events{
+		err = RecordAudit(pool, AuditEvent{
+			Name: user,
+			Actor: user,
+			IP: "127.0.0.1",
+			Event: event,
+			Metadata: map[string]string{"step": event},
+			At: now.Add(time.Duration(i) * time.Second),
+		})
+		if err!=nil {
+			t.Fatal("failed to record event", err)
+		}
+	}
+
+	log, err:= GetAuditLog(pool, user, 2)
+	if err!=nil {
+		t.Fatal("failed to get audit log", err)
+	}
+	if len(log) != 2 || log[0].Event != AuditTwoFactorEnabled ||
+		log[1].Event != AuditLogin {
+		t.Fatal("audit log not newest first or not limited", log)
+	}
+	if log[0].Metadata["step"] != AuditTwoFactorEnabled ||
+		log[0].IP != "127.0.0.1" || log[0].Actor != user {
+		t.Fatal("audit event mangled", log[0])
+	}
+
+	log, err = GetAuditLog(pool, user, 0)
+	if err!=nil || len(log) != len(events) {
+		t.Fatal("failed to get entire audit log", err, log)
+	}
+
+	// Nobody to attach it to
+	missing:= randString(30)
+	err = RecordAudit(pool, AuditEvent{
+		Name: missing,
+		Actor: ActorAnonymous,
+		Event: AuditLoginFailed,
+		At: now,
+	})
+	if err!=nil {
+		t.Fatal("failed to drop event for missing user", err)
+	}
+	log, err = GetAuditLog(pool, missing, 0)
+	if err!=nil || len(log) != 0 {
+		t.Fatal("recorded event for missing user", err, log)
+	}
+
+}
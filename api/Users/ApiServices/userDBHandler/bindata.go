@@ -1,27 +1,93 @@
 // Code generated by go-bindata.
 // sources:
+// sql\addAdmin.sql
 // sql\addCard.sql
 // sql\addCardHistorical.sql
+// sql\addCardValue.sql
+// sql\addChallenge.sql
 // sql\addCollection.sql
+// sql\addRecoveryCode.sql
 // sql\addReset.sql
 // sql\addSession.sql
+// sql\addTOTP.sql
+// sql\addTrade.sql
 // sql\addUser.sql
+// sql\addVerification.sql
+// sql\addWebhookEvent.sql
+// sql\claimMail.sql
+// sql\claimTrial.sql
+// sql\clearLoginFailures.sql
+// sql\enableTOTP.sql
+// sql\enqueueMail.sql
+// sql\getAdmin.sql
 // sql\getAllResets.sql
+// sql\getAllSessions.sql
+// sql\getAuditLog.sql
 // sql\getCard.sql
+// sql\getChallenge.sql
 // sql\getCollectionContents.sql
 // sql\getCollectionHistory.sql
+// sql\getCollectionHistoryPage.sql
 // sql\getCollectionList.sql
 // sql\getCollectionMeta.sql
+// sql\getCollectionPage.sql
+// sql\getCoupon.sql
+// sql\getLoginAttempts.sql
+// sql\getMail.sql
+// sql\getOutboxStats.sql
+// sql\getPlan.sql
+// sql\getPlans.sql
 // sql\getReset.sql
 // sql\getSessions.sql
 // sql\getSub.sql
+// sql\getSubByCustomer.sql
+// sql\getTOTP.sql
+// sql\getTrade.sql
+// sql\getTradeCards.sql
 // sql\getUser.sql
+// sql\getUserPlan.sql
+// sql\getVerification.sql
+// sql\markMailFailed.sql
+// sql\markMailSent.sql
 // sql\modSub.sql
+// sql\recordAPIRequest.sql
+// sql\recordAdminAction.sql
+// sql\recordAudit.sql
+// sql\recordLoginFailure.sql
+// sql\redactOutbox.sql
+// sql\redeemCoupon.sql
+// sql\rehashPassword.sql
+// sql\releaseCoupon.sql
+// sql\releaseTrial.sql
+// sql\removeAPIUsage.sql
+// sql\removeAuditLog.sql
+// sql\removeCardValue.sql
+// sql\removeChallenge.sql
+// sql\removeChallenges.sql
+// sql\removeCollectionContents.sql
+// sql\removeCollectionHistory.sql
+// sql\removeCollections.sql
+// sql\removeRecoveryCode.sql
+// sql\removeRecoveryCodes.sql
+// sql\removeResets.sql
 // sql\removeSession.sql
+// sql\removeSessions.sql
+// sql\removeSub.sql
+// sql\removeTOTP.sql
+// sql\removeTrades.sql
+// sql\removeUser.sql
+// sql\removeVerifications.sql
+// sql\searchUsers.sql
 // sql\setCollectionPermissions.sql
+// sql\setDisabled.sql
+// sql\setEmail.sql
+// sql\setLocale.sql
+// sql\setLockout.sql
 // sql\setMaxCollections.sql
 // sql\setPassword.sql
 // sql\setSubEffects.sql
+// sql\setTOTPCounter.sql
+// sql\setTradeReverted.sql
 // DO NOT EDIT!
 
 package userDB
@@ -90,382 +156,1662 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _sqlAddcardSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x52\xcb\x6e\xdb\x30\x10\x3c\xdb\x80\xff\x61\x0f\x39\x24\x81\xd3\xa0\xef\xd7\x51\xf0\xad\xe8\xcb\x2a\xd0\x5b\xc1\x98\x6b\x99\x28\xc5\x55\xc5\x55\x02\xfd\x7d\x87\xa4\x12\x09\xce\x61\x05\x69\x67\x38\xbb\x33\xe2\xed\xf5\x66\xbd\x59\xff\xfa\xbe\xdf\xfd\xac\x23\xb9\xa0\x42\x43\xe4\xbe\x12\xef\xf9\xa0\x4e\x42\x25\x41\x39\x68\x44\xdb\x85\x86\xf4\xc4\x64\xac\xfd\x73\x30\xbd\xa5\xe3\x10\x32\xe7\x45\xd2\xa8\x64\xf0\x96\x3a\x49\x6c\x67\xbc\x1f\xc9\x8b\x74\x74\x94\x9e\xef\xb9\xa7\xbb\x41\xa9\x11\xb1\x78\x58\xb2\xc2\x11\xd4\xa8\x4d\x8f\x97\xc0\x6c\x21\xec\xf0\x66\xd4\xdd\xb3\x1f\xb3\xe0\xd3\x98\x93\x89\x79\x2e\xa4\x5a\xa3\x9b\xf5\xea\x11\xb9\x8c\x1d\x1f\xbe\x3d\x04\xc8\xd7\xbb\xdf\xf5\x96\xd2\xf7\xbc\x7a\x69\x82\xbf\x5a\x65\x00\x27\xbe\x9a\x96\x17\xdc\x3d\xeb\x59\xa7\x92\xb6\x85\x81\xb3\xa3\x3f\x06\x03\x53\x3a\xa6\x80\x0a\x0f\x1d\x9f\x1a\x30\x11\xdd\x9d\xe7\xe9\xbb\x80\xb5\x83\xa6\xe2\x11\xd5\xb4\xdd\x55\x32\x53\x9b\xbf\x1c\x3f\x41\x50\xf2\xba\x37\x14\xb5\x47\x9e\xdb\x9c\x36\xdc\x19\x25\x20\xf8\x03\xc9\xdf\x61\xb6\x30\x13\x17\x4d\x39\x96\x13\xe9\x6c\xa2\x3f\x1a\x9b\xc9\x29\xae\x56\x1b\x4a\x10\x18\x71\xf2\xf9\x9c\x00\x24\x0f\x2c\xae\x67\xdc\x94\xc5\x26\x00\x94\x7f\x93\xe1\x25\xc5\xf2\xd1\x05\xfc\xbb\x09\x03\xcb\x1b\xdc\x91\x25\x25\x35\x06\xd3\x30\x92\x4b\xf3\x8a\x50\xc9\xf2\xa6\xa4\x79\x92\x07\x6a\x4d\x18\xf3\xae\x71\xb3\xbe\xbe\x4d\x79\xed\x77\x5f\x76\x55\xfd\x74\xd5\x2e\x2f\x5e\x6e\xe9\xe2\x15\xea\x35\xea\x0d\xea\x2d\xea\x1d\xea\x3d\xea\x03\xea\xe3\xd5\xe7\xff\x01\x00\x00\xff\xff\x00\xd9\x70\xcb\xcc\x02\x00\x00")
+var _sqlAddadminSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x90\x4d\x4f\xc3\x30\x0c\x86\xcf\x44\xca\x7f\xf0\x61\x07\x36\x85\x4d\x7c\x9c\xb8\x4d\x63\xb0\x4a\x53\x2a\xb1\xc2\x05\x71\xc8\x1a\x43\xa2\xad\x09\x6a\x3c\xaa\xfd\x7b\x9c\x52\x89\x72\x70\xe2\x8f\xe7\x75\xec\x2c\x66\x52\x2c\xad\x4d\x60\x02\x18\xdb\x78\x3e\xf7\x47\x04\x8a\x70\x4a\x7c\x39\x1c\xb2\x09\xdb\x6f\x5f\xa3\x14\x52\xe8\x48\xce\x87\x4f\xf0\x2c\xb2\x16\x2d\x74\x0e\x43\x8f\x06\xd3\x60\x4e\x93\x39\x60\x98\x67\xb6\x62\x2f\xdd\x4b\x71\xd1\x97\xae\x20\x51\xcb\x52\xc5\x92\x38\x6a\xee\x13\x13\x6d\x3c\xfe\x27\x0c\x8d\x90\xc6\x9c\xc1\x46\xc6\x0e\x78\xde\x98\xe4\x98\x7c\x7b\xdf\x9f\x09\x15\xb8\x1c\xc6\x8f\x1e\xe6\xea\x48\x64\x4e\xec\x07\xf2\xb5\x21\x4c\xd0\x79\x72\xdc\xa0\x6e\x91\x43\xcb\x0d\xc8\x37\x98\xc8\x34\x5f\xea\x6f\x85\x5f\x61\x67\x86\xdd\xa4\x98\x2d\xf2\x1e\x85\xde\xad\x9f\x2b\x28\x74\x55\xe6\x8f\x69\xd3\xbc\x07\xf3\xdc\x97\x79\x35\x05\x79\x7c\x05\xc3\x74\x0a\x86\x57\xa6\x52\xbc\x2e\xb7\x2f\xeb\x5d\x06\x27\xd7\x0a\x26\x37\x6c\xb7\x6c\x77\x5c\x2a\x35\xac\x4a\xfd\xb8\x2d\x56\x15\xf4\x6d\xa6\xf0\x50\x82\x2e\xab\x4d\xa1\x9f\x7e\x00\x2a\x9e\x0f\x68\x9a\x01\x00\x00")
+
+func sqlAddadminSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddadminSql,
+		"sql/addAdmin.sql",
+	)
+}
+
+func sqlAddadminSql() (*asset, error) {
+	bytes, err := sqlAddadminSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addAdmin.sql", size: 410, mode: os.FileMode(438), modTime: time.Unix(1792418256, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddcardSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x53\xcb\x6e\xdb\x30\x10\x3c\xdb\x80\xff\x61\x0f\x39\x24\x81\xdd\xd4\x4d\xdf\x3d\x1a\x6e\x2f\x46\x9b\xd6\x0a\xd0\x5b\x41\x8b\x6b\x49\x08\x45\xaa\x22\x1d\xc1\x7f\xdf\x59\x52\xb6\x14\xf7\xb0\x84\xb8\x3b\xfb\x9a\xa1\xee\x6e\x67\xd3\xd9\xf4\xf1\x61\xbb\xfe\x95\x79\xaa\x6c\x70\x74\xf0\xdc\xae\x9c\x31\x9c\x87\xca\xd9\x95\xb3\x81\x6d\xf0\x70\x57\xb6\xa0\x50\x32\x29\xad\xff\xe4\xaa\xd5\xb4\x3f\xd8\x88\x79\x25\x35\x56\xee\x60\x34\x35\x4e\xd0\x95\x32\xe6\x48\xc6\xb9\x86\xf6\xae\xe5\x67\x6e\x69\x77\x08\x54\x38\xa7\x71\x68\xd2\x8e\x3d\xa0\x3e\x14\x2d\x3e\x2c\xb3\x46\xe1\x0a\x5f\x2a\x54\xcf\x6c\x8e\xb1\xe0\xb9\x4d\xa9\x7c\xec\x8b\x52\xb5\x0a\xb3\xe9\xe4\x14\xb9\xf6\x0d\xe7\x3f\x3a\x8b\xf2\xd9\xfa\x77\x36\x27\xb9\x0f\xa3\x27\x27\xf0\x93\x49\x0c\x20\xe3\xbb\xaa\x79\x84\xdd\x72\xb8\xf0\xac\x5c\x5d\x63\x81\x8b\xd4\x9f\x07\x85\xa5\xc2\x51\x08\x4a\x38\x78\x8c\x38\xb0\x84\xaf\x76\x86\xfb\xfb\x90\xb2\x51\x60\xeb\x14\x95\xcb\x41\x15\x9c\x72\xb3\x0a\x2d\x03\x0e\x1f\x54\xdd\x0c\x29\x5f\x5d\x65\x68\xe7\x9c\x61\x65\x13\xf2\x5b\xab\xf4\x8b\xe5\xa2\xe3\x62\xb8\x8d\xcb\xd5\x79\xdd\x1b\x61\x2e\x53\x4f\xec\x3f\x03\xe0\x22\x37\x0b\xf2\xa1\x85\x78\xf3\x28\x2d\xa8\x54\x81\x10\x81\xdc\x42\x66\x3e\xf0\x35\x00\x47\x4e\xb7\x4f\x19\x92\x2b\xf0\x13\x8b\x03\x58\xb4\xa9\x43\x41\x12\x02\xc2\xf7\xa4\xfe\x0f\x40\x24\x36\x4c\x14\x0f\x71\x95\x06\xeb\x03\x80\xfc\xed\xd9\x1d\x43\x34\xef\x2b\x8b\x87\xd2\xc7\x80\x32\x42\xf1\x18\x62\x7a\x9a\x21\x93\xf4\x4b\x85\x92\x70\x8b\x24\x5d\xe9\x3a\xaa\x95\x3d\xc6\x59\x7d\xac\xe1\xc3\x63\xa3\x55\x90\x79\x07\x4d\xa8\x2b\xd9\xc6\xb9\xf3\x12\x45\x99\x3a\xbc\xc1\x1a\xdc\x23\x65\x2f\x32\x2d\xa2\x50\x11\x07\x54\x9b\xa0\x52\x93\x54\x2b\x2f\xb5\x32\x40\x16\x49\xbe\x61\xc4\xae\x74\x14\x9d\x7a\x94\xe0\x5a\xe2\xba\x89\x1b\xc5\xd8\x05\x71\xc9\x87\xaf\x23\x75\x8c\xda\x05\xfe\x10\x3b\x4e\x32\x27\xfd\xc7\x7d\x04\xf9\x72\xa6\x27\x6e\xc2\x28\xed\xf6\x4e\x5e\xca\x76\xbd\x59\xaf\xb2\xf3\x1f\x7d\x7d\xb5\x9c\xd3\xd5\x1b\xd8\x3d\xec\x2d\xec\x1d\xec\x3d\xec\x03\xec\x23\xec\x13\x6c\xf9\x5a\x0e\x41\x2e\x05\xba\xbc\xbf\xf9\xf2\x0f\x6c\xe6\x27\x76\x47\x04\x00\x00")
 
 func sqlAddcardSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAddcardSql,
-		"sql/addCard.sql",
+		_sqlAddcardSql,
+		"sql/addCard.sql",
+	)
+}
+
+func sqlAddcardSql() (*asset, error) {
+	bytes, err := sqlAddcardSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addCard.sql", size: 1095, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddcardhistoricalSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x92\x4d\x4f\xc3\x30\x0c\x86\xcf\x54\xea\x7f\xf0\x81\x03\xa0\xf0\x51\xbe\xe1\x86\x00\x89\x49\x68\x48\x30\xb8\x87\xd5\x6b\x23\xda\x64\x24\x1e\xd5\xfe\x3d\x76\xda\x42\x98\xc4\xc1\xad\x14\x3f\xaf\xf3\xc6\xf6\xe1\x5e\x9e\x4d\x6c\x40\x4f\x01\x34\x78\xd7\x81\xb1\xe4\x80\x6a\x84\x15\x9f\xde\xba\xa6\xc1\x39\x19\x67\x6f\x9d\x25\xb4\x14\x0e\xf2\x2c\xcf\x66\xfa\x03\xc3\x75\x9e\x6d\xb9\xce\xa2\x87\x7d\x08\xe4\x8d\xad\x54\xd4\xb0\x58\x13\x70\x26\x80\x21\x66\xe6\x3f\x35\x12\x30\x39\x74\x8b\x5e\x21\x5a\xc1\xb5\x2f\xa7\xba\xc5\x04\x16\x37\x2d\x55\x20\x29\x26\x02\xd2\x3f\x00\x67\xe2\x85\x6d\xcb\x56\x93\xbc\xee\x8d\x0d\x09\x46\x3e\x57\xba\x31\xb4\xfe\x83\x94\xb8\x30\x16\x4b\x18\x72\x4c\x35\xda\x56\x7f\x10\x39\x58\xe9\x0a\xb9\x49\x72\x5f\x5f\xc8\x52\x5f\x89\x1b\xa7\xa0\xe6\x0e\xb6\xda\xae\xa3\xd7\x10\x6b\x04\x7a\x5d\x96\x9a\xc4\x2f\x99\x16\x03\xe9\x76\xa9\xa0\xab\xd1\x46\xdf\xf3\x9a\x8b\x22\x74\x3a\xb0\xb0\x44\x96\x90\xe7\xff\xe4\x6e\x2c\x29\x50\x3c\xea\xdb\x24\x50\xdf\xd8\x85\x33\x0d\x43\xef\xce\x35\xb1\x1e\x83\xbe\x2f\x29\x77\x83\xf6\x08\x82\x30\x59\x89\x3c\x9d\x53\x57\x3b\x88\x87\x65\x22\x70\x1e\xb0\x5d\xc6\x97\xc7\xdc\x46\x83\xab\xc1\x03\xae\xa1\x43\xae\x5d\x99\x2f\x7e\x42\x22\x6a\xdc\x5c\x6f\x8c\x99\x5d\x79\xdc\xf0\xf4\x81\x4b\x4a\x64\x7b\x87\xb2\x51\x93\xe9\xcb\xfd\xf3\x0c\x26\xd3\xd9\x53\x9c\x55\x38\xf8\xdd\x90\x07\x13\xc8\xf9\x35\xe4\xd9\x4e\xdc\xb7\x74\x7b\x14\x8c\xfb\xa2\x60\xd8\x0b\x35\xce\x59\xc1\x38\x1d\x35\x0e\x55\xc5\x11\xca\x77\x9c\x8a\x82\xa1\xdd\x6a\xe8\xa8\xea\x1f\xea\x87\x3f\xb3\xc3\xb3\x76\xd9\xc0\xdb\xcd\xe3\xeb\xfd\x0b\x1b\xd9\x2e\x14\x6c\x1f\x73\x9c\x70\x9c\x72\x9c\x71\x9c\x73\x5c\x70\x5c\x72\x5c\x71\x14\x47\xf2\x11\xb2\x10\xb4\x10\xb6\x38\xdd\xfd\x06\x07\xb9\xb2\xf4\x74\x03\x00\x00")
+
+func sqlAddcardhistoricalSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddcardhistoricalSql,
+		"sql/addCardHistorical.sql",
+	)
+}
+
+func sqlAddcardhistoricalSql() (*asset, error) {
+	bytes, err := sqlAddcardhistoricalSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addCardHistorical.sql", size: 884, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddcardvalueSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8f\xbd\x6e\xc3\x30\x0c\x84\xe7\x0a\xf0\x3b\xdc\x90\xa1\x09\x94\x06\xfd\x99\xba\x75\xc8\x10\xa0\x70\x81\xc4\xcd\x2e\xc8\x4c\x2c\xd4\x96\x0d\x91\xae\x91\xb7\x2f\x65\xb7\x4b\x07\x02\xe4\xf1\xd3\xf1\xb4\xdb\x14\xe6\x48\xbe\x4f\x35\x63\x6a\x9c\xc0\x81\x43\xbc\xb6\x04\xdf\x0f\x37\xf4\x17\x15\x86\x14\xa2\xa8\x88\xc0\xf0\x63\x4a\x14\xa5\xbd\x61\xea\x93\x34\x85\x29\x4c\xe5\xbe\x88\x5f\x0b\x73\xe7\x5d\xaa\x4b\xd7\x11\xb6\x60\xd1\x37\x57\x8b\x98\x47\x35\x91\x46\x0d\x75\xad\x14\x93\xfc\x83\x54\x41\x10\x4c\x8e\x97\x53\x54\x23\x44\x25\x75\xf0\x99\x53\xc9\xe2\xdb\xb5\x23\x69\x0b\xaf\xe7\x59\xb7\xe3\x50\xbb\x8c\x6e\x21\xa1\x23\x16\xd7\x0d\x56\xbf\x40\x71\x3e\xb6\xe0\xd9\x52\x34\x9e\xba\x6d\x76\x39\xeb\xa1\x3c\xed\x8f\x15\x0e\x65\xf5\x81\x91\x29\xf1\x43\x4e\x75\xce\x70\xf6\xbc\xff\xfb\xc2\x1c\x6a\x69\xe6\x14\x16\xbf\xe7\xd6\x85\x39\xbf\xbd\x7f\xee\x4f\x99\x5e\x3d\x5a\xac\x9e\xb4\x9e\xb5\x5e\xd6\x3f\x50\xac\x67\x3b\x4c\x01\x00\x00")
+
+func sqlAddcardvalueSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddcardvalueSql,
+		"sql/addCardValue.sql",
+	)
+}
+
+func sqlAddcardvalueSql() (*asset, error) {
+	bytes, err := sqlAddcardvalueSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addCardValue.sql", size: 332, mode: os.FileMode(438), modTime: time.Unix(1792416216, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddchallengeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x8f\x3d\x6b\xc3\x30\x10\x86\xe7\x0a\xf4\x1f\xde\x21\x43\x13\xd4\x86\x7e\x4c\xdd\x4a\xc8\x10\x5a\x52\xa8\xdd\x2c\x25\xc3\xb9\x3e\xdb\x22\xb6\x1c\x74\x4a\x8a\xff\x7d\xcf\x86\x86\x0e\x1d\x0e\x04\x7a\xde\x7b\x9f\x5b\x2e\xac\xc9\x38\x94\x02\x82\x50\xe0\x76\x40\xc9\xd1\x9f\xb9\x44\xdb\xd7\x3e\xe0\xab\xa1\xb6\xe5\x50\x33\xfa\xaa\x42\xea\x91\x1a\x46\x49\x89\x0a\x12\xb6\xc6\x9a\x9c\x0e\x2c\x4f\xd6\x5c\x05\xea\x18\x37\x90\x14\x7d\xa8\x1d\x4e\xc2\x51\x61\x4a\xe8\xbf\x83\xc0\x27\x45\x2e\xcb\x5e\x78\x50\xf4\x73\x5f\x0c\x89\xdd\xb4\xb2\x21\x69\xb4\x42\x35\x2e\x90\x06\x24\x51\x4c\x3b\x6a\x7d\xe9\xa0\x96\xd3\x4b\x83\xc9\x77\xac\x5f\xdd\x51\x1c\xaa\x3e\xe2\x18\xf9\xcc\x21\x69\x2f\xa8\x38\x8d\x5e\x8b\xe5\xe8\xb6\xd9\x66\xeb\xf7\x1c\x9b\x6d\xfe\x36\xf9\xc8\xed\x74\xd4\xea\xb7\x41\x60\xcd\xf5\xe8\xed\xf0\x57\xcd\xe1\xbf\xde\xb9\xc2\xbb\xe7\xd7\x8f\x75\xa6\xa1\xd9\x9d\xc3\xec\x5e\xe7\x41\xe7\x71\x6e\xcd\x0f\xb1\xaa\x33\x9a\x49\x01\x00\x00")
+
+func sqlAddchallengeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddchallengeSql,
+		"sql/addChallenge.sql",
+	)
+}
+
+func sqlAddchallengeSql() (*asset, error) {
+	bytes, err := sqlAddchallengeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addChallenge.sql", size: 329, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddcollectionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x8e\xbf\x4e\x80\x30\x10\xc6\x67\x9b\xf4\x1d\x6e\x20\x51\x08\x42\xd4\xcd\xcd\x81\x81\x44\x31\x11\x74\xaf\x70\xb5\x8d\xd8\x1a\xee\x08\x3e\xbe\x47\x07\x82\x8e\xf7\xdd\xef\xfb\x53\x17\x5a\xf5\x18\x26\x02\x03\x76\x41\x72\x30\xc6\x79\xc6\x91\x7d\x0c\x10\xad\x05\x8e\xc0\x0e\x61\x7a\xaf\xb4\xd2\xea\xc9\xfc\x9c\x00\x02\x4f\x40\xc8\x07\x84\xd6\xac\x33\x8b\x0f\xee\x12\x3e\x98\x4f\xa4\x7b\xad\x2e\xe2\x16\x70\x81\x6b\x20\x5e\x7c\xf8\x28\x13\xbd\x92\x48\xec\x8c\xf0\x9b\x44\xb1\xf3\x24\x64\x30\x5f\xf8\x0f\xfc\x53\x38\x61\x60\x6f\xbd\x58\x7d\x38\x62\x2e\x65\xc6\xb7\x19\x51\xab\xa2\xde\x7b\xdb\xae\x6f\x5e\x06\x68\xbb\xe1\x39\xfd\xa9\x3a\x67\x68\x75\x95\xf6\x94\xb0\x97\xe5\x72\xbf\x3d\x3c\xbe\x36\xbd\xe8\xd9\x4d\x09\xd9\x6d\xfe\x1b\x00\x00\xff\xff\x4a\x96\x4b\xab\x16\x01\x00\x00")
+
+func sqlAddcollectionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddcollectionSql,
+		"sql/addCollection.sql",
+	)
+}
+
+func sqlAddcollectionSql() (*asset, error) {
+	bytes, err := sqlAddcollectionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addCollection.sql", size: 278, mode: os.FileMode(438), modTime: time.Unix(1428963928, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddrecoverycodeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8d\xb1\x0a\xc2\x30\x14\x45\x67\x03\xf9\x87\x3b\x38\x68\x69\x15\x05\x1d\xdc\x44\x0a\x0a\xa2\x60\xab\x8b\x38\xbc\xb6\x4f\x23\x62\x03\x7d\x51\xe9\xdf\x9b\x14\x1c\x5c\xef\x3d\x9c\x33\x8e\xb4\xca\xb8\xae\x04\xce\x30\x0c\x89\x81\xbd\x82\xd0\x70\x69\xdf\xdc\xb4\x28\x6d\xc5\x7e\xba\xc2\xd9\x0e\xa9\xc8\x51\x41\xc2\x5a\x69\x95\xd3\x83\x65\xa1\x55\xaf\xa6\x27\x23\x81\xb8\xe6\x5e\xdf\x62\xbc\x84\x1b\x0f\x93\x83\xfd\xd4\x82\xbb\xf3\x48\xf0\xac\x83\x3e\xc1\xf9\x52\xb4\x8e\x63\x88\xa1\xe9\x6c\x1e\x7a\x41\xfc\x57\xd4\x2a\x1a\x87\xc2\x66\x97\xa5\x87\x1c\x9b\x5d\xbe\xef\xac\x32\xfa\x61\x2b\x4f\x09\xb4\x1a\x84\x76\x8c\x9f\x7e\xe8\xa7\xd3\x72\x7b\x4c\x33\x7f\xf5\x27\x31\xfa\xd3\xa1\x56\x5f\xc1\x5f\xce\x10\xe6\x00\x00\x00")
+
+func sqlAddrecoverycodeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddrecoverycodeSql,
+		"sql/addRecoveryCode.sql",
+	)
+}
+
+func sqlAddrecoverycodeSql() (*asset, error) {
+	bytes, err := sqlAddrecoverycodeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addRecoveryCode.sql", size: 230, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddresetSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x8f\x3f\x4f\xc3\x30\x10\xc5\x67\x22\xf9\x3b\xbc\xa1\x03\xad\x0c\x15\x7f\x26\x36\x86\x0e\x15\xa8\x48\x24\x74\x41\x0c\x17\xf9\x02\x56\x1b\xa7\xf2\x5d\x83\xf2\xed\x39\x07\x46\x06\x4b\x96\xde\xef\xe9\xfd\x6e\xbd\x72\x55\xcd\x29\x08\x08\x42\x89\x8f\x13\x02\xe7\x38\x72\x40\x66\x61\xc5\xd0\x75\xd0\x01\xfa\xc5\x08\xa4\xd4\x92\xb0\xab\x5c\xd5\xd0\x81\xe5\xc1\x55\x17\x89\x7a\xc6\x15\x44\x73\x4c\x9f\x1e\x67\xe1\x6c\x30\x59\xf1\x3b\x09\xa2\x1a\x22\x2c\x12\x87\xf4\xc4\x93\x81\xef\x1f\xed\xa4\xec\x6d\x6e\xa4\x63\x0c\xf8\x0b\x71\xe0\xa9\xa0\x4a\x59\xf7\x25\xf0\x30\xab\xf9\x67\x25\x8d\x3d\x5b\xd4\x9f\xc4\xa3\x1b\x32\x4e\x99\x47\x4e\x6a\x8b\xa0\xf6\x5c\x8c\x56\xeb\x62\xb5\xdd\xd5\x9b\xd7\x06\xdb\x5d\xf3\x32\x9b\xc8\xf5\x7c\x84\xc0\x55\x97\x45\xd4\xff\x1e\x65\x26\x1e\xff\x4d\x2d\x0d\xdc\x3f\x3e\xbf\x6d\x6a\x2b\x2c\x6e\x3c\x16\xb7\xf6\xee\xec\xdd\x2f\x7f\x02\x00\x00\xff\xff\x0d\xce\x15\x28\x2a\x01\x00\x00")
+
+func sqlAddresetSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddresetSql,
+		"sql/addReset.sql",
+	)
+}
+
+func sqlAddresetSql() (*asset, error) {
+	bytes, err := sqlAddresetSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addReset.sql", size: 298, mode: os.FileMode(438), modTime: time.Unix(1428963994, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddsessionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x8e\x3f\x4f\x02\x41\x10\xc5\x6b\xe6\x53\xbc\x82\x02\xc8\x2a\xf1\x4f\x65\x67\x41\x41\x34\x98\x78\x27\x8d\xb1\x98\xcb\xcd\xe9\x06\x6e\x97\xec\x8c\x67\xee\xdb\x3b\x10\x12\x1a\x8a\x49\x26\xbb\x6f\xde\xef\xb7\x5c\x50\x25\xa9\x55\x30\x94\x93\xec\x47\xb4\x52\xe2\x20\x2d\x54\x54\x63\x4e\xc8\x5d\x07\xcb\xb0\x1f\x41\xcb\xc6\x0d\xab\x10\xd5\xbc\x13\x7d\xa2\x49\xe2\x5e\x70\x03\xb5\x12\xd3\x77\xc0\xaf\x4a\xf1\x24\x1b\xf2\x5f\x52\x44\xa3\xc9\xb9\xe6\x45\x46\xcf\x7d\x7e\x35\xa3\x49\x70\xd8\xc0\xfb\x78\x61\xec\x64\xf4\xa4\x71\xb1\xed\xf1\x3d\xc0\x95\x4e\x9b\xdf\x58\xec\xc5\xbf\xfa\x83\x06\x74\xb9\xe0\x50\x64\x90\x64\xce\x03\x37\x0e\xa4\xc5\x92\x68\xbd\xa9\x56\xef\x35\xd6\x9b\xfa\xed\x24\xa1\xb7\xe7\x6a\x05\xcd\x8e\x92\x01\x17\x11\xdf\xaf\xa0\xe6\xa0\xed\xf3\xeb\xc7\xaa\xa2\xd9\xf4\x2e\x60\x7a\xef\xf3\xe0\xf3\x38\xff\x0f\x00\x00\xff\xff\x92\x18\x13\x59\x24\x01\x00\x00")
+
+func sqlAddsessionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddsessionSql,
+		"sql/addSession.sql",
+	)
+}
+
+func sqlAddsessionSql() (*asset, error) {
+	bytes, err := sqlAddsessionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addSession.sql", size: 292, mode: os.FileMode(438), modTime: time.Unix(1428963914, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddtotpSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8d\xb1\x0e\x01\x41\x14\x45\x6b\x93\xcc\x3f\xdc\x42\x81\x2c\x82\x4e\xa7\xd8\x62\x13\x21\xb1\x4b\xff\x98\xb7\x56\xac\x19\x99\xf7\x44\xfc\xbd\x59\x24\xda\x73\x4f\xce\x9d\x8e\xac\x29\xd9\x3b\x01\xa1\x8e\x2c\x4d\xfb\x02\xfb\x18\xda\x96\x5d\x06\x1f\x14\x2f\xd6\x44\xe8\xf8\x01\xc2\xa7\xe0\x1d\x6a\x3a\x69\x88\x08\x75\x0d\x0d\xd0\x86\xe1\x48\xe9\x48\xc2\xd6\x58\x53\xd1\x95\x65\x69\x4d\xcf\xd3\x8d\x31\x86\x68\xbc\xf8\x73\x86\x87\x70\x4c\x32\x29\xc2\xd3\x0b\x2e\x9a\x94\x14\x8c\xe9\xe0\x2f\x75\xb1\x2e\xb4\x98\x43\x1a\x8a\xec\xf0\x55\xac\x19\x4d\xbb\x78\xb1\x29\xf3\x5d\x85\x62\x53\x6d\x3f\x41\x99\x68\xd0\x3b\xac\x19\x74\x6f\xd9\xcf\x1e\x26\x70\x58\xad\xf7\x79\x99\x86\xfe\x2c\x43\x7f\x3e\xb4\xe6\x0d\x41\x0b\x9e\x13\xed\x00\x00\x00")
+
+func sqlAddtotpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddtotpSql,
+		"sql/addTOTP.sql",
+	)
+}
+
+func sqlAddtotpSql() (*asset, error) {
+	bytes, err := sqlAddtotpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addTOTP.sql", size: 237, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddtradeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x90\x4f\x4f\xc3\x30\x0c\xc5\xcf\x44\xca\x77\xf0\x61\x12\x30\x85\x8d\x3f\x3b\xed\xc6\xa1\x42\x95\x50\x27\x75\x1d\xf7\xd0\x7a\x6b\xc4\x9a\x20\xdb\xa5\x5f\x1f\xb7\x83\x31\x0e\x96\x12\xfb\xf7\xde\x93\xbd\x9c\x5b\x53\x62\x9d\xa8\x61\xf0\x20\xe4\x1b\x04\x7f\xf0\x21\xb2\xe8\xbf\x67\xa4\x6b\x86\x3a\x1d\x8f\x58\x4b\x48\xd1\x01\xa1\xf4\x14\x43\x3c\x40\x10\x86\xd0\x2c\xac\xb1\xa6\xf2\x1f\xc8\x6b\x6b\xae\xd2\x10\x91\xe0\x0e\x58\x48\x11\x37\x19\x80\xb4\x5e\x40\x27\x8a\x8b\x32\x7f\x6e\x17\xe0\x45\x33\xed\x4f\x8a\x51\xab\x38\xf7\xef\x5d\x10\xc1\x46\x69\x09\x1d\xb2\xf8\xee\xd3\xc1\xd0\x62\x54\x3f\x18\x3c\xc3\x19\x51\x9c\xf0\x0b\x49\x36\x7b\xa5\x43\x14\xa7\x56\xf8\xb3\x96\xb4\x81\x21\x45\x84\x13\xa2\x6f\x82\x7b\x6b\xe6\xcb\x71\x83\xbc\xd8\x66\x65\x05\x79\x51\x6d\xa6\x60\x5e\x4c\x22\x56\xc7\x9b\x69\x29\xf7\xef\x0a\xe7\x44\x07\xbf\x81\xb7\xd6\xbc\x3d\xbf\xee\xb2\xed\x28\x99\x3d\x38\x98\x3d\x6a\x3d\x69\xad\x74\x54\x66\xd5\xae\x2c\xf2\xe2\x45\x4f\xf6\x0d\x36\xfd\x53\xbf\x74\x01\x00\x00")
+
+func sqlAddtradeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddtradeSql,
+		"sql/addTrade.sql",
+	)
+}
+
+func sqlAddtradeSql() (*asset, error) {
+	bytes, err := sqlAddtradeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addTrade.sql", size: 372, mode: os.FileMode(438), modTime: time.Unix(1792416657, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAdduserSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x90\x31\x4f\xc3\x40\x0c\x85\x67\x22\xe5\x3f\x78\xe8\x40\xab\xd0\x0a\x0a\x0b\x1b\x43\x25\x2a\x41\x41\x24\x74\x77\x73\x4e\x73\x22\xb9\x43\x67\xb7\x81\x7f\x8f\x2f\x81\x14\x18\x2c\x4b\xd6\xfb\x9e\x9f\xbd\x98\xa5\x49\x4e\xce\x30\x20\x1c\x29\xd8\xca\x92\x81\x03\x53\x00\x5f\x55\x20\x1e\xa4\x26\x30\x28\xb8\x43\xa6\x79\x9a\xa4\xc9\x23\x7e\x40\xe9\x9b\x86\x4a\xb1\xde\x31\x58\x06\x26\x19\xa5\x54\xe1\xa1\x11\xa5\x61\xd9\xcb\x0b\x7c\x23\xbe\x4d\x93\x33\x87\x2d\xc1\x05\xb0\x04\xeb\xf6\xd9\xb0\x43\x6a\x54\x69\x17\x5d\x44\x25\xd4\xa2\x6d\x7e\x69\xa2\x21\x1a\x13\x88\x19\x3a\x82\x12\x9d\x6e\x76\x82\xa5\x68\xda\xde\x00\x23\xf6\x8e\xcc\xf7\xc8\xb5\x92\xbb\x4f\x21\x1c\x40\xa5\xbe\x83\x18\xbd\xeb\xa8\x86\xfd\x38\x8a\x3b\x1f\x0c\x74\x56\x6a\x70\xde\x95\x14\xc3\xc5\xfe\x97\x1f\x46\xba\xc5\x40\xe5\xc3\xc9\xe4\x67\x9b\x52\xb5\xb6\x67\x0c\xd8\xf2\xff\xd0\xcd\xde\x07\xf5\x6f\x01\x9d\xd1\xcc\x2c\x23\x06\x1d\xf2\x60\x46\x43\x86\x34\x99\x2d\xe2\xa3\xd6\x9b\x7c\xf5\x52\xc0\x7a\x53\x3c\xf5\xb7\xf1\xbc\x25\x41\x48\x93\xf3\xf8\xb9\x0c\xfa\xe7\x64\xa3\x4f\x36\x04\xcc\xe0\x14\x62\xaa\xe2\xed\xdd\xc3\xeb\x2a\x57\x68\x72\x99\xc1\xe4\x4a\x6b\xa9\x75\xad\x75\x33\xfd\x02\x58\xd0\x8b\x79\xec\x01\x00\x00")
+
+func sqlAdduserSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAdduserSql,
+		"sql/addUser.sql",
+	)
+}
+
+func sqlAdduserSql() (*asset, error) {
+	bytes, err := sqlAdduserSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addUser.sql", size: 492, mode: os.FileMode(438), modTime: time.Unix(1792414416, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddverificationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x4f\x3b\x4f\xc3\x30\x10\x9e\xb1\xe4\xff\x70\x43\x07\x5a\x19\x2a\x5e\x0b\x1b\x43\x87\x0a\x54\x24\x12\xba\xa0\x0e\x17\x7c\x21\x56\x13\xa7\xf2\x5d\x83\xf2\xef\x39\x07\x84\x2a\xc4\x70\xf2\xc9\xf7\x3d\x97\x0b\x6b\x0a\x8a\x9e\x01\x81\x31\x52\x3b\x82\xa7\x14\x06\xf2\x30\xe8\x5b\x87\x77\x94\xd0\x47\xe8\xeb\x1a\xa4\x07\x69\x08\x3c\x0a\x56\xc8\x64\x8d\x35\x25\xee\x89\xef\xad\x39\x8b\xd8\x11\x5c\x00\x4b\x0a\xf1\xc3\xc1\x91\x29\x29\x18\x05\xfa\xcf\xc8\x10\x44\x21\xd4\x61\x68\x4f\x30\x59\x0b\xbd\x4f\xc4\x0c\x15\xe9\xd7\x8f\x23\x79\x05\x4f\xeb\xf8\x48\xa3\x12\xde\x76\xd5\x28\xf4\x4d\x68\x90\x1b\x0d\xa3\x69\x07\x6c\xc3\x9f\x90\x7b\x1a\x95\xca\x82\x49\xb6\xf9\xea\x40\x9b\x4d\x9b\xaa\x48\xe8\x48\x4f\xdd\x81\x1d\xd4\x7d\x82\x43\xa2\x81\xa2\x64\x5f\xac\x8e\xb9\xce\x62\x99\x2b\xad\x37\xc5\xea\xa5\x84\xf5\xa6\x7c\x9e\x6a\xf0\xe5\xa9\x07\x83\x35\xe7\xb9\xac\x6a\xe7\x3e\x0e\x7e\x93\x3a\xf8\xcf\x79\xae\x84\xed\xc3\xd3\xeb\xaa\x50\xe2\xec\xca\xc1\xec\x5a\xe7\x46\xe7\x56\xe7\x6e\x6e\xcd\x17\x28\x4b\xb8\x1f\x83\x01\x00\x00")
+
+func sqlAddverificationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddverificationSql,
+		"sql/addVerification.sql",
+	)
+}
+
+func sqlAddverificationSql() (*asset, error) {
+	bytes, err := sqlAddverificationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addVerification.sql", size: 387, mode: os.FileMode(438), modTime: time.Unix(1792413950, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlAddwebhookeventSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x8f\x41\x4b\xc3\x40\x10\x85\xcf\x2e\xec\x7f\x78\x87\x40\x6b\x49\x5b\xe2\x51\xf0\x20\xba\x62\x40\x13\x48\x02\x7a\xdd\x24\x13\xb3\xb4\xdd\x0d\xbb\x6b\x42\xff\xbd\x9b\x9a\xe2\xc5\xe3\x0c\xef\x7b\xf3\xcd\x7e\xc3\x59\x41\x8d\xb1\xad\x83\xc4\x44\x75\x6f\xcc\x01\x34\x92\xf6\x90\x0e\xbd\xd4\xed\x91\x5a\xa8\x0e\xca\x87\xc9\xe9\x95\x47\x4d\xa4\x21\x8f\x96\x64\x7b\xde\x71\xc6\xd9\x63\xd7\x51\xe3\x1d\xb4\x81\x35\x93\x43\x67\x2c\xa4\x5e\x5a\x26\x5a\x8d\x74\x8d\xc3\x05\xf6\xc2\x54\xf2\x40\xee\x9e\xb3\x9b\x4b\x2a\x7d\xc6\x16\xce\x5b\xa5\xbf\x62\xf8\x9e\x16\x56\xb5\xb3\xc4\x60\xcd\xa8\xda\x60\x51\x9f\x2f\x99\x81\x02\x66\xa9\x21\x35\x86\xe5\x16\x5e\x9d\xc8\x79\x79\x1a\x62\x4c\x7d\x50\x9b\xe8\xcf\xdb\x73\xb6\xd9\xcf\xf7\xd2\xac\x14\x45\x85\x34\xab\x72\x7c\x3b\xb2\x6e\xb7\x3c\x2b\xe6\x4b\x0e\xeb\xc5\x23\xc6\xb5\xf9\x96\xb3\x52\xbc\x89\xa7\x0a\x51\x12\x23\xba\xe3\xec\xe3\x55\x14\x02\x59\x5e\x41\x7c\xa6\x65\x55\x62\xbd\x04\x12\xbc\x14\xf9\xfb\xbf\xbd\xbf\xcc\x52\xfe\x10\x25\xa1\xf5\x07\xd6\x5c\x3b\x52\x74\x01\x00\x00")
+
+func sqlAddwebhookeventSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlAddwebhookeventSql,
+		"sql/addWebhookEvent.sql",
+	)
+}
+
+func sqlAddwebhookeventSql() (*asset, error) {
+	bytes, err := sqlAddwebhookeventSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/addWebhookEvent.sql", size: 372, mode: os.FileMode(438), modTime: time.Unix(1792414528, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlClaimmailSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x52\x5d\x6f\xd3\x40\x10\x7c\x8e\x25\xff\x87\x79\xa8\x14\xa8\xd2\x54\xc0\x1b\x1f\x0f\xa1\x31\x10\xa9\x4d\x91\x71\x85\x78\x3c\x9f\x37\xf1\x11\xfb\xce\xf2\xae\x49\xfb\xef\xbb\xe7\x24\x88\x00\x4f\xb7\xde\xdb\x99\xd9\x99\xf3\xf5\x65\x9a\xa4\xc9\x4d\x63\x5c\xcb\x30\x28\x8d\xd8\x1a\x61\x83\x8e\x7c\xe5\xfc\x16\xd4\x1a\xd7\x30\xa4\x36\x02\xd3\x13\xaa\x81\xb0\x09\x3d\x2a\x6a\xdc\x2f\xea\x9f\xe6\xbf\xe1\x71\xba\x1b\xb8\x26\x86\xa7\x47\x59\x88\x50\xdb\x09\xc2\x20\x90\xa0\x04\x84\x86\x0c\x13\x38\xc0\xf8\xa0\xdf\x3d\x58\x45\xf4\xd8\x07\x3f\x95\x34\xe9\x9c\xdd\x8d\x73\x6c\x5a\x42\x4b\xcc\x66\x4b\x18\x3a\xec\x6b\xd7\x10\x9c\xc0\x31\x4a\x8a\x3a\x47\x75\xaa\xe6\x28\x14\xa0\x1a\x4a\xf3\xfd\x4b\x96\x67\x69\xa2\x43\x3d\xd9\x9a\xec\x8e\x2a\x98\x4d\xbc\x69\x82\xdd\x45\x98\x4a\xdb\xe0\xed\xd0\xf7\xe4\x05\xf6\x60\xba\x8a\xea\x08\x4a\xd7\x98\x6e\xb4\x53\x98\x1d\xf1\xdb\x34\x99\xf8\xb0\xc7\x15\xc4\xe9\x2e\x62\xda\x6e\x36\x6e\x77\x82\xc7\xb6\xce\x1c\x4c\x9d\x4d\xed\x6b\xf2\x9a\xe5\xc8\xaf\x3b\x9c\x9c\x94\x64\x83\xd6\x63\x84\x66\x6b\x9c\x57\xb4\x0d\x83\x52\x5d\xc1\x79\x39\xb0\xb7\x81\xe5\x84\xe0\x18\xdc\xc8\x92\x26\x97\xd7\x71\xb3\x87\xaf\xcb\x45\x91\x61\x60\xea\x79\xae\xae\xcb\xf0\xa8\x24\xdf\xb2\xe2\x2c\xf2\x0f\xb8\x78\x9d\x26\xc7\x38\x26\xae\xc2\x6a\x8d\x17\x5a\xe9\xe0\x6d\x76\x53\x40\x3b\x9f\xf2\xfb\xbb\xbf\x69\x26\x23\x02\x6a\x42\x06\x56\x92\xe9\xf1\x1f\x98\x62\xb1\x5e\x9e\x09\xbc\x57\x85\x57\x11\x71\x9f\x2f\xb3\x1c\x1f\x7f\xfc\x79\x1b\xfb\xb7\xab\xbb\x55\x81\x8b\x37\x5a\xbf\x8c\x68\x3d\xff\x4f\x1b\x43\xfe\x97\x38\xcf\x8a\x87\x7c\xbd\x5a\x7f\xd6\x55\x67\xf1\x35\x5d\xe7\x28\x26\xc4\x43\xf9\x93\xac\x16\x65\xa8\x9e\x66\xa8\xa5\x6d\x66\x30\x07\x30\xbf\x4b\x93\x67\xdb\x4a\x83\x19\xce\x02\x00\x00")
+
+func sqlClaimmailSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlClaimmailSql,
+		"sql/claimMail.sql",
+	)
+}
+
+func sqlClaimmailSql() (*asset, error) {
+	bytes, err := sqlClaimmailSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/claimMail.sql", size: 718, mode: os.FileMode(438), modTime: time.Unix(1792415412, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlClaimtrialSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x8d\x4b\x0b\x82\x40\x14\x85\xd7\x0d\xcc\x7f\x38\x8b\x20\x08\x4b\xda\x06\x2e\x24\x07\x5a\x59\x94\xd1\xfa\x9a\xd7\x07\xe9\x08\xde\x11\xe9\xdf\x37\x1a\xb4\x3b\x9c\xc7\x77\xc2\xad\x56\xa7\x96\x9a\x4e\x40\x18\x85\x87\x8d\xa0\xb7\x0c\x37\x34\xd4\x06\xa0\xb2\xe4\x97\x6b\x6c\x05\xdb\x63\xe8\x27\xc1\x54\xb3\x45\xe3\x7c\x8d\xda\x81\xa9\xf8\x20\x67\xef\xf8\x69\xa1\x95\x56\x19\xbd\x59\x8e\x5a\xad\x2c\x75\x8c\x1d\xc4\x83\x6c\x15\x2c\x68\xb8\x9a\x1c\xfa\xc9\x8a\x07\x68\xb5\x0d\xe7\xc1\xe3\x9a\xc4\x99\x59\x72\xd9\xcb\x98\x8b\x56\x77\x93\xfd\xfe\xb9\x40\xe4\xd5\xc8\x5a\x3d\xcf\xe6\x66\x30\x43\xa3\xf5\x01\x71\x9a\x20\xbd\xfc\x5b\x5f\x31\x99\x16\x96\xc5\x00\x00\x00")
+
+func sqlClaimtrialSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlClaimtrialSql,
+		"sql/claimTrial.sql",
+	)
+}
+
+func sqlClaimtrialSql() (*asset, error) {
+	bytes, err := sqlClaimtrialSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/claimTrial.sql", size: 197, mode: os.FileMode(438), modTime: time.Unix(1792419064, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlClearloginfailuresSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\xce\x4d\x0b\x83\x30\x0c\x06\xe0\xf3\x0a\xfd\x0f\x39\x08\x82\x6c\x93\xed\x38\xf0\x20\x58\xd9\x61\x1f\x20\xc2\xce\x45\x53\x17\xd4\x2a\x4d\xfd\xff\x6b\x77\xf1\x16\xc8\x93\x37\x6f\x9e\x49\x51\x2f\x6e\x40\xcf\x60\x34\x4d\xd8\xc3\xb4\x0c\x64\x19\xb4\xf1\xe8\x40\x03\x6f\x5d\x87\xcc\x66\x9b\x60\xb1\x28\x85\x14\xad\x1e\x91\x6f\x52\x1c\x46\xb2\x3d\x9c\x80\xbd\x23\x3b\x1c\x01\xc9\x7f\xc3\x49\x6a\xf5\x8c\x29\x2c\x61\xa2\x35\x0d\x8c\x7a\xb4\x9e\x0c\x85\xdd\x8e\x03\x85\x08\xa3\xa3\x55\x8a\x2c\x8f\xd1\x95\x7a\xa8\x56\x41\xdd\xbc\x9f\xb0\x31\x3a\x3e\xff\xdb\x94\xde\xe3\xbc\x86\x8a\x9f\xbb\x6a\x14\xc4\xbf\x45\x72\x81\xf2\x55\xc1\x1e\x5e\x24\x57\x29\x7e\x25\x50\x9a\xf2\xd0\x00\x00\x00")
+
+func sqlClearloginfailuresSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlClearloginfailuresSql,
+		"sql/clearLoginFailures.sql",
+	)
+}
+
+func sqlClearloginfailuresSql() (*asset, error) {
+	bytes, err := sqlClearloginfailuresSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/clearLoginFailures.sql", size: 208, mode: os.FileMode(438), modTime: time.Unix(1792414359, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlEnabletotpSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8e\x41\x4b\xc3\x40\x10\x85\xcf\x0e\xec\x7f\x78\x87\x82\x50\x52\x8b\x45\x3c\x08\x39\x88\x06\xbc\x14\x8a\x46\x3c\x4f\xb3\x53\xbb\x34\xee\x86\x9d\x49\xfb\xf7\xdd\xa4\x20\xde\x86\x37\x1f\xef\x7b\xeb\xa5\xa3\x2d\xe7\x93\x82\x31\xaa\xe4\x5b\x85\x4a\x97\xa2\xc7\x81\x3b\x4b\x19\xac\x90\xc8\xfb\x5e\x3c\xf8\x60\x52\x02\x74\xc9\x0b\x8e\xe5\xb1\x17\x89\x18\x72\x3a\x07\x2f\xde\x91\xa3\x96\x4f\xa2\x4f\x8e\x6e\x22\xff\x08\x56\x50\xcb\x21\x7e\x57\x73\x33\xec\xc8\x86\x74\x89\x8a\x60\x05\xe9\x59\xed\x25\x8d\x71\xea\x5c\x21\x44\x7b\x7c\xa8\x0a\x23\x18\x24\x87\xe4\xe7\x73\x36\x97\x86\xab\xf2\x52\x94\x67\xee\x83\x2f\xb4\xa3\xe5\x7a\x32\x7e\xee\x5e\x9f\xdb\x66\x16\xe8\x9d\x25\x1b\x1c\x7d\x34\xed\xdf\xe4\x1a\x96\x47\xa9\xf0\x5f\x56\x63\xb1\x71\xf4\xf5\xd6\xbc\x37\x8e\xa6\xa1\xf5\xe2\xde\xd1\x2f\xfe\xb0\x05\x2b\x09\x01\x00\x00")
+
+func sqlEnabletotpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlEnabletotpSql,
+		"sql/enableTOTP.sql",
+	)
+}
+
+func sqlEnabletotpSql() (*asset, error) {
+	bytes, err := sqlEnabletotpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/enableTOTP.sql", size: 265, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlEnqueuemailSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x50\x3d\x4f\xc3\x40\x0c\x9d\x89\x94\xff\xe0\xa1\x03\xad\x8e\x56\x7c\x2d\x30\x65\xe8\x50\x09\x15\x89\x06\xf6\x4b\xcf\x24\x07\xf7\x11\xce\x0e\x6d\xff\x3d\xbe\x08\x4a\x19\x18\x2c\x9f\x7d\xef\x3d\xdb\x6f\x31\x2b\x8b\xb2\xa8\x8c\x21\xd0\x90\x30\x18\x4c\x68\x00\xbd\xb6\x0e\x38\xc2\x40\x98\x68\x1e\x07\x6e\xe2\x3e\xd7\x0d\x82\x41\x67\x3f\x47\x94\x26\xa0\x18\x43\xce\x7d\x24\xb2\x8d\xc3\x79\x56\xab\xf5\x3b\xd2\x5d\x59\x9c\x25\xdc\xda\xde\x62\x60\xb8\x00\xe2\x64\x43\xab\xe0\x35\x26\xaf\x99\x33\xdd\x98\x84\x44\xc0\x1d\x82\x97\x87\x6e\x11\xda\x88\xd2\x88\xc2\xa5\xa1\x79\xc3\xed\x29\xf3\xa7\xe3\x6c\x40\x01\x34\xd1\x1c\x4e\x75\x07\xe7\x0e\xbf\x17\xf4\x4e\xdb\xc0\xb8\x67\xc8\x38\x81\xb3\xf5\x28\xf0\x9c\x88\xb5\xef\x15\xec\x3a\x0c\x60\x19\x76\xb2\xff\xc7\x80\x03\x1a\x81\x75\xec\xdd\xff\xaa\xe3\xaf\x76\x8c\x29\x68\x16\x17\x20\x26\xf1\xaa\x67\x19\x30\x5b\xe4\xd3\x57\xeb\xcd\xf2\xa9\x86\xd5\xba\x7e\xfc\xe3\x9d\x28\x9f\x1f\xdd\x38\x9e\xa2\xc6\xe5\x14\x6c\x13\x6a\x71\x44\x41\x90\x85\x2b\x31\x47\x24\xd5\x38\x6c\x5a\x16\x2f\xd5\xc3\xf3\x72\x93\x05\x26\x97\x0a\x26\x57\x12\xd7\x12\x37\xdf\x71\x3b\xbd\x2f\x8b\x2f\x33\xa3\xba\xf9\xc6\x01\x00\x00")
+
+func sqlEnqueuemailSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlEnqueuemailSql,
+		"sql/enqueueMail.sql",
+	)
+}
+
+func sqlEnqueuemailSql() (*asset, error) {
+	bytes, err := sqlEnqueuemailSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/enqueueMail.sql", size: 454, mode: os.FileMode(438), modTime: time.Unix(1792415412, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetadminSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\xcc\x3d\x0b\xc2\x30\x10\x06\xe0\xd9\x83\xfc\x87\x1b\x9c\x8a\x5a\x5c\x05\x07\x91\x48\x07\x45\xa8\x05\x07\x71\x88\xcd\xd5\x84\xb6\x89\x26\xa9\xd2\x7f\x6f\x5a\x14\x5c\x8e\x7b\xef\xe3\x49\x13\x06\x9b\xf2\xd9\x69\x47\x1e\x83\x22\x14\xb2\xd5\x06\x95\x6d\xa4\x36\xf7\x71\xf2\x70\xf6\xa5\x25\x49\xac\xa9\x67\xc0\xa0\x10\x35\xf9\x15\x83\x49\xcc\x99\xf0\x0a\xe7\x78\xb9\xde\xfa\x40\x33\x54\x43\xb4\xd5\xf8\x16\xb7\x7f\xa0\xe8\x62\x6f\x82\x2e\x45\x88\xd2\x5b\x07\xc5\x20\x49\x07\xee\xc4\xf7\x7c\x5b\xa0\x11\x6d\x04\x9c\x6d\x62\xfd\xc2\x0c\x76\xf9\xf1\xc0\xa0\xf3\xe4\xfc\x62\x74\x3c\x9e\x33\x9e\xf3\xdf\xc5\x7a\xba\xfc\x00\x1d\x9e\xde\xff\xc1\x00\x00\x00")
+
+func sqlGetadminSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetadminSql,
+		"sql/getAdmin.sql",
+	)
+}
+
+func sqlGetadminSql() (*asset, error) {
+	bytes, err := sqlGetadminSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getAdmin.sql", size: 193, mode: os.FileMode(438), modTime: time.Unix(1792416032, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetallresetsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3c\x8d\xbd\x6a\xc3\x30\x14\x46\xe7\x0a\xf4\x0e\xdf\xd0\xa1\x35\xaa\x4d\xd7\x42\x0b\xa6\x55\x09\xe4\x0f\x1c\x93\xcc\x22\xba\x49\x84\x13\x29\x91\x64\x1b\xbf\x7d\x6c\x05\xb2\x5d\x2e\xe7\x9c\xaf\xc8\x38\x2b\xf7\xb7\xd6\x78\x0a\x88\x27\x02\x75\xe4\x07\x74\xea\x6c\x34\xc6\x1f\x45\x34\x34\xe0\xe0\x3c\x14\xae\xde\x75\x46\x93\x46\x1b\xc8\xe7\x9c\x71\x56\xab\x86\xc2\x17\x67\x2f\x56\x5d\x08\x1f\x08\xd1\x1b\x7b\x14\x09\x18\x73\x2a\xc2\xf5\x36\xc0\x44\xce\xb2\x62\x12\x36\x72\x21\x7f\x6b\x4c\xb8\x78\xf4\xe7\x34\x88\xd1\x53\x3e\x6e\xa7\x51\x01\xb2\x3a\x5d\x9c\xfd\x57\xeb\x65\x4a\x85\x3c\xa1\x81\xb3\xdd\x4c\x56\x32\xe9\xdf\xaf\x9f\x28\x57\x7f\x4f\x1c\x3f\xb0\xae\x7f\x7b\xbf\x07\x00\x00\xff\xff\xc7\x94\x70\x4a\xd2\x00\x00\x00")
+
+func sqlGetallresetsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetallresetsSql,
+		"sql/getAllResets.sql",
+	)
+}
+
+func sqlGetallresetsSql() (*asset, error) {
+	bytes, err := sqlGetallresetsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getAllResets.sql", size: 210, mode: os.FileMode(438), modTime: time.Unix(1433183741, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetallsessionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8f\x3d\x4f\xc3\x30\x10\x86\x67\x4e\xba\xff\xf0\x0e\x4c\x55\x48\xc5\x8a\xc4\xc0\x87\x2b\x06\x50\xa5\x10\x81\x18\xdd\xfa\xa8\xad\xa6\x0e\xf8\x9c\x84\xfc\x7b\x1c\x60\x60\x3b\xe9\x9e\xf7\x7d\xee\xd6\x2b\xa6\x9b\xfd\xe7\x10\x92\x28\x26\x2f\x11\x32\x4a\x9a\xa1\xa2\x1a\xfa\x08\x8b\x41\x25\xc1\x5b\xc5\x4e\xca\x36\xa8\x0e\xe2\x2a\xc8\xd7\x47\x89\x38\xf4\x09\xb1\xcf\x15\xd3\x54\x88\xd1\x76\xc1\xd5\x68\xbd\xe0\x28\xb3\x22\x7b\x39\xa9\x74\x63\xa9\xb6\x49\xe0\xa4\x0b\x3b\x49\x36\x4b\x37\xa3\x93\xf7\x8c\x7e\xc8\x35\x13\x53\x6b\x8f\xa2\x57\x4c\x67\xd1\x9e\x04\x17\xd0\x9c\x42\x3c\x54\xbf\xee\xec\x6d\x21\xa7\xa8\x08\x99\x69\xb5\x5e\x02\xcf\xe6\xd1\xdc\xb5\x85\xb3\x29\xbf\x2c\xd6\x72\x51\x74\x3f\x13\xd3\xa6\xd9\x3e\x31\x2d\x59\xad\xff\xfe\x50\xa6\xd7\x07\xd3\x18\x2c\x82\xeb\xf3\x4b\xa6\x6d\x73\x6f\x1a\xdc\xbe\xfd\xeb\xf8\x06\x7d\xe1\x3e\xdc\x0c\x01\x00\x00")
+
+func sqlGetallsessionsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetallsessionsSql,
+		"sql/getAllSessions.sql",
+	)
+}
+
+func sqlGetallsessionsSql() (*asset, error) {
+	bytes, err := sqlGetallsessionsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getAllSessions.sql", size: 268, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetauditlogSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8e\x41\x6b\x02\x31\x10\x85\xcf\x0d\xe4\x3f\xcc\xc1\x93\xa4\x8a\x3d\x16\x7a\x68\x35\xa5\xc2\x8a\xb0\x2e\x94\x1e\x43\x1c\x75\xd0\x4d\xda\xcc\xc4\xd2\x7f\xdf\xd9\xad\xd0\xe3\x30\xdf\xfb\xde\x9b\x4f\xad\x79\x8e\x5f\x95\x0a\x32\xc8\x09\xa1\xcf\x2c\x50\x30\x62\x12\x60\x8c\xb5\x90\xfc\x00\x5e\xf5\x64\x38\xe4\x02\x01\x2a\x63\x71\x90\xf0\x1b\x95\x3c\x50\x61\xb1\xc6\x9a\x2e\x9c\x91\x1f\xad\xb9\x4b\xa1\x47\xb8\x07\x96\x42\xe9\xe8\x46\x7a\x14\xdf\x1c\x31\xa7\x88\x25\x29\x78\xa1\x9e\x44\x49\x4a\xe2\xfe\xab\x6f\x98\x64\x1d\x21\x75\x00\xa7\xf3\xc1\xbf\xf3\x8d\x5f\x76\x30\xd8\x1d\x84\x28\x59\x37\xd0\xa7\xfb\xe3\x1d\xf4\x28\x61\x1f\x24\xe8\x4f\xe7\xbc\xb6\xdb\x8d\x35\x43\x35\xcf\x42\xdd\x93\x34\xf9\x68\xcd\xfb\x9b\x6f\xfd\x68\x78\x9a\x2c\xac\xd9\xb6\x2b\xdf\xc2\xcb\x87\x26\x60\xe5\x77\x4b\x6b\x9a\xf5\x66\xdd\xc1\xe4\xe1\x17\xab\x00\x8e\xa0\x15\x01\x00\x00")
+
+func sqlGetauditlogSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetauditlogSql,
+		"sql/getAuditLog.sql",
+	)
+}
+
+func sqlGetauditlogSql() (*asset, error) {
+	bytes, err := sqlGetauditlogSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getAuditLog.sql", size: 277, mode: os.FileMode(438), modTime: time.Unix(1792415803, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcardSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x50\x4f\x6b\xfb\x30\x0c\x3d\xff\x0c\xfe\x0e\x3a\x04\x7e\x50\xb2\x96\xfd\xbb\x0c\x72\x28\x5d\xc6\x0e\x5b\x07\x5d\xc7\xce\x26\x51\x5b\xb3\xd4\x5e\x2d\xa5\xa5\xdf\x7e\xb2\x93\x51\x5f\x76\xb2\xac\xf7\x9e\x9e\x9e\x66\x13\xad\xe6\xcd\xa1\xb7\x01\x09\x78\x87\xd0\x19\x46\x62\x20\x96\x17\xfc\x06\x0c\x34\x26\xb4\x60\x9d\x54\x3d\x61\xf8\x4f\xd0\xf8\xae\xc3\x86\xad\x77\x53\xad\xb4\x5a\x9b\x2f\xa4\x07\xad\xfe\xf9\x93\xc3\x00\x57\xa2\x0d\xd6\x6d\xcb\x44\x97\x99\x86\x41\x10\x02\xcb\xc2\xb9\x68\x33\x62\xd6\x14\xc7\xa4\x88\xda\x48\x17\xef\xa5\xd9\x63\x46\x8e\x4b\xee\x79\x9b\xd6\x12\x06\x21\xff\x41\x10\x44\xf0\x43\x6f\x3a\xcb\xe7\x0c\xf7\x0e\x07\x1b\x84\x01\xb4\x12\xfd\x84\xb0\x33\x47\x8c\x22\x30\x04\x47\xe9\xb7\xb0\xf1\x21\xd9\x90\x56\x93\x59\x8c\xfa\x5e\xbf\xd4\x8b\x35\xfc\x6e\x55\xc2\xe8\x5e\x8e\x93\xce\xa9\x70\x9c\xaa\xce\x10\x7f\x7c\xb7\x72\x47\xad\x9e\x56\x6f\xaf\xa0\x55\x4c\x45\xd3\x4b\xdc\x85\x77\x8c\x8e\x65\xfe\xe7\x73\xbd\xaa\x85\x91\x6e\x58\x15\xd7\x30\x5f\x3e\x66\x77\xa9\x8a\x9b\xa1\x33\x3a\x57\xc5\x6d\xfa\x8f\xfe\x55\x71\x97\xbe\xe3\x16\x55\x71\xff\x13\x00\x00\xff\xff\x5d\xee\x86\xf6\xd8\x01\x00\x00")
+
+func sqlGetcardSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcardSql,
+		"sql/getCard.sql",
+	)
+}
+
+func sqlGetcardSql() (*asset, error) {
+	bytes, err := sqlGetcardSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCard.sql", size: 472, mode: os.FileMode(438), modTime: time.Unix(1428963905, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetchallengeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8f\xcd\x4b\x03\x31\x10\xc5\xcf\x06\xf2\x3f\xbc\x43\x0f\x5a\x62\x8b\x1e\x85\x16\x4a\x5d\x11\xfc\x82\x5a\xea\x41\x3c\x4c\x37\xd3\x4d\x70\x9b\x68\x92\x6e\xd9\xff\xde\xdd\x40\x3f\xbc\x0d\x33\xef\xfd\xde\xbc\xf1\x50\x8a\x59\xf9\xbb\xb3\x81\x23\xb8\xe1\xd0\xa2\xf6\x95\x75\x28\x0d\xd5\x35\xbb\x8a\xb1\xf1\x01\x84\x9f\xe0\x1b\xab\x59\x63\x17\x39\x20\x19\x4a\xd8\x52\x2a\x0d\x47\x29\x92\xe1\xd3\xfd\x64\x24\xa7\x61\x23\x1a\xaa\xad\x1e\x49\x21\xc5\x92\xbe\x39\xde\x49\x71\xe1\x68\xcb\xb8\x46\x4c\xc1\xba\x4a\x9d\x21\xfd\xde\x45\xd8\xd4\x49\x8e\x98\x27\x6e\x3b\xe9\xe7\xd7\xba\x4d\xac\xd0\x47\x19\x8a\x06\x7e\xd3\x3d\x75\x14\x49\x31\x1c\xf7\x09\xef\xc5\x73\x31\x5f\xa2\xe7\x2b\x9c\x23\x54\x17\x46\x21\xad\xfa\x5f\x14\xd8\xe9\x3c\x49\xf1\xb0\x78\x7b\xc9\xf9\x71\x94\x7b\xcf\x0f\x9e\xae\xd6\xc7\x63\xb1\x28\x32\x6b\x32\xb8\xc1\xec\xf5\xfe\x1f\x71\x32\xb8\xcd\xbb\x03\x0b\x53\x38\xbf\xbf\xbc\x92\xe2\x0f\xf5\xa5\x49\x6f\x54\x01\x00\x00")
+
+func sqlGetchallengeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetchallengeSql,
+		"sql/getChallenge.sql",
+	)
+}
+
+func sqlGetchallengeSql() (*asset, error) {
+	bytes, err := sqlGetchallengeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getChallenge.sql", size: 340, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectioncontentsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x4f\x4d\x4b\x03\x31\x10\x3d\x1b\xc8\x7f\x98\x43\x41\x28\xb1\x45\x8f\x42\x0f\xa5\xae\x78\xd0\x0a\xb5\xe2\x79\xc8\x4e\xdb\x60\x36\xb1\x99\x59\xc4\x7f\xef\x24\x0a\xdd\xd3\x3c\x66\xde\xd7\x2c\xe7\xd6\xac\xfd\x79\x0c\x85\x18\xe4\x44\x10\x51\x88\x05\x58\x74\x42\x3e\x00\xc2\xc8\x54\xae\x19\x7c\x8e\x91\xbc\x84\x9c\x16\xd6\x58\xb3\xc7\x4f\xe2\x7b\x6b\xae\xf2\x77\xa2\x02\x37\xaa\x28\x21\x1d\x5d\xa3\xab\x13\x0a\xe8\x85\x21\x88\x72\x2e\xda\x09\x71\xb2\xd4\x9c\xa6\xa8\x5a\x6b\xe6\xcb\x1a\xf0\xd6\x3d\x77\x9b\x3d\x78\x2c\xfd\x16\x07\x72\xc0\x24\x7f\xe0\x3c\x62\x0c\xf2\xd3\x40\x92\x86\x7c\x1e\x06\x4a\xe2\xb4\x7e\xb5\x8e\xc8\xf2\xfe\xd5\xeb\x0b\x4e\xd3\x0f\x39\x44\x07\xc7\x82\x3d\x95\xff\xa9\x94\xec\xb1\x66\x5b\xf3\xb8\x7b\x7d\xb1\xa6\x46\xf3\xe2\xd2\x69\x93\x93\xa8\x23\xc3\xc7\x53\xb7\xeb\xa0\x7d\xb9\x9a\xdd\xc2\x7a\xfb\x30\x69\xbe\x9a\xdd\xfd\x02\xdd\xae\x2a\x4e\x42\x01\x00\x00")
+
+func sqlGetcollectioncontentsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectioncontentsSql,
+		"sql/getCollectionContents.sql",
+	)
+}
+
+func sqlGetcollectioncontentsSql() (*asset, error) {
+	bytes, err := sqlGetcollectioncontentsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionContents.sql", size: 322, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectionhistorySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x90\xc1\x6a\xc3\x30\x0c\x86\xcf\x33\xf8\x1d\x74\x08\x0c\x4a\xd6\xb2\xed\x36\xc8\xa0\x6c\x19\x3d\x6c\x1d\x74\x1d\x3b\x6b\x8e\x92\x9a\x25\x76\x6a\x29\x2b\x79\xfb\xd9\x59\xa1\xb9\xd8\x42\xfa\x24\xfd\xbf\x56\x0b\xad\xd6\xe6\x38\xd8\x40\x0c\x72\x20\x38\x58\x16\x1f\x46\xf0\x35\x20\x0c\x4c\xe1\x9a\xc1\xf8\xb6\x25\x23\xd6\xbb\x84\xd8\x00\x7d\x8b\x0e\x3a\x1c\xe1\xd7\xd2\x69\xa9\x95\x56\x7b\xfc\x21\x7e\xd0\xea\xca\x9f\x1c\x05\xb8\x01\x96\x60\x5d\x93\x4f\x23\x62\x17\x0a\xc4\x0a\x83\x95\xc8\xcc\xe6\x5d\xc0\x59\x32\xee\x9e\x3a\x52\x6f\xc4\xd9\x3a\x43\x91\x14\xdb\x11\x0b\x76\x7d\x84\x0f\xe8\x9a\xa8\xf8\x9b\x6a\x1f\x28\xd2\x96\x01\x43\x52\x5f\x55\xe4\xb4\x5a\xac\x92\xa8\x8f\xf2\xb5\x7c\xda\x83\xc1\x50\x6d\xb1\xa3\x1c\x98\xe4\x3f\x38\x0e\xd8\x5a\x19\xa7\xc0\xc9\x14\x19\xdf\x75\xe4\x24\x87\xe8\xad\x49\x2f\xcb\x67\x5f\xa1\x50\x1e\x25\xd4\xde\xb6\x39\x34\x01\x2b\x0a\xe7\x3f\x22\xde\x60\xd2\xab\xd5\xcb\xee\xfd\x4d\xab\x24\x97\x97\x17\x1f\x9b\xf3\x29\xbf\x36\xe5\xae\x84\xe9\x30\x45\x76\x0b\xeb\xed\xf3\xcc\x6c\x91\xdd\x4d\x99\xcb\x3e\x78\x2c\x20\xbb\xff\x03\x44\x6d\xd2\xf1\x99\x01\x00\x00")
+
+func sqlGetcollectionhistorySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectionhistorySql,
+		"sql/getCollectionHistory.sql",
+	)
+}
+
+func sqlGetcollectionhistorySql() (*asset, error) {
+	bytes, err := sqlGetcollectionhistorySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionHistory.sql", size: 409, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectionhistorypageSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\xdb\x4e\xe3\x30\x10\x7d\x6e\xa4\xfc\xc3\x3c\x44\xe2\xa2\x2c\x50\xee\x54\xdb\x95\xd8\x25\x2b\xaa\x2d\xad\x54\x40\xab\x7d\x34\x89\xdb\x5a\x24\x76\xb1\x1d\x0a\x7f\xbf\x63\x27\xce\xad\x48\x85\xa7\xda\xe3\x73\x66\x3c\x67\x4e\xdc\xc3\x7d\xdf\xbb\x8e\x5f\x72\x26\xa9\x02\x02\x2b\xb2\xa0\x20\xe6\xa0\x97\x14\x96\x4c\x69\x21\xdf\xcd\x96\x40\xae\xa8\xdc\x51\x10\x8b\x34\xa5\xb1\x66\x82\x1b\x08\x93\xb0\x4a\x09\x87\x8c\xbc\xfb\xde\x2b\xa3\x6b\x5c\xe9\x78\xc9\xf8\xc2\x26\x58\x49\xf1\xca\x12\x9a\xc0\x9c\xa5\x9a\x4a\x15\x02\xa7\x6b\xaa\x34\xee\xa5\xd2\x07\xbe\xe7\x7b\x0f\xe4\x99\xaa\x81\xef\xf5\xc4\x9a\x53\x09\xdf\x40\x69\x89\xf4\xd0\xd6\xc3\x24\x44\x03\x9e\x28\x60\x1a\x31\x8d\xe2\x35\xb0\x11\xb4\xf7\x46\x86\xe1\x22\x5c\x31\x1e\x53\x44\x6a\x96\x61\x51\x92\xad\x10\xbc\x24\x7c\x81\x8d\x3e\xd1\xb9\x90\x14\xd1\x0c\x9b\x96\xa6\xd5\x24\xa1\xdc\x70\xa8\x6e\xe4\xa6\x6f\x24\xd6\x60\x62\x42\x02\xcd\x56\xfa\x1d\x90\x07\x84\x63\xbb\x3d\x4e\x32\xda\xc0\x8e\xc6\xa3\x3f\x11\xea\xa7\xb1\x53\x6e\x61\x46\x82\x98\xc8\x04\x0c\x12\x09\x2f\x39\x49\x19\xa6\xe8\xe6\x77\xf1\x0f\x6a\xa0\xb8\x8b\x0d\xbc\x09\xe6\x76\x4e\x9b\x84\x58\x64\x19\xe5\x7a\xfb\xbd\x0a\x9c\x29\x21\x62\xd2\x91\xb4\x2c\xe3\x0e\x3e\x28\x83\x66\xc9\x6d\xf7\x4f\x42\xa4\x21\xac\x97\x14\x93\x62\x66\x81\x39\x88\xd4\x40\xe6\x9a\x16\x85\xe6\x38\x1e\xb1\x36\x8e\x78\xa6\x45\x47\x4a\x3f\xae\x12\xa2\x3b\x93\xc9\x39\x7b\xc9\xa9\x01\x39\xfb\x19\x24\x48\xb1\x46\xfd\xed\x68\x8c\x94\x93\xb6\xe6\xdb\x49\x38\xbb\x2f\x73\x36\xe7\xb4\x9d\xd3\x19\xd4\x76\xc2\x5c\xb0\xb4\xd2\x6f\x3b\x7c\x21\x49\xd2\xfe\x3e\x3e\x47\xf9\x5a\x13\x9b\x56\xf8\x04\x89\x65\xcc\xf8\x8d\x71\x1d\x5a\x40\x26\x0a\x80\x32\x76\x90\x54\xe7\xd2\x5a\x68\xf2\x38\x1e\x17\x0e\x4a\x53\xdf\xdb\x3f\x34\x5f\xff\x7d\x34\x8e\x7e\x3d\x80\x9b\x6c\x08\xe5\xb8\x42\xf7\x4d\xd8\x05\xd7\x76\x55\x5a\x36\xb4\xfe\x0f\xa1\x36\x52\x58\xca\x19\x42\xa1\x52\xf9\x1b\x56\x0e\xf6\xbd\xdf\xb3\xe9\x9d\xef\x99\x77\x41\x1d\xd4\x0f\xc6\x6d\xf1\xc0\xf9\xde\xdf\xdb\x68\x16\x81\x7d\x82\x86\x41\x1f\xae\x27\x37\x8d\x67\x65\x18\x1c\xdb\x48\xc3\xb9\x3f\x86\x10\x9c\x98\x20\x56\xde\x0d\x4e\x07\x03\x4d\xdf\x34\x0c\x61\x67\x07\xa6\x33\xd7\x04\xee\xdd\xd1\x5e\x89\xad\x2c\x5c\x7c\x94\xc1\x59\xc9\x74\x99\xce\x3b\x99\x4a\x15\xaa\xa8\x03\xec\x55\x8c\x8b\x0e\xc3\x68\x53\xc3\x2f\x3a\xe5\xcb\xc7\xa1\xac\x7e\xd9\xa9\x7e\xd5\xcd\xe5\xec\x80\x99\xae\x3a\x85\x27\xd3\x07\x08\xfa\x47\x83\x81\x31\x30\xc5\xbf\x80\xe9\x0c\xc3\xbd\xdd\xc6\x54\xaa\xb1\x16\xd4\x6a\xb8\x6e\xdb\x6a\x2e\x6c\xdc\xdc\xcc\xb3\xd7\x9a\xa8\xc3\xd8\x5d\x45\x28\xaf\xe7\x6e\xf6\xdd\x5e\x20\xe8\xf7\x31\x50\xbf\x2b\x41\xff\xd8\x11\x82\xfe\x49\xbd\x3c\xad\x97\x67\xcd\xb2\x41\xff\xbc\x6a\xca\x1c\x5e\xd4\xb8\xcb\x7a\xe9\xe4\xd8\xf3\xbd\xe9\xec\x26\x9a\xc1\xcf\x7f\x4d\x7f\xdc\x44\xf7\xbf\xba\xfd\x97\xc1\x96\x08\x45\xac\x7e\x72\x5a\xc8\xc6\x28\x1d\xcc\x3e\x1a\xc5\x69\x53\x98\x66\xa8\x9d\xa2\x25\x91\x0d\xfa\xde\x78\x74\x37\xc2\xe1\x1d\x1f\xfd\x07\xd5\x5b\xb8\x31\xfb\x07\x00\x00")
+
+func sqlGetcollectionhistorypageSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectionhistorypageSql,
+		"sql/getCollectionHistoryPage.sql",
+	)
+}
+
+func sqlGetcollectionhistorypageSql() (*asset, error) {
+	bytes, err := sqlGetcollectionhistorypageSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionHistoryPage.sql", size: 2043, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectionlistSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x4f\x8b\xc2\x30\x10\xc5\xcf\x3b\x30\xdf\x61\x0e\x7b\x2a\xdd\x2d\x7b\x5d\xf0\x20\x12\xf1\xa0\x08\xb5\xe0\x39\x84\xd1\x04\x6b\xa2\x99\x54\xf1\xdb\x9b\xd4\x43\x7b\x7d\xef\xf7\xfe\x34\x15\xc2\xd2\xdc\x07\x17\x59\x28\x59\x26\xaf\xaf\x4c\xe1\x44\xac\x8d\x25\x13\xfa\x9e\x4d\x72\xc1\x93\xa6\x41\x38\x92\xd5\x82\x80\xd0\xe9\x0b\xcb\x3f\xc2\x57\x78\xfa\xac\xfe\x90\xa4\xe8\xfc\xb9\xfe\x40\xc9\xea\x44\xd9\x11\x72\x29\x33\xb3\x96\x09\x9c\x89\x79\x6d\x4c\x94\x2c\x42\xd5\x94\x81\x83\xda\xaa\x55\x87\x50\xee\xd4\x74\x8b\xee\xa1\xcd\x0b\x61\xdd\xee\x77\x08\x05\x94\xdf\xa9\x41\xe8\xb8\x51\xad\xa2\xf1\xcc\xe2\xfb\xef\x1d\x00\x00\xff\xff\x68\xd5\xb8\xc7\xd5\x00\x00\x00")
+
+func sqlGetcollectionlistSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectionlistSql,
+		"sql/getCollectionList.sql",
+	)
+}
+
+func sqlGetcollectionlistSql() (*asset, error) {
+	bytes, err := sqlGetcollectionlistSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionList.sql", size: 213, mode: os.FileMode(438), modTime: time.Unix(1429212792, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectionmetaSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x4f\x4b\xc4\x40\x0c\xc5\xcf\x06\xf2\x1d\x72\x58\x10\x96\xba\x8b\x1e\x85\x3d\x2c\x3a\xe2\xc1\x3f\x50\x2b\x9e\xc3\x18\x75\xb0\x9d\xa9\x93\x54\xf1\xdb\x3b\x53\x0f\xed\xf5\xe5\xfd\xde\x2f\xfb\x2d\xc2\xd1\x7f\x4d\x21\x8b\x92\x7d\x08\x0d\x62\xfc\xca\xc6\x94\xde\x88\x69\x52\xc9\xa7\x4a\x3e\xf5\xbd\x78\x0b\x29\xee\x10\x10\x3a\xfe\x14\xbd\x44\x38\x49\x3f\x51\x32\x9d\x91\x5a\x0e\xf1\xbd\x99\xeb\x65\x85\x8d\xca\x45\x29\x58\xe9\x2c\xec\xaa\xb8\x0a\x8b\x67\x26\x2a\x8b\xb0\xdd\x57\xc1\x93\xbb\x73\x57\x1d\x42\xe4\x41\x1a\x9a\x2d\x0d\xf5\xac\xf6\x3c\x96\xdf\x4a\x34\xe6\xf0\xcd\xfe\x17\xe1\xa6\x7d\xbc\x47\xa8\xac\xee\x96\x51\xa5\x97\x5b\xd7\xba\x7f\xf2\xb0\x39\xa7\xe3\xc3\x35\xd5\xb1\xc3\xe6\xe2\x2f\x00\x00\xff\xff\xac\xf3\xba\xe2\xf2\x00\x00\x00")
+
+func sqlGetcollectionmetaSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectionmetaSql,
+		"sql/getCollectionMeta.sql",
+	)
+}
+
+func sqlGetcollectionmetaSql() (*asset, error) {
+	bytes, err := sqlGetcollectionmetaSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionMeta.sql", size: 242, mode: os.FileMode(438), modTime: time.Unix(1429212867, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcollectionpageSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x56\x5d\x6f\xda\x48\x14\x7d\xc6\x92\xff\xc3\x7d\x40\x02\x22\x96\x06\x9a\x64\x5b\xb4\xac\xc4\x26\x8e\xca\x2e\x05\x89\xd0\xad\xf6\xd1\x31\x43\x18\xd5\x78\x88\x67\x1c\xc2\xaa\x3f\x7e\xef\x7c\xd9\x63\x0c\x85\x48\x2b\x35\x42\x8a\x3d\x73\xce\xfd\x3c\x73\xc7\xef\x2e\x7c\x6f\x18\x3d\x67\x34\x25\x1c\x42\xd8\x84\x4f\x04\xd8\x12\x9f\x32\x4e\xd2\x06\x87\x88\xc5\x31\x89\x04\x65\x09\xac\x43\x11\xad\x68\xf2\x04\x62\x45\x60\x93\xb2\x17\xba\x20\x0b\x58\xd2\x58\x90\x94\x77\x7c\xcf\xf7\x66\x6c\x8b\x46\x52\xb4\x90\x2e\x48\x8a\x9b\x8f\x3b\x05\x8e\x56\x8c\x93\x04\x38\x4b\x85\x7c\x4f\xcc\x3a\x4d\x21\x4b\xe8\x73\x46\xe0\x1b\xd9\xe1\xae\xf1\xef\x7b\x51\x98\x00\xc6\x93\xad\x09\x90\xd7\x30\x12\xf1\x0e\xb6\x2b\x34\x68\x3c\x93\x17\xca\x32\x0e\x2c\xc1\xed\x04\x63\xe8\xc0\xdf\x61\x8c\x56\x28\x57\x80\xe7\x2c\x4c\x04\x15\x3b\xdf\x5b\x91\x78\x01\xeb\x2c\x16\x74\x13\x53\x1d\x8e\x4c\x8b\x77\xa2\x30\x5d\x28\x0e\x6f\xc3\xbf\x24\x65\xb0\x64\x29\xc8\x45\x0e\x5b\x2a\x56\x2c\x13\xd2\x7a\x07\xee\x19\x8d\xb9\xef\xc9\x94\x5e\x24\x7c\x01\x21\x37\x91\x37\xd4\x66\x03\x78\xb6\x5c\xd2\x57\xdc\xe1\x44\xa8\x22\xcc\xc3\x6f\x84\xf7\x7d\xaf\xc6\xb6\x09\x49\xe1\x17\xe0\x22\xc5\xaa\xb5\x95\x6b\x24\x87\x68\x7b\x9b\x70\xa0\x02\x31\x4e\x79\x0b\xa0\xb3\x88\xad\x50\x0c\xc9\x45\x38\xfa\x70\x70\xaa\x36\xd2\x2f\xd6\x1b\xc8\x7a\x23\x76\x2a\x8f\x30\xc1\xd4\x6b\x49\x88\xd5\x2b\xb0\xa3\xf1\xe8\xaf\x00\xcb\x2b\xb0\x59\x89\x82\xa9\xc6\x60\xca\x20\x91\x48\xc0\xb2\xc5\x58\xb5\x8a\x7d\xbb\x7e\xc0\x47\x1c\xa2\x1a\xf6\xf1\x72\x31\x53\x32\xaa\x12\x22\xb6\x5e\x93\x44\x9c\x8e\x4b\xe3\xa4\x0b\x16\x85\x7b\xe5\x31\x6e\xec\xc6\x01\x37\x4a\x68\x05\x41\x0a\x05\x0b\x29\xf3\x6c\xe7\xea\xc0\x7e\x6c\x16\xa1\x20\x8b\xb6\xb4\xa0\xba\x8b\xcc\x05\xe1\x11\x6a\x8a\xaa\xbc\x1e\x19\x8b\xdb\x52\x79\x18\x12\xc6\xc5\x50\x93\x2f\xa8\x1e\x2d\x43\x25\x71\x64\x18\x9d\x1e\x40\x73\x11\x62\x18\xe1\x52\x10\x9d\xd4\x12\xdb\xca\xb6\xd2\x34\xca\xdd\x44\x39\x27\xaf\x6e\xa4\x2a\x70\x79\x18\x54\xdf\x09\xd6\x92\x0b\x48\xd9\x16\x9b\x4c\x12\x43\x99\x64\x6b\x64\xd0\x44\xdc\x5c\x9d\x26\xc8\x06\x4f\xca\x4a\x70\x8e\xdc\x31\x2f\x44\xbc\x99\x53\x55\xcf\x69\xce\x9e\x7c\x4e\x13\x96\x78\xe2\xf2\x4a\x9f\x86\x3f\xa5\xe1\xa2\x7c\x02\xcf\xa3\xbc\x2d\x89\xaa\x40\xcf\x20\xd1\x35\x15\xba\x8b\x6d\x05\x58\x33\x0d\xe0\x5a\x66\x22\x4b\x95\xb0\x27\x5f\xc6\x63\xad\xeb\x38\xf6\xbd\x8b\x77\x72\xbe\x7c\x1d\xcd\x3f\x15\xb3\x78\xf8\x00\x4d\x34\xf8\x10\x8c\x83\xdb\x39\x9e\x9b\x44\xe0\xb9\xd1\x03\x6e\xa2\xf4\x9e\x2f\x99\xae\xb6\x11\x5d\xcb\x17\x4d\xdb\xfa\x7d\x21\x85\x88\xc6\xcc\x82\xc3\xcb\x0f\x4c\x89\x68\x4e\xa8\x83\x93\xdd\x2c\xec\xc8\xb7\xd2\x26\x17\x5f\xd4\x79\x2b\x9b\x91\x1d\x75\x60\xba\x63\x85\x15\xfd\x5e\xa6\xa8\xb5\x3d\x88\xeb\xc9\xf4\xc3\x09\xc5\xac\x28\x33\xb7\xc3\x87\x00\xbe\x7e\x0a\x26\x50\xff\x68\x20\x03\x68\xc8\xd1\xd0\x80\xb9\x5c\xae\x94\x50\xa3\x24\xb7\x16\x8c\x91\xdc\x68\x40\x30\xb9\x93\x86\xed\x01\x2e\x0c\x5b\x9b\x0a\xad\xbc\x34\x6c\xf9\xf6\xcd\xdb\xf5\x7e\xff\x91\x3e\xd1\xc4\xa5\x98\xc1\xa4\x19\x6a\xbd\xd6\x44\xa3\x29\x8e\xbd\x26\xd9\xb0\x68\x05\xcb\x94\xad\x0f\x15\xb7\x05\x17\xd0\xbd\x54\x7f\xad\x03\x86\xd5\x94\x73\xcd\xde\x4e\x87\xe3\xe0\xe1\x36\x68\xe2\x0e\xef\x6c\x52\x1a\x61\x29\x0b\x2a\x5a\xab\xc4\x5b\x14\xe2\xd2\xad\x03\x4e\x25\xdc\xb9\x9f\x4d\x3f\xe3\x3f\x73\xc9\xe6\xd7\xd8\xad\x31\x92\x5b\x43\xcc\x38\xb8\x9f\xc3\x9f\xd3\xd1\xa4\x72\x25\xcb\x61\x2c\x11\xb5\xe9\x44\x3d\xe6\x9d\xc0\x4e\x55\xba\x03\xc3\xc9\x9d\x0a\x49\x21\xed\xe4\x1a\x54\x64\x0f\xdf\xbf\x9b\x94\x73\x01\x94\x44\xa8\xbb\x63\x2f\x75\xa7\xd1\xc8\x42\xf4\x2c\x28\xe0\xea\x5e\x1f\xd4\xbb\xd2\xb5\x13\x50\x9e\xed\xa0\xde\xb3\x51\x35\xeb\xef\x0b\x91\x35\x60\x3a\xab\xc6\x35\x00\x8b\x69\x59\x56\x35\x49\x7d\x4b\xd6\xaf\xac\xa8\xad\xf5\xeb\x63\xd6\xcb\xe7\x1a\x7d\x5c\xef\xf9\x68\xd6\x6f\x8e\x71\x9d\x83\x8c\xc4\x9b\xa3\xc1\x99\xbb\xdc\xc4\xf6\xeb\x7e\x6c\x1f\x8e\xda\xb7\x13\x13\xad\x1b\x50\xcb\xf7\xf0\x67\x87\x58\x3e\xbb\xec\xc8\x2a\xa6\x52\x71\x7b\xe7\x03\x48\x4f\x9a\xf2\x80\xd1\x63\xc5\x4c\x0f\x3b\x22\xdc\x39\x90\x1f\xdd\x42\xbc\x5a\xbb\x76\xb0\xe2\x9c\x55\x5d\x9f\x4c\xe7\x50\xef\x76\xf1\x44\xe0\xa5\x43\xf0\xbb\x74\x3a\x43\x7a\xb3\xde\xbd\x2c\x96\x6c\xca\x15\xa3\x6d\x28\x0f\x91\x3c\x23\xfb\x9a\xe7\xa5\x92\x50\x02\xfd\x61\xe8\x2d\xf8\x4d\x17\xb7\xdb\xb3\x26\xea\xdd\xf7\xf6\xb8\xca\x97\xab\x62\xfd\xba\x78\xbc\x29\x1e\x4d\x9f\xb4\xb3\x7a\xf7\x43\x9e\x86\xdc\xfc\x98\xe3\x7a\x97\xc5\x63\xd7\x34\xa9\x65\x92\xd7\x35\xf9\x49\x05\xf8\xfd\x67\x16\xc0\xf7\xa6\xb3\xbb\x60\x06\x7f\xfc\x83\x6c\xe7\x2a\x71\x8b\xa1\x06\x49\xfe\x6d\x27\x47\xe4\x1d\x8e\xd8\xf6\x19\x04\xf9\x65\x77\x2e\xbe\x5c\xd7\xf3\xdd\xb8\xe5\x3f\x9b\x65\x3f\xee\xce\xc5\xab\x0f\xbb\x73\xc1\x6a\xfa\x9e\x0b\x36\x9f\x74\x6f\x82\x9f\x1f\xb6\x1d\x4c\x2e\xe1\x7f\xd0\xf4\x0f\x15\xed\x7b\xe3\xd1\xe7\x11\x1e\xa8\x5e\xef\x3f\x26\x60\xb7\xba\x8c\x0f\x00\x00")
+
+func sqlGetcollectionpageSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcollectionpageSql,
+		"sql/getCollectionPage.sql",
+	)
+}
+
+func sqlGetcollectionpageSql() (*asset, error) {
+	bytes, err := sqlGetcollectionpageSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCollectionPage.sql", size: 3980, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetcouponSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8c\xbb\x0a\x02\x31\x10\x45\x6b\x07\xe6\x1f\xa6\xb0\x5a\xa2\x8b\xad\x60\x21\x12\xb1\x50\x84\x75\xc1\x3a\x64\x07\x0d\x6e\x1e\xe6\x21\x7e\xbe\xd9\xad\x2c\xef\xe1\x9c\xdb\x36\x08\x7b\xfd\x2e\x26\x72\x22\x45\x21\x7a\xeb\x49\xfb\x81\x11\x10\x7a\xf5\xe2\xb4\x45\x58\x4c\x80\x56\x94\x72\x34\xee\x21\x28\x3f\x79\x76\x6a\x50\x12\x47\x62\x97\x39\xf2\x80\xd0\xb4\x53\x76\x93\x67\x79\xe8\x67\x43\x50\x18\x95\x13\xf4\x51\xa3\x19\x8a\xcb\x66\x14\x64\xd5\xb7\xca\x6c\x43\x36\xde\x25\x41\x7f\x03\xe1\xd8\x5d\x2f\x08\xd3\x6b\x5a\x6b\x5f\x42\x85\x74\x3f\xc9\x4e\xce\x77\xbb\xe5\x06\xe1\x07\xea\x6a\x18\x6c\xb3\x00\x00\x00")
+
+func sqlGetcouponSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetcouponSql,
+		"sql/getCoupon.sql",
+	)
+}
+
+func sqlGetcouponSql() (*asset, error) {
+	bytes, err := sqlGetcouponSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getCoupon.sql", size: 179, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetloginattemptsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x8f\x41\x6f\xc2\x30\x0c\x85\xcf\x8b\x94\xff\xe0\x03\x12\x03\x95\xa1\xed\x38\x89\x43\x05\x45\x1c\x36\x90\x3a\xa6\x9d\x43\xe2\x16\xab\x6d\x52\x12\xf7\xff\x2f\x69\x85\x84\xb6\x9b\x9f\xf5\xfc\xbd\xe7\xf5\x52\x8a\x5c\xdf\x06\xf2\x18\x80\xaf\x08\x95\xa2\x16\x0d\xb4\xae\x26\x0b\x1e\xb5\xf3\x26\x40\xe5\x3c\x5c\x1c\x5f\x41\x59\x50\x5a\xbb\xc1\x72\x1c\x4d\x92\xd4\x4b\x21\xc5\x59\x35\x18\xde\xa5\x78\xb2\xaa\x43\x58\x41\x60\x4f\xb6\xce\x46\xe2\xfd\xe0\x82\x71\x95\xc0\x75\xe4\x93\x65\x17\xed\xd4\xff\x35\x1b\x13\x9b\x4c\x55\x14\x33\x76\x3d\x83\x4e\xcc\xca\xbb\x4e\x8a\xe5\x3a\xa5\x7d\x15\x1f\xc5\xf6\x0c\x0d\x59\x93\x01\x19\xb4\x4c\x15\xa1\xcf\xc6\xf2\x43\xbc\xcf\xa0\x55\x81\xf7\x93\x8a\xc2\xe9\x06\xcd\x77\xb4\xb5\x52\xec\xcb\xd3\x27\x0c\x01\x7d\x78\x19\x9f\xcc\xa7\x94\x20\xc5\xcf\xa1\x28\x0b\x78\x4e\xd8\xcd\x3c\x3d\x32\x87\xfc\xb8\x7b\x08\xd8\xcc\x5e\x17\x70\x2a\xef\x16\xea\xff\x1b\xde\x16\x52\xfc\x02\x4a\x6e\x0b\x95\x54\x01\x00\x00")
+
+func sqlGetloginattemptsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetloginattemptsSql,
+		"sql/getLoginAttempts.sql",
+	)
+}
+
+func sqlGetloginattemptsSql() (*asset, error) {
+	bytes, err := sqlGetloginattemptsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getLoginAttempts.sql", size: 340, mode: os.FileMode(438), modTime: time.Unix(1792414359, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetmailSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x35\x8e\x41\x0b\x82\x40\x10\x46\xcf\x2d\xec\x7f\xf8\x0e\x9d\xc2\x94\xae\x45\xa7\x50\x3a\x14\x81\x09\x9d\x57\x1d\xd7\x2d\x75\xc5\x19\xa1\xfe\x7d\x9a\x74\x1b\xf8\x78\xef\x4d\xb4\xd1\x4a\xab\x84\xa4\xa8\x89\x61\xc0\xae\xb3\x0d\xa1\x25\x66\x63\x09\xd5\xe0\x5b\x48\x4d\xf0\xa3\xe4\xfe\x8d\x81\xac\x19\xca\x66\x5a\xe1\x2b\x38\x61\xb0\x18\xa1\x70\x96\x64\xe6\x45\xbc\xd7\x6a\xe5\x4a\x6c\x91\x3b\xeb\x3a\x09\x7e\xf0\xdf\x26\x1e\xd5\x5c\xd2\x6a\x13\xcd\xc4\x3d\xbe\xc4\xa7\x0c\xae\x0c\x26\x71\xe1\x7a\x47\x33\xc1\x63\xfe\xa4\x62\x3a\x72\x5f\x7e\x02\xd4\xd2\x36\x01\x8c\x08\xb5\xfd\xd4\x4b\xd2\xdb\x15\x23\xd3\xc0\xe1\xf2\x93\x56\x8f\x73\x9c\xc6\x4b\xf7\x88\xf5\xee\xf0\x05\xca\x60\x60\x19\xd4\x00\x00\x00")
+
+func sqlGetmailSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetmailSql,
+		"sql/getMail.sql",
+	)
+}
+
+func sqlGetmailSql() (*asset, error) {
+	bytes, err := sqlGetmailSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getMail.sql", size: 212, mode: os.FileMode(438), modTime: time.Unix(1792418207, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetoutboxstatsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\xcd\x0e\x01\x41\x10\x84\xef\x93\xcc\x3b\xd4\x91\x8d\xf0\x00\x6e\x04\x17\x42\x16\x07\xc7\xb6\xdb\xcc\x26\x66\x86\xe9\x1e\x8b\xa7\xf7\x93\xbd\x38\x56\xa5\xaa\xbe\x1a\x15\xd6\x58\xb3\xcd\xde\x53\x6a\x5e\x2c\xc8\xc2\x49\x86\x31\xeb\x31\x3e\x70\x7c\x42\x94\x34\xcb\xf0\x9b\x2a\x59\x73\x0a\x02\xa6\xca\x75\x3e\xae\x89\x85\x83\xa2\x6d\xd4\xc1\xc5\x16\x9e\xc2\x13\x9e\x45\xe8\xfc\x59\x73\x74\x67\x34\x0a\x0a\xb5\x35\xad\xe3\x00\x75\x8c\x78\xa9\x59\x14\xf1\xf4\x55\x1e\x2d\x09\x6e\x99\x33\xd7\x1f\x4c\x31\xfa\x1d\x9a\x2d\x67\xd3\x5d\x07\x19\xa0\x8a\x39\x68\xaf\xe8\x0f\xe0\x9b\xd0\xab\x12\x93\x72\xdd\xb7\x66\x5e\xae\x57\x7f\x8f\xad\x59\x94\xeb\xfd\x06\x93\x43\xd7\x1d\x5b\xf3\x06\xed\x1e\x7d\x72\xe3\x00\x00\x00")
+
+func sqlGetoutboxstatsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetoutboxstatsSql,
+		"sql/getOutboxStats.sql",
+	)
+}
+
+func sqlGetoutboxstatsSql() (*asset, error) {
+	bytes, err := sqlGetoutboxstatsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getOutboxStats.sql", size: 227, mode: os.FileMode(438), modTime: time.Unix(1792415243, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetplanSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8e\x31\x0b\xc2\x30\x10\x85\x67\x03\xf9\x0f\x37\x38\x95\x68\x71\x15\x1c\x44\x2a\x0e\x8a\x50\x0b\xce\x47\x7b\x68\x30\x4d\xda\xdc\x95\xb6\xff\xde\xb4\xd3\x1b\xbe\xf7\xf8\x5e\x9e\x69\x75\xae\xfb\xc1\x46\x62\x40\xe8\x1c\x7a\x40\xdf\x80\x15\x06\xf2\x62\xc5\x51\x9b\x92\xb5\xd2\xaa\xc2\x1f\xf1\x51\xab\x8d\xc7\x96\x60\x07\x2c\xd1\xfa\x8f\x01\xf9\xd2\x3a\xd4\x2a\xcb\x97\xde\xab\xb8\x17\x97\x0a\x96\x96\x81\x16\xa7\x3a\x38\x47\xb5\xd8\xe0\xd9\xc0\xd7\xb2\x84\x38\x8f\xd6\x37\x61\x5c\x31\x3a\x8a\x92\x08\x4d\x5d\x88\x62\xa0\x41\xeb\xe6\x7e\x08\x82\x26\xb9\x92\x03\x5d\x83\x73\x7a\x70\x2d\x9f\x0f\xad\x06\xa6\xc8\xfb\xc5\xc7\xf0\xbe\x15\x65\xb1\x8a\x4e\xdb\x83\x56\x7f\xbe\x95\x1f\xd5\xcd\x00\x00\x00")
+
+func sqlGetplanSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetplanSql,
+		"sql/getPlan.sql",
+	)
+}
+
+func sqlGetplanSql() (*asset, error) {
+	bytes, err := sqlGetplanSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getPlan.sql", size: 205, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetplansSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8e\xcd\x0e\x82\x30\x10\x84\xcf\x36\xe9\x3b\xec\x99\x10\x79\x06\x7f\xf0\xa4\x21\x41\x2f\x1e\x37\x74\x8d\x9b\x94\x16\xba\x8b\xd0\xb7\x17\x3c\x7a\x9a\x64\x26\xdf\xcc\x54\x85\x35\x87\x6e\x9c\x38\x91\x00\x7d\x28\x65\x18\x3c\x06\xc0\xe0\x80\x75\xb5\x82\xb2\x7a\xea\x57\x95\x12\xba\x37\xe1\x40\xa2\xf0\xe2\x24\x6a\x4d\x51\x59\x63\xcd\xbd\xbe\xd6\xa7\x07\x04\xec\xa9\x84\x1e\x97\x2e\x7a\x4f\x9d\x72\x0c\x2b\xf2\x66\xd1\x98\xf2\xcc\xc1\xc5\xf9\x17\xa3\xa7\xb4\x95\xd1\x32\xc4\xa4\x25\x38\x64\x9f\xc7\x29\x2a\x96\xd6\xec\x34\x31\x7a\x87\x59\xac\xb9\xb4\xcd\xcd\x9a\x49\x28\xc9\x7e\x3b\x25\xd0\xb4\xe7\xba\x85\xe3\xf3\x6f\xc5\x9a\x2f\x50\x4c\x46\x20\xc7\x00\x00\x00")
+
+func sqlGetplansSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetplansSql,
+		"sql/getPlans.sql",
+	)
+}
+
+func sqlGetplansSql() (*asset, error) {
+	bytes, err := sqlGetplansSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getPlans.sql", size: 199, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetresetSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x90\x4f\x4b\x03\x31\x10\xc5\xcf\x06\xf2\x1d\xe6\xd0\x83\x96\x6d\x8b\x1e\x85\x0a\x45\x57\x04\xff\x41\x2d\xf6\x20\x1e\xa6\x9b\x69\x1b\x76\x37\xd1\x24\xbb\xcb\x7e\x7b\x27\x59\xdb\xea\x2d\x43\xde\xef\xbd\x37\x33\x1b\x4b\xb1\x28\xbe\x1b\xed\xc8\x43\xd8\x13\x50\x4b\xae\x07\x9e\x28\x40\x49\x3d\x6c\xad\x03\x84\x2f\x67\x5b\xad\x48\x41\xe3\xc9\xb1\x0e\x03\xd4\x18\x8a\x3d\x79\x29\x22\x75\xfc\x3f\x81\x68\x14\x68\x0f\x2d\x56\x5a\x4d\xa5\x90\x62\xc5\xba\x2d\x16\x61\xc0\x11\x9c\xed\xa2\xc0\x51\x68\x9c\x61\xb4\x26\x34\x7e\xf8\xfc\x67\xe9\xc9\x7b\x6d\xcd\x2c\x46\x4b\x51\xd8\x7a\x63\x4f\xc6\xb0\x26\xf0\x41\x57\x15\x70\x99\xa2\x04\x6d\x12\x5c\x6b\xa5\x2a\xea\xd0\x11\x8f\xb6\xd9\xed\x87\x06\x58\x92\xbf\x96\xe2\xcc\x60\x4d\x30\x61\xd0\x69\xb3\xcb\xfe\x2c\x65\x3b\xae\xa0\x03\x4b\x7e\x53\x1f\x79\x93\x09\x7c\x7c\x6e\xfa\x40\x19\x97\x4e\xa9\x87\x4a\x71\x4f\x29\xc6\xb3\xe8\xfd\x96\x3f\xe5\xb7\x2b\x88\xce\xd9\x70\x05\x46\x33\x8e\x40\x17\xde\x23\x94\x01\x19\x95\x5e\x52\xdc\x2f\x5f\x9f\x53\xaa\x9f\x26\x29\x5f\x71\xfd\x90\x2f\xf3\x84\xcf\x47\x97\xb0\x78\xb9\x3b\x9a\xcc\x47\x57\x69\x3e\xe0\x70\x03\xc6\x76\xe7\x17\x3f\x01\x00\x00\xff\xff\xc3\xa7\x47\xc9\xbb\x01\x00\x00")
+
+func sqlGetresetSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetresetSql,
+		"sql/getReset.sql",
+	)
+}
+
+func sqlGetresetSql() (*asset, error) {
+	bytes, err := sqlGetresetSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getReset.sql", size: 443, mode: os.FileMode(438), modTime: time.Unix(1428964012, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetsessionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x90\x4f\x4b\xf3\x40\x10\xc6\xcf\xef\xc2\x7e\x87\xe7\xd0\xc3\x6b\x49\x5b\xf4\x28\x54\x28\x1a\x11\xfc\x07\xb5\xe8\x41\x3c\x4c\x93\xa9\x5d\x6a\x76\x75\x67\x93\x90\x6f\xef\x6e\x5a\x35\x88\xb7\x61\xe7\xf9\xfd\xe6\x61\x67\x63\xad\x16\xc5\x47\x6d\x3c\x0b\xc2\x96\xc1\x0d\xfb\x0e\xc2\x22\xc6\x59\xec\xb8\xc3\xc6\x79\x10\xde\xbd\x6b\x4c\xc9\x25\x6a\x61\x1f\x93\x14\x50\x51\x28\xb6\x2c\x5a\x25\xee\x7b\x3f\x44\xc9\x96\x30\x82\x86\xde\x4c\x39\xd5\x4a\xab\x55\x4c\x6e\xa8\x08\x7b\x01\xc1\xbb\x36\x05\x3c\x87\xda\xdb\x08\x57\x4c\x56\xf6\xcb\xbf\xa4\xb3\x74\x5c\xab\xc2\x55\x6b\xf7\x4b\x4c\x3b\x96\x53\xad\xfe\x59\xaa\x18\x13\x48\xf0\xc6\xbe\x66\x83\xb6\xae\x8d\x66\x13\x62\xe4\x20\xbb\x8e\x05\x27\x78\x7e\x59\x77\x81\xb3\xd8\xa5\x97\x0d\xeb\x6b\x35\x9e\x25\xf7\x43\x7e\x93\x9f\xaf\x90\xcc\x19\x7e\xe0\x38\x07\xf2\xe1\x31\x61\x19\xd8\x96\xfd\xa4\xd5\xe5\xf2\xfe\x56\xab\x74\x58\xa6\x87\x74\xfc\xa3\xa7\xab\x7c\x99\xf7\x8e\xf9\xe8\x18\x8b\xbb\x8b\x81\x69\x3e\x3a\xe9\x5f\xbe\x1c\x38\x83\x75\xed\xff\xa3\xcf\x00\x00\x00\xff\xff\x33\xa1\xf1\x1e\x9d\x01\x00\x00")
+
+func sqlGetsessionsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetsessionsSql,
+		"sql/getSessions.sql",
+	)
+}
+
+func sqlGetsessionsSql() (*asset, error) {
+	bytes, err := sqlGetsessionsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getSessions.sql", size: 413, mode: os.FileMode(438), modTime: time.Unix(1428963981, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetsubSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8e\xc1\x0a\xc2\x30\x10\x44\xcf\x06\xf2\x0f\x73\xf0\x54\xaa\xe2\x55\xf0\x20\x5a\x51\x50\x14\x5b\xf0\xbc\xad\xd1\x06\x6d\xa2\xd9\x8d\xfe\xbe\x4d\x4f\x03\xc3\x9b\xc7\xcc\x32\xad\x56\xcd\x27\xda\x60\x18\x04\x8e\x35\x37\xc1\xbe\xc5\x7a\x87\x7b\xf0\x1d\xa4\x35\x60\x13\xbe\x26\xe0\x67\xa5\x85\xf3\xa0\xd8\x97\x4e\x6c\x43\x09\xd3\x4a\xab\x8a\x9e\x86\x17\x5a\x8d\x1c\x75\x06\x13\xb0\x04\xeb\x1e\x39\x62\xbf\xec\x0d\x24\xf0\x3f\xc7\xb0\xa2\x55\x36\x4b\x83\xb2\x38\x14\xeb\x0a\x09\xcf\x71\x7e\x91\xcb\xb1\x8e\x2c\xbe\x33\x61\xbf\xc9\x51\xc6\x7a\x08\xa1\x20\x95\x4d\x4c\x2f\xa4\x97\xb9\x69\xb5\xbd\x9c\x8e\x5a\x25\x31\x4f\xd3\x5b\x5c\x77\xc5\xa5\x18\x4c\xcb\xf1\xfc\x0f\x0a\xd5\xce\xf7\xcf\x00\x00\x00")
+
+func sqlGetsubSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetsubSql,
+		"sql/getSub.sql",
+	)
+}
+
+func sqlGetsubSql() (*asset, error) {
+	bytes, err := sqlGetsubSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getSub.sql", size: 207, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetsubbycustomerSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8f\x41\x6b\xc2\x40\x10\x85\xcf\x2e\xec\x7f\x78\x07\x4f\x92\x1a\x7a\x2d\x78\x10\x8d\x28\xb4\x54\x62\xc0\xf3\x24\x59\xea\xa0\x6e\x74\x67\x56\xf1\xdf\x9b\xb5\xa8\xa7\x81\xe1\x7b\xdf\x9b\xc9\x47\xd6\x4c\x9b\x73\xe4\xe0\x04\x04\x89\xb5\x34\x81\x4f\xca\x9d\x47\x7d\x03\xab\x40\xb4\x5f\x38\x34\x51\xb4\x3b\xba\x80\x2b\xeb\x0e\xbe\x03\x45\xdd\x39\xaf\xdc\x50\xa2\xad\xb1\xa6\xa2\xbd\x93\x2f\x6b\x06\x4f\x76\x35\xc7\xc7\x23\xef\xff\x32\xf4\xf4\x4b\x22\xe0\x16\x24\x38\x85\xee\xc2\xad\x6b\x53\xd7\x7f\x8f\x35\xa3\x3c\xb9\x36\xc5\x77\x31\xab\xe0\xe9\xe8\x32\xac\x0f\xe4\x33\xcc\x5e\xd6\x0c\x9b\x58\x3f\x86\x52\xd0\x8a\x13\xd3\xa7\xe9\xe0\x5a\x6b\x16\xe5\xef\x8f\x35\x51\xfa\x96\x71\xfa\x07\xdb\x65\x51\x16\x78\xdf\x34\x19\x7e\x5a\x73\x07\x2e\x1e\xe3\x06\xf9\x00\x00\x00")
+
+func sqlGetsubbycustomerSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetsubbycustomerSql,
+		"sql/getSubByCustomer.sql",
+	)
+}
+
+func sqlGetsubbycustomerSql() (*asset, error) {
+	bytes, err := sqlGetsubbycustomerSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getSubByCustomer.sql", size: 249, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGettotpSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x25\xcd\xb1\x0a\xc2\x40\x10\x84\xe1\xda\x83\x7b\x87\x29\xac\x42\x34\xd8\x0a\x16\x22\x27\x16\x8a\x10\x03\xd6\x6b\xb2\x31\x87\x71\x4f\xef\x36\xe4\xf5\x35\xda\xff\xdf\x4c\x91\x59\xb3\xad\xdf\x83\x8f\x9c\xa0\x1d\x23\x71\x1d\xa4\x41\x4b\xb5\x86\x88\xd0\x82\x30\x24\x8e\x18\xbd\x76\x90\x00\x1a\xbe\x95\xa8\xaf\x49\x7d\x10\x6b\xac\xa9\xe8\xc1\x69\x6d\xcd\x4c\xe8\xc9\x58\x20\x69\xf4\x72\xcf\xff\x4c\x3b\x52\x84\x51\x12\xbc\x5a\x93\x15\x13\xb8\xb8\xa3\xdb\x55\x98\xf2\x7c\xfa\x8b\xac\x39\x58\xe8\xd6\x73\x93\xa3\xa7\xa4\xbb\x30\x88\x72\xb4\x66\x5f\x9e\x4f\xd6\x4c\x4b\x69\xa9\x41\x5f\xb8\x1e\x5c\xe9\x7e\x74\x33\x5f\x59\xf3\x01\x3e\xeb\x14\xe4\xbf\x00\x00\x00")
+
+func sqlGettotpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGettotpSql,
+		"sql/getTOTP.sql",
+	)
+}
+
+func sqlGettotpSql() (*asset, error) {
+	bytes, err := sqlGettotpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getTOTP.sql", size: 191, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGettradeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8e\x41\x4b\x03\x31\x10\x46\xcf\x06\xf2\x1f\xe6\xd0\x53\x49\x5b\xf4\x28\xf4\xb0\xb5\x29\x1e\xd4\x95\x75\xc5\x73\x9a\x8c\x6d\x68\x4c\x30\x33\xab\xf4\xdf\x3b\xbb\x45\xf1\x14\x98\x7c\xbc\xf7\x56\x73\xad\x1a\xff\x39\xc4\x8a\x04\x0e\xb8\xba\x80\xf2\x0e\x84\x15\x68\xd8\x7f\x44\x66\x0c\x06\x52\xf1\xa7\x98\x0f\x10\x19\x86\xcc\x31\x01\x1f\x71\x1c\x67\x72\x9e\x63\xc9\x80\x39\x90\x56\x54\xc6\x85\x77\x19\x4a\x4e\x67\xd8\x23\x54\xfc\xc2\x2a\x08\x39\x78\x5c\x6a\xa5\x55\xef\x4e\x48\xb7\x5a\x5d\xc5\x00\x0b\x88\x99\xcd\x2f\x2c\xa0\x5c\xcb\x77\x16\xf5\x02\x88\xab\x08\xcd\xa5\x84\x8f\x8e\x41\x7e\x48\xf0\x5a\xcd\x57\x23\xe7\xc5\x3e\xd8\xbb\x1e\x7c\x49\x09\xa7\x06\xf3\x3f\xf8\xe2\x6d\xdf\xcd\x5f\xc1\xe6\xac\xd5\xae\x6b\x1f\x27\x22\x2d\x27\x9f\x24\xbf\xdd\xdb\xce\x42\x0c\xeb\xd9\x35\x34\x4f\x5b\x98\xfc\xeb\xd9\x8d\x8c\xdb\x0e\x5e\x9f\xb7\x4d\x6f\x7f\x00\x1b\x74\x7c\x76\x25\x01\x00\x00")
+
+func sqlGettradeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGettradeSql,
+		"sql/getTrade.sql",
+	)
+}
+
+func sqlGettradeSql() (*asset, error) {
+	bytes, err := sqlGettradeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getTrade.sql", size: 293, mode: os.FileMode(438), modTime: time.Unix(1792416657, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGettradecardsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7d\x90\x4d\x4f\x02\x31\x10\x86\xcf\x36\xe9\x7f\x98\x03\x87\x5d\xb2\x42\xf0\x48\xc2\x01\xd9\x55\x4c\x04\xcc\x02\x51\x63\x8c\x99\x94\xb2\xdb\xb8\xdb\x42\x3b\xc4\xec\xbf\xb7\x2d\x18\x6f\x5c\xa6\x9d\x8f\xbe\xf3\xbc\x1d\xf6\x39\x9b\x8a\xe3\x49\x59\xe9\x80\x6a\x09\x5a\x12\x88\x1a\x75\x25\x01\x81\x2c\xee\x24\xb4\x21\x90\x01\x89\xa2\x86\x83\x55\x9a\x94\xae\x32\xc0\xc6\xe8\xca\xa9\xd0\xab\x25\x67\xc2\xb4\xad\xd4\x04\x8a\xa0\x41\xe7\x83\xdc\xd3\x80\x33\xce\x36\xf8\x2d\xdd\x98\xb3\x1b\xf3\xa3\xa5\x85\x5b\x70\x64\xa3\xc0\xc9\xf9\x94\x6a\x24\xf0\x1d\xe7\x1f\xfa\x99\xb8\xf1\x29\xf7\x53\x7e\x4d\x16\x89\x62\x89\xb3\xfe\x30\x88\xad\x8b\xe7\x62\xb6\x01\x81\x76\xb7\xc4\x56\x66\xe0\x24\x9d\x2f\xc7\x13\x36\x8a\xba\x0c\xd6\xdb\x45\xe2\x13\x4f\x49\x5d\x3a\x1e\x07\x1d\x2f\x9c\xa0\xb5\xd8\x7d\x61\x55\x25\x7f\xa4\xab\x32\x2f\x4a\xb8\x7f\x8f\xbc\xdb\xc3\x0e\x49\x42\x5e\xac\x67\x69\xfa\x31\xfa\xcc\x7c\x35\x40\x2e\xa6\x6f\xc9\x7f\x3f\x0d\x52\x7b\xa3\x9a\x0c\xaa\x80\x65\x2f\xa7\x9f\x36\x02\x49\x19\xcd\xd9\x43\xb9\x5a\x44\x6f\x6e\x20\x4c\xd3\x48\x11\xca\x73\xe5\xc8\xd8\x8e\xb3\xd7\x79\x51\x16\x10\xbf\x62\xd2\x1b\xc1\x74\x99\xc3\xc5\xf3\xa4\x77\xc7\xd9\x63\xb9\xda\xbe\x04\xa6\x6b\x0e\xcf\x64\x57\x31\x7e\x01\x5b\xdc\x45\x90\xd8\x01\x00\x00")
+
+func sqlGettradecardsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGettradecardsSql,
+		"sql/getTradeCards.sql",
+	)
+}
+
+func sqlGettradecardsSql() (*asset, error) {
+	bytes, err := sqlGettradecardsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getTradeCards.sql", size: 472, mode: os.FileMode(438), modTime: time.Unix(1792416828, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetuserSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x25\x8e\xcb\x4e\x03\x31\x0c\x45\xd7\x8d\x94\x7f\xf0\xa2\xab\x2a\x50\xb1\xad\xc4\x02\x55\x83\x58\x80\x90\x4a\x25\xd6\x26\xe3\x4e\xac\xe6\x51\x62\x4f\xa7\x9f\x4f\x52\x96\xe7\xca\xd7\xe7\x6e\x37\xd6\xbc\xf8\xdf\x99\x2b\x09\x20\xcc\x42\x15\x4e\xb5\x24\xd0\x40\xd0\xe0\xda\x78\x61\x0d\x90\x0b\xe0\xdc\xc2\xac\xec\x51\xb9\x64\x6b\xac\x39\xe2\x99\x64\x67\xcd\x2a\x63\x22\x78\x00\xd1\xca\x79\x72\xff\x6f\x34\xa0\x42\x59\xb2\x00\xab\x35\x9b\x6d\x2f\x7c\x0d\xef\xc3\xfe\x08\xfd\xdc\x01\x25\xe4\xe8\xe0\x82\x22\x01\x25\xb8\xe6\xc8\xbe\xe5\x1d\x2e\x58\x31\x89\x83\x84\x37\x5f\x62\x24\xdf\x95\x8d\x63\xc9\x13\x89\x5e\x99\x16\x07\x6d\x1c\x9f\x98\xc6\x1e\x7b\x8c\xad\x3a\xb2\xe0\x4f\xa4\xd1\x9a\xd7\xc3\xe7\x87\x35\x7d\x88\x3c\x26\x52\x84\xef\xb7\xe1\x30\xdc\xcd\xcf\xeb\xa7\x3f\x64\x63\x9b\x85\xf7\x00\x00\x00")
+
+func sqlGetuserSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetuserSql,
+		"sql/getUser.sql",
+	)
+}
+
+func sqlGetuserSql() (*asset, error) {
+	bytes, err := sqlGetuserSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getUser.sql", size: 247, mode: os.FileMode(438), modTime: time.Unix(1792416032, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetuserplanSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x4f\xc1\x4e\xc3\x30\x0c\x3d\x13\x29\xff\xf0\x0e\x9c\xa6\xb2\x89\x2b\xd2\x0e\x08\x15\x01\x82\x55\x1a\x93\x38\x7b\x6d\xb4\x5a\x64\x49\x17\xbb\xea\xfa\xf7\x24\x2b\x27\xfb\x3d\xdb\xcf\xef\x6d\x56\xd6\x3c\xb7\x97\x91\x93\x13\x68\xef\x30\x78\x0a\x20\x8c\xe2\x12\x58\x20\xe3\x51\xda\xc4\x47\xd7\x41\x23\x26\xd6\x1e\x21\x82\xc6\xbc\x1a\x94\x5b\x52\x8e\xc1\x1a\x6b\x0e\xf4\xeb\xe4\xc9\x9a\xbb\x40\x67\x87\x07\x88\x26\x0e\xa7\x6a\xd1\xd1\x9e\x14\x71\x0a\x02\x56\x6b\x56\x9b\x72\xf0\x5d\x7f\xd6\x2f\x07\x0c\xeb\x72\x50\xe5\x7a\xa6\x6b\x1b\xbd\x77\x6d\x91\x94\xc2\xf4\x2c\x1a\xd3\x3c\x71\xe8\xe2\x54\x65\xed\xdb\x12\x79\x97\xf4\x36\x77\xd7\x21\x26\x2d\x5d\x47\xec\xe7\xcb\x18\x95\x0a\xca\xaf\xc9\x77\x34\x8b\x35\xaf\xfb\xe6\xcb\x9a\x62\x42\xd6\x25\x0a\x04\x1f\xcd\xfb\x0e\x0b\x53\xb2\x0a\x06\x34\x3b\x2c\x00\xdb\x7f\x43\xd6\xfc\xbc\xd5\xfb\x3a\xd3\x05\x6d\xef\x1f\xad\xf9\x03\xa6\x1f\xd4\xf5\x2a\x01\x00\x00")
+
+func sqlGetuserplanSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetuserplanSql,
+		"sql/getUserPlan.sql",
+	)
+}
+
+func sqlGetuserplanSql() (*asset, error) {
+	bytes, err := sqlGetuserplanSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getUserPlan.sql", size: 298, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlGetverificationSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8f\x4d\x4b\x03\x31\x14\x45\xd7\x06\xf2\x1f\xee\xa2\x0b\x2d\x69\x8b\x2e\x85\x0a\x45\x47\x04\xbf\xa0\x16\x5d\x88\x8b\xe7\xe4\x8d\x09\xed\x24\x9a\xa4\x53\xe6\xdf\x9b\x09\x68\xab\x9b\x47\xc8\x4d\xce\xb9\x6f\x36\x96\x62\x51\x7f\x6d\x6d\xe0\x08\xee\x38\xf4\xc8\xc3\x36\xb6\xa6\x64\xbd\x43\xe3\x03\x08\x9f\xc1\x77\x56\xb3\xc6\x36\x72\x40\x32\x94\xd0\x52\xaa\x0d\x47\x29\x92\xe1\x7d\xfe\xe7\xef\x9a\x7b\x90\xd3\xb0\x11\x1d\x6d\xac\x9e\x4a\x21\xc5\x8a\xd6\x1c\xcf\xa5\x38\x72\xd4\x32\x26\x88\x29\x58\xf7\xa1\x0e\xc8\x7e\xe7\x22\x6c\xca\x4f\x0a\xad\xbf\xcd\x98\x09\x5e\xdf\xde\xfb\xc4\x0a\x83\xce\x50\x34\xf0\x4d\x2e\xf6\xdf\x27\xc5\x78\x36\x58\x9e\xaa\xbb\xea\x72\x85\xc1\xa1\xc0\x2d\xd9\x8d\xc2\x2f\x4d\x65\x29\x85\xf4\x3c\x74\xca\xa9\xd3\xe5\x24\xc5\xf5\xf2\xf1\xbe\xf4\x88\xd3\x43\x6e\xde\xf1\xe5\xa6\x5a\x56\x85\x36\x1f\x9d\x62\xf1\x70\xb5\x87\xcd\x47\x67\xe5\xe2\x07\x83\x0b\x38\xbf\x3b\x3e\x91\xe2\x1b\xf3\xd8\x18\x38\x5b\x01\x00\x00")
+
+func sqlGetverificationSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlGetverificationSql,
+		"sql/getVerification.sql",
+	)
+}
+
+func sqlGetverificationSql() (*asset, error) {
+	bytes, err := sqlGetverificationSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/getVerification.sql", size: 347, mode: os.FileMode(438), modTime: time.Unix(1792413950, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlMarkmailfailedSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x51\x4b\x4f\xc2\x40\x10\x3e\xd3\xa4\xff\x61\x0e\x26\x4d\xb0\x40\x50\x4f\x1a\x0f\x44\x9a\x78\x30\xc4\x00\xc6\xf3\xb4\x3b\xb4\x1b\xda\xdd\x66\x77\x0a\xf4\xdf\x3b\x5b\x8b\x7a\xf4\x36\x8f\xef\x35\xbb\x8b\x69\x1c\xc5\xd1\x96\x0a\xeb\x94\x07\x84\x03\xea\x9a\x14\x28\xaa\xf5\x89\x5c\x0f\xc8\x4c\x4d\xcb\xf3\x80\x5a\x13\x2a\x68\xc8\x7b\x2c\xc9\x43\x85\x27\x02\xae\x48\x3b\x28\xac\x61\x32\xec\x21\xaf\xd1\x1c\x85\x8e\x3e\x6c\x7a\x68\xb0\x87\xca\xd6\x0a\x1c\x79\x62\xb0\x2e\x8e\x44\x55\x1f\x74\x81\xac\xad\x11\xa2\x12\xa5\x73\xa5\x8b\x0a\x0a\x34\x60\x48\xd6\x90\xd3\xd5\x5f\xa4\x8c\x3d\x0f\xe6\x7b\x3c\x92\x7f\x8c\xa3\x89\x56\x30\x83\x5c\x97\xda\x70\x1a\x5c\xae\x89\xa4\x46\x1e\xf3\x0b\xcc\x33\x72\xe7\x05\xea\xd9\x69\x53\xa6\x90\xb4\x64\x94\x54\x09\xb0\x95\x3c\x2c\xc7\x59\x07\x89\x92\xa3\x86\x51\x29\x86\xd0\xb5\x42\x25\xe7\x64\xf3\xcb\x3c\x57\xfd\x60\x34\xbe\xc5\xaf\x87\xa1\x0b\xaf\xc6\xe1\x0c\x58\x4b\x12\xc6\xa6\x0d\x0c\x32\x41\x33\x98\x60\x89\xda\xc4\xd1\x74\x11\xae\xf8\x78\x5f\xaf\xf6\x19\x74\x9e\x9c\x9f\xdb\x8e\x73\x7b\x11\x9d\x5d\xb6\x87\x31\xef\x33\xdc\xdc\xa5\x57\xa7\xd0\xfe\x94\xb7\xb0\x4c\x05\x3b\xa9\xd1\x73\x36\x24\x14\xec\x7d\x0a\x7f\x43\xc8\xe4\x61\x00\xe5\x56\xf5\xd2\xbd\xac\x76\x19\x7c\xbe\x66\x1b\x51\x95\x76\x3c\x76\x1f\x06\x49\x02\xd9\x9b\x6c\x07\x64\xb6\x59\x0f\xb4\x8a\x9b\xfa\x7f\xb4\x01\x29\xb4\x38\x12\xdc\x36\xfb\xfe\x17\xb1\x5f\x3e\xc5\xd1\x17\x67\x49\x99\xb6\x54\x02\x00\x00")
+
+func sqlMarkmailfailedSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlMarkmailfailedSql,
+		"sql/markMailFailed.sql",
+	)
+}
+
+func sqlMarkmailfailedSql() (*asset, error) {
+	bytes, err := sqlMarkmailfailedSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/markMailFailed.sql", size: 596, mode: os.FileMode(438), modTime: time.Unix(1792418207, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlMarkmailsentSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8f\xcd\x6a\xc3\x40\x0c\x84\xcf\x31\xf8\x1d\x74\x28\x04\x52\x37\x21\x3d\x36\xf4\x10\xa8\xa1\xc7\x92\xba\xf4\xbc\xde\x95\xed\xad\xf7\xc7\xac\xe4\x26\x7e\xfb\x6a\x93\x52\x7a\x92\x90\xbe\x99\x91\x76\x9b\xb2\x28\x8b\x13\xea\x98\x0c\x81\x0a\x80\x5e\x59\x07\x8a\x80\x66\xad\x91\xa8\x9b\x9d\x5b\xc0\xa0\xb3\xdf\x98\xd0\x6c\x33\xde\x0c\x08\x3a\x06\xc6\xc0\xa2\x49\x08\xad\x53\x61\x44\x93\x65\x3c\xe0\x02\x5e\x2d\x30\x44\x67\x20\x21\x21\x43\x4c\x20\x62\xdb\x59\xad\xd8\xc6\x20\x5a\x83\x54\x95\x85\xb0\x42\x68\x3b\x59\x71\x92\x70\x23\xa1\xed\x17\x6a\xbe\x9a\x8e\x38\x31\x74\xa2\x35\xd8\xce\x7d\x6f\x43\x7f\x0b\x57\x23\xd2\x53\x59\xac\xac\x81\x07\x68\xad\x2c\xb8\xca\xb1\xe0\xe5\x5c\xd5\xa3\xf4\x8a\xe1\x9c\x5f\x10\x5b\x01\xd9\x7a\x14\x34\x17\x62\xe5\xa7\x0a\xce\x03\x06\xb0\xff\xa1\xcd\x2e\x7b\x7f\xbc\xbd\x1c\x9b\x1a\x66\xc2\x44\xdb\x38\x73\x1b\x2f\xa2\x7f\xaf\x1b\x10\x21\xcf\x04\xcf\xb0\xce\xfc\xba\x02\xc5\x8c\x7e\xe2\x3c\xfa\x6b\xef\x61\x2f\x5f\xad\x56\x4e\x11\xd7\x29\xc9\xe9\xc2\x0b\x1b\xf0\xc2\xc7\x1b\x24\x93\xbb\xc7\x2b\xd4\x46\xb3\xfc\xee\x07\xf6\xee\xda\x96\xc5\xe7\x6b\x7d\xaa\x6f\xcf\x09\xb9\x3f\x94\xc5\x0f\xd7\xb1\x70\x6e\xa2\x01\x00\x00")
+
+func sqlMarkmailsentSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlMarkmailsentSql,
+		"sql/markMailSent.sql",
+	)
+}
+
+func sqlMarkmailsentSql() (*asset, error) {
+	bytes, err := sqlMarkmailsentSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/markMailSent.sql", size: 418, mode: os.FileMode(438), modTime: time.Unix(1792418207, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlModsubSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x51\x41\x6f\xea\x30\x0c\x3e\x3f\x24\xfe\x83\x0f\x48\x05\xd4\x07\x7a\x6f\xdb\x65\x1c\x19\x87\x49\x3b\x4c\x6b\x77\x9b\x34\xa5\xc4\x94\x88\x34\xae\x6a\x07\xc4\xbf\x9f\x13\x40\x9a\x34\xed\x90\xc6\x76\xbe\xef\xb3\xfd\x75\x39\x1f\x8f\xc6\xa3\xf7\xd7\x6a\xf3\x56\x33\xb8\x20\x04\x91\x71\xe0\x05\xc7\x86\x35\x74\xa1\x05\xd9\x23\x74\x64\x3f\xb5\x04\xbb\x18\xb6\xe2\x28\x2c\x12\x6d\x4d\xd1\x5b\xe8\x49\x30\x88\x33\xde\x9f\xc1\x13\xf5\xb0\xa3\x01\x8f\x38\x40\x13\x05\x5a\x22\xab\x1f\x0b\x96\x90\x15\xca\xd2\x0e\x1a\x04\x44\xab\xba\x4e\x23\x23\xee\x88\xfe\x9c\x05\x6f\x5d\xf6\x86\x73\x57\x55\xea\x8c\x8c\x47\x7f\xae\x0f\xd3\x22\x09\x7b\xd3\x16\x25\x14\x15\x06\x46\xf7\x51\x30\xd4\xd4\x6b\x21\xd0\xa9\x54\x68\xc1\xd4\xe1\x3a\xb2\xe8\x35\xd4\x74\xc0\x90\xc0\xa9\x58\xc5\xe6\x92\xcf\x56\xa9\x59\x6d\x0e\xc8\x8f\xca\x08\xa6\x43\xf8\x0b\x2c\x83\x6e\x5b\xe6\xfd\xb5\xbb\x11\xa0\x53\x50\x4f\x52\xff\xde\x9b\xa0\x10\x9d\x9f\x5d\xe3\x93\x52\x99\x07\xcc\xf5\xcc\x4f\x59\x66\x5a\x64\xa7\x2b\x2a\x49\x5c\xd6\x4d\x17\x8b\xe9\xfa\x0b\xc5\x1b\xd1\x14\xb6\x7b\x13\x5a\x54\xd4\xf6\x3a\xea\xf3\xd3\xb7\x19\x12\xf0\xf6\xa0\x23\x58\x50\x43\xfa\x81\x8e\xce\xaa\x6f\xcd\x39\xe3\xfa\xc4\x56\x53\x7e\x10\x93\x83\xbf\x53\xe6\xcb\xb4\x7c\xb5\x79\xd9\xac\xeb\xdb\x6f\x9d\x4e\xfe\x95\x30\xf9\xaf\xe7\x4e\xcf\xbd\x9e\x87\xd9\xea\x2b\x00\x00\xff\xff\x23\x93\xa7\xaf\x1b\x02\x00\x00")
+
+func sqlModsubSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlModsubSql,
+		"sql/modSub.sql",
+	)
+}
+
+func sqlModsubSql() (*asset, error) {
+	bytes, err := sqlModsubSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/modSub.sql", size: 539, mode: os.FileMode(438), modTime: time.Unix(1431382801, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRecordapirequestSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x8e\xb1\x0e\xc2\x30\x0c\x44\x67\x22\xe5\x1f\x3c\x30\x00\x2a\x20\x18\x61\x44\xdd\x18\x10\x94\xb9\xb2\x5a\xd3\x46\x55\x13\x88\x1d\x55\xfc\x3d\x49\x01\xb1\x30\x9e\xef\xee\xf9\xd6\x0b\xad\xb4\xba\x9e\x2e\xf9\xb9\x60\x30\x56\x1c\x04\x26\xcf\x2b\xbc\x9b\x2b\x63\x43\x51\x1a\xdb\x80\xb4\x04\x9e\x2a\xe7\xeb\x32\x3a\xa5\xa7\x47\x20\x16\xb8\x05\x5b\x89\x71\x76\x95\x28\x67\x92\xe0\x2d\x43\xeb\x06\xe8\xd1\x3e\xe1\x93\xe2\xb1\x9d\xb0\xd0\x22\x47\xab\xa6\x78\x41\x81\x1a\x9f\x63\xb1\xc0\x8e\x78\xa7\xd5\xc4\x62\x4f\xb0\x04\x16\x1f\x7f\x66\xef\x4a\x8f\xdd\x6f\xc0\xc8\x8b\xc1\xd8\x8c\xb9\x1a\x85\xb2\xd1\x49\xda\x08\x0c\x5f\xbc\xb3\x5a\x2d\xd6\x89\x7d\xc9\x8f\xf9\xa1\xf8\xb3\x7d\x36\xdd\x64\x30\xdd\xce\xf7\x5a\xbd\x00\x72\xb5\x3b\xcf\x05\x01\x00\x00")
+
+func sqlRecordapirequestSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRecordapirequestSql,
+		"sql/recordAPIRequest.sql",
+	)
+}
+
+func sqlRecordapirequestSql() (*asset, error) {
+	bytes, err := sqlRecordapirequestSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/recordAPIRequest.sql", size: 261, mode: os.FileMode(438), modTime: time.Unix(1792414715, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRecordadminactionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x90\x3d\x6f\x02\x31\x0c\x86\x67\x22\xe5\x3f\x78\x60\x28\x28\x2d\xa2\x1f\x4b\x37\x06\x06\x24\x44\x25\xb8\x76\xb7\x2e\xd6\x5d\x4a\x2f\x3e\x25\xa6\x15\xff\x1e\xe7\xae\x95\x68\x3b\x38\xb1\xe2\xc7\xaf\xfd\x66\x31\xb7\x66\xd5\xf7\x14\x7d\x06\x8c\x80\xb5\x04\x8e\x20\x78\x24\x3d\xdb\xc4\xa7\xa6\xd5\x9b\x00\x7d\x17\x22\x64\x4a\x9f\xa1\x26\x10\x86\x93\xe6\xf9\x6e\x78\xde\x72\x63\x8d\x35\x95\x36\xe5\x67\x6b\x26\x23\x7b\x0b\x59\x52\x88\x8d\x83\xaf\x96\xb5\x83\x8f\xa3\xd0\x30\xa1\x50\xe3\xa8\x6b\x0c\xa5\x20\x67\xf0\xc1\x2b\x20\x98\x1a\x92\x3f\x3a\x9c\x7e\x73\x10\x34\x67\xa5\x3b\x12\xf4\x28\x78\xc5\xbf\x67\xd5\xa7\x58\xb3\x27\x0f\x5e\xeb\xe1\x23\x97\xc1\x45\x53\x42\x47\x59\xb0\xeb\x8b\xac\x7a\x55\x99\x16\xcb\x3f\x90\x8e\x9e\x2f\x8a\x9f\xcd\xee\xb0\xde\x57\xb0\xd9\x55\x2f\xff\xdc\x4e\x6e\x86\xdc\x7d\xdb\x71\x30\x2e\xeb\xe0\x67\x0d\xad\xc8\xcc\x9a\xb7\xd5\xf6\x75\x7d\x28\xfc\x74\xe9\x60\x7a\xaf\xf1\xa0\xf1\xa8\xf1\x34\xbb\x00\x86\xd3\x08\x6c\x7c\x01\x00\x00")
+
+func sqlRecordadminactionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRecordadminactionSql,
+		"sql/recordAdminAction.sql",
+	)
+}
+
+func sqlRecordadminactionSql() (*asset, error) {
+	bytes, err := sqlRecordadminactionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/recordAdminAction.sql", size: 380, mode: os.FileMode(438), modTime: time.Unix(1792416032, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRecordauditSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x90\x4f\x4f\x83\x40\x10\xc5\xcf\x25\xe1\x3b\xcc\xa1\x87\xb6\xc1\x36\xf5\xdf\xa1\x89\x07\x63\x30\x36\xa9\x6d\x52\x48\xf4\xba\xb2\x53\x58\x2d\xbb\xb8\x3b\x54\xfd\xf6\xce\x02\x25\x18\x4f\x4c\x78\x6f\xdf\xfb\xcd\x2c\x66\x61\x70\x5f\x55\xa8\xa5\x03\x01\x0e\xb3\xda\x2a\xfa\x01\x3c\xa1\x26\x20\x03\xb5\x43\xeb\xe6\xa2\x96\x8a\x36\x26\x0f\x83\x30\x88\xbd\xc4\xe6\x37\x53\x13\x68\x51\xa2\x03\x2a\x04\x41\x21\x4e\x08\xba\x7d\x01\xc2\x22\x48\x6b\x38\x58\x82\x33\x70\x10\xea\xc8\xd3\xd1\xe4\x4a\xbb\x30\x10\xb9\xe0\x2f\x41\x29\x24\x42\x5d\x75\x29\xd2\xf0\x73\x82\x8a\xad\xfc\x73\xee\xbb\x52\xf1\x81\x6e\x15\x06\x23\xef\x80\x0b\x70\x64\x95\xce\xa3\xb6\x83\x0a\xec\x38\x33\xa3\x33\xb4\x3e\x79\x24\x32\x32\x76\xe0\xfc\x2a\x0c\x54\x68\x0f\xc6\x96\x0c\xa0\x88\x2d\xaa\xfa\xa3\x23\xa3\xfa\x28\x8b\x9f\x35\x32\x54\xe6\xab\x0e\xd6\x94\x6c\x6d\xe3\x87\xee\x66\x51\x7f\x2f\x94\xac\x97\x48\x42\x0a\x12\x03\xcb\xbb\x33\x1a\x50\x67\x46\x72\x9f\x64\x5d\x1d\x1b\x2e\x1f\x43\x8a\xf7\x24\x51\x56\x4d\xaf\x66\x9c\x41\xd8\x6c\xe1\x37\x5e\x6f\x93\x78\x9f\xc2\x7a\x9b\xee\xfe\xdd\x7e\x34\xf1\x67\x88\xa0\xd9\x31\x02\xc5\x31\x0d\x60\x04\x67\x0e\xd6\x68\x1a\x06\x49\xbc\x89\x1f\x52\x18\x2f\x57\x2b\xc2\x6f\xd6\xc7\x97\xfd\x74\xd5\x4f\xd7\xfd\x74\xd3\x4f\xb7\x3c\x9d\x21\xc3\xe0\xe5\x29\xde\xc7\x10\xbf\xae\x93\x34\x81\x49\x97\xba\x84\xc7\xfd\xee\xb9\x83\xf3\xc5\xd0\xda\x3c\xdb\xdd\xb9\x72\xfa\x0b\xa9\x82\xe0\x10\x58\x02\x00\x00")
+
+func sqlRecordauditSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRecordauditSql,
+		"sql/recordAudit.sql",
+	)
+}
+
+func sqlRecordauditSql() (*asset, error) {
+	bytes, err := sqlRecordauditSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/recordAudit.sql", size: 600, mode: os.FileMode(438), modTime: time.Unix(1792415803, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRecordloginfailureSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x90\x3d\x4f\xc3\x40\x0c\x86\x67\x22\xe5\x3f\x78\xa8\x14\xa8\xd2\x56\x50\x26\x98\x10\xca\xc6\x80\xda\x32\x57\xe9\xc5\x49\xac\x36\x76\xe4\xf3\xc1\xdf\xc7\x09\xaa\x90\x10\x83\x65\xdf\xf9\xf1\xc7\xeb\xcd\x32\xcf\xf2\xec\xe3\x7d\x5f\xed\x0e\x11\x88\x4d\x20\x45\xd4\xb8\xbe\x48\x47\xfc\x62\x86\xc3\x68\xd1\xff\x88\x3b\xb0\x1e\x41\x31\x88\x36\xc7\x39\x7d\x6c\x6b\xba\x24\x45\x68\x13\x07\x23\xe1\xf5\xd4\x6c\x87\x96\x94\xe3\x4c\x73\x1a\x4e\xa8\x20\x2d\x04\xe1\x88\x21\x19\x7d\x3a\xfe\x53\x16\x67\xfc\x50\x9f\x31\x3e\xe5\xd9\xcd\x99\xb8\x81\x15\x44\x53\x9f\x55\x02\x92\x37\x50\x28\xb8\x1e\xb0\x00\xf1\x88\xc6\xc2\x31\x6a\x90\x8d\x5a\xf2\xdc\x2f\x3c\xcf\x72\x70\xe2\x68\x74\xca\xc8\x1f\x2b\x98\x5c\xb4\x7a\x18\x4b\xf8\xea\x91\x67\xee\xba\xb4\x84\x90\x54\xb1\x71\xda\xd5\x85\x3f\xf8\x75\x47\x38\x61\x2b\x4e\x5b\x4f\x11\xea\x49\xab\x68\x27\x7e\x17\xce\xb3\xe5\x66\x12\xb0\xaf\xde\xaa\xd7\xc3\xbf\x87\xb9\x5d\xdc\x97\xb0\x78\x70\xdb\xba\x3d\xde\x3d\xe7\xd9\x37\x29\xb0\x4b\x4b\x71\x01\x00\x00")
+
+func sqlRecordloginfailureSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRecordloginfailureSql,
+		"sql/recordLoginFailure.sql",
+	)
+}
+
+func sqlRecordloginfailureSql() (*asset, error) {
+	bytes, err := sqlRecordloginfailureSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/recordLoginFailure.sql", size: 369, mode: os.FileMode(438), modTime: time.Unix(1792414359, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRedactoutboxSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x50\xd1\x6a\xc2\x40\x10\x7c\x6e\x20\xff\x30\x0f\x42\xac\x4d\x15\x5f\x8b\x0a\x29\x06\x5a\xa8\x22\xad\xa5\xcf\x17\x6f\x13\xaf\x26\x77\xe1\xee\x62\x2d\xf8\xf1\xdd\x5c\xb0\x2f\xc7\xde\xce\xec\xec\xec\xcc\x26\x71\xf4\x5c\x0b\x7d\x72\xf0\x47\x82\x90\xd2\x92\x73\x10\x5a\xe2\x60\xb4\x27\xed\x1d\x4c\x89\x46\xa8\x9a\x09\xc2\xe3\x47\x30\x5a\x5b\x12\xf2\x17\x8e\xe1\x14\xc6\xa2\x12\x67\x8a\xa3\xae\x85\xd1\x29\xbc\x81\x40\xe7\xc8\x4e\xb1\x23\x2d\x95\xae\x86\x71\xe5\x50\x53\xe9\x7b\xbc\x20\x48\xaa\xd5\x99\x2c\xc9\x69\x1c\xc5\x51\x36\xec\x25\xd6\xb6\x84\xd2\xd8\x46\x78\x4f\x12\xbc\x6c\x9b\x6d\xf2\x45\xbe\xc9\x5e\xdf\x56\x70\x26\xb8\xd4\xa2\x21\xb4\x96\x4a\x75\x61\x55\x5e\xcc\x8d\x42\x55\x9d\xe9\x5c\x50\xdb\x8b\x13\xb9\xa7\x38\xba\x0b\xc4\x47\x38\x6f\xd9\x45\x1a\x4c\x05\x81\xe0\xa7\xbf\xa4\xbf\x80\x0d\xc5\xd1\x64\xd6\x0f\x7e\xee\xd6\xd9\x3e\x0f\x3c\x37\x35\x9d\x2f\xcc\x85\x55\x3e\xf2\x3d\x2c\x1d\x54\xab\x7a\xf6\x12\x49\x92\xc2\x75\xc5\x37\x1d\x6e\xbf\xc2\x70\x1a\x43\x79\xf4\x4d\x1d\xca\x38\xfa\x7a\xc9\xdf\x73\x9e\x77\x5e\xf8\xce\x61\xb1\x42\xd2\x0e\x81\x24\xc8\xb6\x6b\x46\xfa\x3c\xc6\xff\xd2\x29\xe7\xa3\x2b\x7f\x1c\x8f\xe6\xf7\x78\x00\x3f\x4b\x8c\xe6\xb8\x5e\x91\x2c\x92\x3f\x7d\x02\x25\x95\xa9\x01\x00\x00")
+
+func sqlRedactoutboxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRedactoutboxSql,
+		"sql/redactOutbox.sql",
+	)
+}
+
+func sqlRedactoutboxSql() (*asset, error) {
+	bytes, err := sqlRedactoutboxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/redactOutbox.sql", size: 425, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRedeemcouponSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x91\x51\x4f\xc2\x30\x14\x85\x9f\x6d\xd2\xff\x70\x1f\xf6\x20\x38\x41\xf4\xcd\x38\x93\x45\x96\x68\x42\xd0\xc0\x88\xcf\xdd\x76\xe7\x1a\xb7\x76\x69\x3b\x81\x7f\xef\x6d\xc1\x00\x3e\xb5\xb7\x3d\xe7\xbb\xa7\xb7\xd3\x31\x67\x2f\x7a\x50\xce\x82\x00\x83\x15\x76\xbd\x93\x5a\x81\xae\xa9\x2e\x75\x85\xa0\x55\xbb\x07\x59\x83\x74\xd0\x89\x3d\x58\x27\xdb\x16\x0a\x84\xc1\x62\x05\xb5\x36\xa4\xeb\x5b\xa1\x26\x9c\x71\x96\xd6\x35\x96\x84\x52\x1a\x8c\xde\x5a\x6f\x73\x0d\x1e\x38\x92\x4a\xf5\x23\x5a\x59\xc5\x80\xbb\x5e\x52\x33\xbf\x69\xc4\x60\x9d\xdf\x12\x89\x68\x9c\x09\xa5\xc9\x63\x4e\xd0\x5c\x7c\xa3\x7d\xe4\xec\x2a\x60\x6e\x29\x81\x91\xea\x2b\x3e\x91\x85\xcf\x62\x00\x95\x43\x82\x92\xd0\x5b\xff\x09\xc3\x51\x81\x54\x83\x1d\x0a\x5b\x1a\x59\x50\x7c\xa7\x49\xed\x64\xe7\xb1\x7e\xb1\x4e\x74\x7d\x0c\xdb\x06\x95\x7f\x2f\x45\x3e\x58\xfc\x60\xb0\xf3\xec\xf1\xd4\x47\xda\x7c\xcc\xd3\x3c\x0b\x6d\xed\xa4\xd4\x43\xaf\x95\xe5\x6c\x9d\xe5\x67\x23\xb4\x90\x5c\x54\x37\x30\xe3\xec\xf3\x35\x5b\x65\x21\x75\x12\xcd\x20\x5d\xce\xa9\xff\x75\xc8\xf6\xb6\x86\xe5\x66\xb1\x80\xf7\x55\xc8\x9a\x44\xf7\xa3\xe3\x7d\x98\x19\xfd\x90\x6c\xe1\x19\xa2\x87\x3f\x57\x27\x76\x97\xcd\xee\xbc\xf7\xfc\xe8\x09\x2e\x35\x23\xce\x7e\x01\x38\x99\x56\x4d\xef\x01\x00\x00")
+
+func sqlRedeemcouponSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRedeemcouponSql,
+		"sql/redeemCoupon.sql",
+	)
+}
+
+func sqlRedeemcouponSql() (*asset, error) {
+	bytes, err := sqlRedeemcouponSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/redeemCoupon.sql", size: 495, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRehashpasswordSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x90\xcd\x6a\xc3\x30\x10\x84\xcf\x11\xe8\x1d\xf6\x60\x08\x04\x37\xa1\x7f\x97\x82\x0f\x81\x18\x72\x2a\xa6\x4d\xe8\x79\x63\x6d\x6c\x53\x5b\x0a\x5a\xa5\x26\x6f\xdf\x95\x42\xe3\x16\x7a\x12\xac\x66\xe6\xdb\xd9\xd5\x42\xab\xfd\xa9\xf1\x68\x88\x01\xe1\xcc\xe4\xe7\x0c\x27\x64\x1e\x9d\x37\xd0\x22\xb7\x39\x38\xdb\x5f\xa0\x3b\x42\x17\xe2\xc0\xce\x03\xd4\x2d\xda\x86\x0c\x70\x67\x6b\xd2\x4a\x3e\x46\x64\xf0\x84\x66\xa9\x95\x56\x3b\xfc\x24\x7e\xd1\x6a\x66\x71\x20\xb8\x03\x0e\xbe\xb3\x4d\x9e\xe2\x21\xb4\x18\xc0\x8d\x96\x25\x4f\x24\x91\xb5\x15\x8c\xc8\x0e\x97\x40\x98\x8b\x80\x24\x8a\xcf\xbd\xc8\x8e\x60\xc8\x77\x5f\xe2\x4e\xe3\xdb\x62\x63\x17\x5a\xb0\x2e\xd1\x67\xe9\xfd\xeb\xbf\x8e\x84\x67\xe0\xe8\xfc\x14\xf2\x43\x13\x57\xec\x56\xa1\xc7\x81\x7f\x6d\x18\xbd\xd8\x37\xce\x4b\xfe\x00\x68\x0d\xd4\x8e\xc3\xcd\x96\x6a\xa6\x30\xba\xee\x20\x39\xae\x37\xd5\xbf\x1d\x22\x00\x0e\x14\xb1\x9e\x4e\x3d\xd6\x64\xb4\x5a\xac\xe2\x81\xf6\xd5\x66\xbd\x2b\xd3\x3d\x78\x39\x50\x40\xad\xde\xcb\xdd\x84\x29\x20\x7b\xc8\xaf\x25\x8a\xec\x31\x87\x69\xd7\x22\x7b\xd2\xea\x63\x5b\xbe\x95\x5a\xc5\xeb\x16\xd9\x3d\xac\x5f\x37\x37\x6b\x91\x3d\x6b\xf5\x0d\x4b\xbc\x08\x0d\xd6\x01\x00\x00")
+
+func sqlRehashpasswordSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRehashpasswordSql,
+		"sql/rehashPassword.sql",
+	)
+}
+
+func sqlRehashpasswordSql() (*asset, error) {
+	bytes, err := sqlRehashpasswordSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/rehashPassword.sql", size: 470, mode: os.FileMode(438), modTime: time.Unix(1792414416, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlReleasecouponSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\xb1\x0a\xc2\x30\x14\x45\x67\x03\xf9\x87\x37\x38\x15\x6b\xed\x2a\x54\x28\x34\xe0\x24\x52\x2b\xce\xb1\x7d\xd1\xa0\x26\x25\x2f\xc1\xdf\x37\x4d\x17\x3b\x5e\xee\x3d\x87\x5b\x64\x9c\xb5\xe8\x83\x33\x04\x12\x1c\x0e\xf8\x19\xbd\xb6\x06\xac\x8a\xb9\xb7\x03\x82\x54\x1e\x1d\xf8\x27\x02\x85\x3b\xf5\x4e\xcf\x03\xed\xe1\x2b\x09\x94\x75\xa0\xa4\x7e\xe3\xc0\x19\x67\x9d\x7c\x21\xed\x39\x5b\x25\x32\x07\xf2\x4e\x9b\xc7\x26\xd1\xb3\x0c\x02\x45\x1b\x9a\xe8\x9c\x90\xac\x98\xb0\xeb\xb9\xa9\x3b\x91\x2a\xda\xf6\x36\x8c\xd6\x10\x67\x17\xd1\xfd\x3d\x22\xa8\x16\x29\x87\x92\xb3\xdb\x51\xb4\x22\x99\xab\x75\x09\xf5\xa9\x59\x4c\x0e\xb0\xe3\xec\x07\x8d\xff\x31\x33\xe2\x00\x00\x00")
+
+func sqlReleasecouponSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlReleasecouponSql,
+		"sql/releaseCoupon.sql",
+	)
+}
+
+func sqlReleasecouponSql() (*asset, error) {
+	bytes, err := sqlReleasecouponSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/releaseCoupon.sql", size: 226, mode: os.FileMode(438), modTime: time.Unix(1792414834, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlReleasetrialSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x25\xcd\xc1\x0a\xc2\x30\x10\x84\xe1\xb3\x81\xbc\xc3\x1c\x04\xa1\xa8\xc5\xab\xd0\x83\x60\xc0\xa3\xd4\x8a\xe7\xd5\x6e\x35\x18\x53\xc9\x6e\xe9\xeb\x9b\xd6\xf3\x30\xdf\x5f\x16\xd6\xd4\xac\x43\x8a\x02\xc2\x20\x9c\x56\x02\x4d\x9e\x02\xa8\x53\x4e\xd0\x17\x43\x86\xbb\x3c\x92\xff\xaa\xef\x23\xbc\x62\x24\x41\xd7\x27\x74\xe4\x03\xb7\xd6\x58\xd3\xd0\x9b\x65\x6f\xcd\x22\xd2\x87\xb1\x81\x64\x22\x3e\xd7\x33\x98\x09\x52\xf4\x63\x2e\x78\xb5\xa6\x28\xa7\xc3\xf5\x7c\x3c\x34\x6e\xde\x65\x3b\xf9\xd6\x5c\x5c\xf3\x2f\x73\x8b\x2a\xdb\x41\xd8\x9a\xdb\xc9\xd5\x0e\x93\x5a\x2d\x77\x3f\xa4\x24\x14\x42\xad\x00\x00\x00")
+
+func sqlReleasetrialSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlReleasetrialSql,
+		"sql/releaseTrial.sql",
+	)
+}
+
+func sqlReleasetrialSql() (*asset, error) {
+	bytes, err := sqlReleasetrialSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/releaseTrial.sql", size: 173, mode: os.FileMode(438), modTime: time.Unix(1792419064, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRemoveapiusageSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8c\x3d\x0b\xc2\x30\x10\x86\x67\x03\xf9\x0f\xef\xe0\x54\xd4\xe2\x2a\xb8\x79\xe2\xa0\x08\xa1\xe2\x7c\xc8\x59\x83\x34\xd1\xdc\x45\xff\xbe\xb6\xf3\xf3\xd1\x36\xde\x05\x19\xf2\x47\x14\xf6\x10\x14\x79\x57\x51\xc3\x2d\xd7\x64\x8a\x7b\x2e\x60\x54\x95\xe2\x9d\x77\x1d\x3f\x45\x37\xde\xcd\x12\x0f\x82\x25\xd4\x4a\x4c\xfd\x62\xe2\xff\x9a\x0d\xf9\x9b\x14\xd1\xbc\x6b\xda\x31\xd8\xd1\x91\x3a\xc2\x3e\x9c\x4f\x93\xa4\x2b\x7e\xc5\x8b\x72\x2f\xb8\x1e\x28\x10\xc6\xd1\x76\xbe\xfe\x01\xa4\xbc\xe3\xc7\x86\x00\x00\x00")
+
+func sqlRemoveapiusageSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRemoveapiusageSql,
+		"sql/removeAPIUsage.sql",
+	)
+}
+
+func sqlRemoveapiusageSql() (*asset, error) {
+	bytes, err := sqlRemoveapiusageSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/removeAPIUsage.sql", size: 134, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRemoveauditlogSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x8d\x41\x0b\x82\x40\x10\x85\xcf\x2d\xec\x7f\x78\x87\x4e\x52\x4a\xd7\xa0\x5b\x1b\x1d\x0c\x41\x84\xce\xa6\x93\x2e\xe9\x2c\xec\xac\x86\xff\xbe\xd6\xa0\xdb\x3c\xde\xfb\xbe\xc9\x12\xad\x4a\x1a\xdd\x4c\x02\x9a\xc9\x2f\x10\x6a\x26\x6f\xc3\x12\x23\x07\x3c\x9d\x47\x8d\x49\xc8\x6b\xa5\x55\xc1\xc3\x82\xd6\x31\xe1\xdd\x13\x23\xf4\xb4\x56\xf1\x18\x85\x86\x68\xb1\x82\x07\x59\xee\xe0\x57\x6d\x9b\x46\xae\xaa\x5f\x24\x47\xad\x36\x5c\x8f\x84\x3d\x24\xf8\xef\x64\xf7\x87\x7f\xcf\x04\x8d\xe3\x86\x3c\x6b\x95\x64\x11\x3b\x9b\xdc\x54\x06\x97\xb2\xb8\xad\x53\x49\xeb\xa9\xb5\x21\x77\x1d\xee\x57\x53\x1a\x44\xdd\x69\x7b\xf8\x00\xe4\xb5\x6d\x15\xc6\x00\x00\x00")
+
+func sqlRemoveauditlogSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRemoveauditlogSql,
+		"sql/removeAuditLog.sql",
+	)
+}
+
+func sqlRemoveauditlogSql() (*asset, error) {
+	bytes, err := sqlRemoveauditlogSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/removeAuditLog.sql", size: 198, mode: os.FileMode(438), modTime: time.Unix(1792415803, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRemovecardvalueSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8d\x31\x0b\xc2\x30\x14\x84\x67\x03\xf9\x0f\x37\x74\x2a\x6a\xd1\x51\xe8\x20\x34\xc5\x41\x2b\x94\xa2\x73\xd0\x67\x1b\xd4\x56\xf2\x5e\xe9\xdf\x37\x41\x5d\x1c\xef\xee\xe3\xbe\x2c\xd5\xaa\x1c\x7c\x4b\xc2\x98\x3a\x2b\xb0\x78\x79\xd7\x8b\xeb\x5b\xb8\x50\x0d\x5e\x3a\xad\xb4\x6a\xec\x9d\x78\xa3\xd5\xec\x62\xfd\xb5\xb2\x4f\xc2\x02\x2c\x81\x6c\xe7\xe8\x63\x1c\x6e\x90\x8e\x10\xe7\x40\x31\xc9\x1f\x14\x1a\x38\xc1\x64\xf9\x23\xa0\x2b\x5c\xaf\x55\x9a\xc5\xf7\xc2\xec\x4d\x63\x50\xd6\xc7\x03\x46\x26\xcf\xcb\xf8\x73\xb2\x8f\x91\x18\xe7\x9d\xa9\x0d\x7e\xde\x3c\x59\x61\x5b\x15\xf8\x1a\xf2\x64\xfd\x06\x06\x03\x8a\x08\xc3\x00\x00\x00")
+
+func sqlRemovecardvalueSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRemovecardvalueSql,
+		"sql/removeCardValue.sql",
+	)
+}
+
+func sqlRemovecardvalueSql() (*asset, error) {
+	bytes, err := sqlRemovecardvalueSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/removeCardValue.sql", size: 195, mode: os.FileMode(438), modTime: time.Unix(1792416216, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlRemovechallengeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8e\xbd\x0a\xc2\x30\x14\x46\x67\x03\x79\x87\x6f\xe8\x54\xd4\xa2\xa3\xd0\x41\x6c\x44\xf0\x0f\x4a\xc1\x41\x1c\xd2\x7a\x6d\x8a\x6d\x02\x4d\x54\xfa\xf6\xa6\x05\x8b\xf3\x3d\xf7\x9c\x2f\x0a\x39\x4b\xa9\x31\x6f\xb2\x90\xa8\x4d\x59\x69\x14\x4a\xd6\x35\xe9\x92\x60\x74\x41\xa8\x1c\x94\xb4\xc8\x89\x34\x5e\x96\xee\x9c\x71\x96\xc9\x27\xd9\x15\x67\x13\x2d\x1b\xc2\x0c\xd6\xb5\x95\x2e\xa7\xfd\xbd\x85\x53\xd2\xc1\x7c\xb4\xf5\xaf\x1e\x19\x75\x7b\xea\x3c\x7a\xbd\xe5\x9d\xa3\xa9\xa7\xa8\xf7\x2a\x98\x87\x2f\x8f\x10\x67\x61\xd4\x17\x12\x71\x10\x99\xc0\x36\x3d\x1f\x07\xab\x9d\x0f\xe3\x36\x3f\xce\xe2\xb2\x13\xa9\x40\x3f\x20\x0e\x16\x58\x9f\x12\xfc\x97\xe2\x60\xc9\xd9\x17\xb7\xd6\x8f\xfd\xde\x00\x00\x00")
+
+func sqlRemovechallengeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlRemovechallengeSql,
+		"sql/removeChallenge.sql",
 	)
 }
 
-func sqlAddcardSql() (*asset, error) {
-	bytes, err := sqlAddcardSqlBytes()
+func sqlRemovechallengeSql() (*asset, error) {
+	bytes, err := sqlRemovechallengeSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addCard.sql", size: 716, mode: os.FileMode(438), modTime: time.Unix(1429059132, 0)}
+	info := bindataFileInfo{name: "sql/removeChallenge.sql", size: 222, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlAddcardhistoricalSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x50\xcb\x6e\xc2\x30\x10\x3c\x67\xbf\x62\x0f\x1c\x00\xb9\xa0\xbe\x1f\xb7\x0a\x21\x15\xa9\xa2\x52\x81\xde\x2d\xb2\x04\xab\x89\xdd\xda\x1b\xa1\xfc\x7d\xd7\x4e\xd2\x86\x43\x0f\xe3\xc3\xce\x8c\x77\x76\xe6\x53\x58\xd9\x40\x9e\x03\x6a\xf4\xee\x84\xc6\xb2\x43\x3e\x12\xd6\x32\x5d\xb8\xb2\xa4\x3d\x1b\x67\x17\xce\x32\x59\x0e\x33\x80\xad\xfe\xa4\xf0\x04\x99\x3b\x59\xf2\x78\x81\x81\xbd\xb1\x85\x4a\x06\x71\x6a\x46\x61\x02\x1a\x86\x6c\xff\xeb\x1f\xe8\x06\x43\x77\x68\x0d\xd1\x2a\x6a\xed\xf3\xb5\xae\x68\xa0\x8d\x41\x2a\x2e\x30\x52\x90\x05\xe2\x7f\x78\x61\xe2\xb6\xaa\x92\x8c\x03\x5a\xb7\xa1\x3a\x02\xb2\xef\x5a\x97\x86\x9b\x33\x45\x4e\x07\x63\x29\xc7\x8e\x83\xac\xd4\xb6\x38\x53\xc4\x41\xad\x0b\x92\x6e\xe2\xb2\xf4\x8d\xe5\xf6\x1f\xa9\x4b\xe1\x51\x7a\xab\xb4\x6d\x52\xcc\x00\xd3\x39\xc0\x6a\xbd\x59\xbe\x6f\x71\xb5\xde\xbe\xa5\x0c\x61\xf6\x77\xf6\x8b\x09\xec\x7c\x83\x30\x4e\x15\x0e\x1b\x51\xd8\x97\xa0\xb0\xbb\x56\xf5\xf1\x15\xf6\x7b\x55\x1f\x56\xa5\x6c\xf1\x0d\xbc\xfb\xca\x35\xd3\x04\xe1\xe3\xf9\x75\xb7\xdc\xc0\x78\x74\xa9\x70\x74\x25\xb8\x16\xdc\x08\x6e\x05\x77\x82\x7b\xc1\x83\xe0\x71\xf2\x13\x00\x00\xff\xff\xf0\x77\x5b\x0e\xfe\x01\x00\x00")
+var _sqlRemovechallengesSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x35\x8d\xb1\x0a\xc2\x30\x14\x45\x67\x1f\xe4\x1f\xee\xe0\x54\xb4\xc5\x55\x70\xd2\x88\x43\x45\x08\x05\xe7\x20\xcf\x34\x98\x26\x90\x17\x2b\xfe\xbd\x34\xe0\x7c\xcf\x39\xb7\x6b\x14\x19\x9e\xd2\xcc\x02\x9e\x39\x7f\x11\x92\xf3\x11\x8f\xd1\x86\xc0\xd1\x31\x9e\x29\xc3\xe2\x2d\x9c\x15\x29\x1a\xec\x8b\x65\xaf\x68\x15\xed\xc4\xd8\x42\x4a\xf6\xd1\x6d\xea\x8e\x32\xda\x82\xf4\x89\x02\x5f\x14\x35\xdd\x22\x9c\x74\xaf\x07\x8d\xb3\xb9\x5d\x2b\x24\x6d\x3d\x38\xfe\xfb\x82\xfb\x45\x1b\x8d\xa5\x77\x58\xef\x7e\xf5\xd7\xe6\x45\x90\x00\x00\x00")
 
-func sqlAddcardhistoricalSqlBytes() ([]byte, error) {
+func sqlRemovechallengesSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAddcardhistoricalSql,
-		"sql/addCardHistorical.sql",
+		_sqlRemovechallengesSql,
+		"sql/removeChallenges.sql",
 	)
 }
 
-func sqlAddcardhistoricalSql() (*asset, error) {
-	bytes, err := sqlAddcardhistoricalSqlBytes()
+func sqlRemovechallengesSql() (*asset, error) {
+	bytes, err := sqlRemovechallengesSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addCardHistorical.sql", size: 510, mode: os.FileMode(438), modTime: time.Unix(1429058997, 0)}
+	info := bindataFileInfo{name: "sql/removeChallenges.sql", size: 144, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlAddcollectionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x8e\xbf\x4e\x80\x30\x10\xc6\x67\x9b\xf4\x1d\x6e\x20\x51\x08\x42\xd4\xcd\xcd\x81\x81\x44\x31\x11\x74\xaf\x70\xb5\x8d\xd8\x1a\xee\x08\x3e\xbe\x47\x07\x82\x8e\xf7\xdd\xef\xfb\x53\x17\x5a\xf5\x18\x26\x02\x03\x76\x41\x72\x30\xc6\x79\xc6\x91\x7d\x0c\x10\xad\x05\x8e\xc0\x0e\x61\x7a\xaf\xb4\xd2\xea\xc9\xfc\x9c\x00\x02\x4f\x40\xc8\x07\x84\xd6\xac\x33\x8b\x0f\xee\x12\x3e\x98\x4f\xa4\x7b\xad\x2e\xe2\x16\x70\x81\x6b\x20\x5e\x7c\xf8\x28\x13\xbd\x92\x48\xec\x8c\xf0\x9b\x44\xb1\xf3\x24\x64\x30\x5f\xf8\x0f\xfc\x53\x38\x61\x60\x6f\xbd\x58\x7d\x38\x62\x2e\x65\xc6\xb7\x19\x51\xab\xa2\xde\x7b\xdb\xae\x6f\x5e\x06\x68\xbb\xe1\x39\xfd\xa9\x3a\x67\x68\x75\x95\xf6\x94\xb0\x97\xe5\x72\xbf\x3d\x3c\xbe\x36\xbd\xe8\xd9\x4d\x09\xd9\x6d\xfe\x1b\x00\x00\xff\xff\x4a\x96\x4b\xab\x16\x01\x00\x00")
+var _sqlRemovecollectioncontentsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8d\xb1\x0a\xc2\x30\x14\x45\x67\x03\xf9\x87\x3b\x38\x15\xb5\xb8\x0a\x4e\x1a\xe9\x50\x11\x42\xc1\x39\x94\xa7\x0d\xd6\x04\xf2\x9e\x15\xff\xde\x18\x11\xd7\xc3\xb9\xe7\xd6\x95\x56\x96\xee\x71\x22\x86\x0c\x84\x3e\x06\xa1\x20\x8c\x78\x01\x4d\x94\x5e\x99\x8c\x23\xf5\xe2\x63\x80\xc3\x83\x29\x21\x3e\x03\x6b\xa5\x55\xe7\x6e\xc4\x1b\xad\x66\x19\x64\xbc\x04\x4b\xf2\xe1\xba\xf8\x5a\x32\x38\x29\x2a\xbc\x68\x55\xd5\x9f\xc5\xde\xb4\xa6\x33\x38\xd8\xd3\xb1\x48\xbc\xfa\xd7\x77\xbf\xe7\x73\x63\xac\x41\x69\x6e\xe7\xeb\x37\x39\x52\xcd\x9c\xa1\x00\x00\x00")
 
-func sqlAddcollectionSqlBytes() ([]byte, error) {
+func sqlRemovecollectioncontentsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAddcollectionSql,
-		"sql/addCollection.sql",
+		_sqlRemovecollectioncontentsSql,
+		"sql/removeCollectionContents.sql",
 	)
 }
 
-func sqlAddcollectionSql() (*asset, error) {
-	bytes, err := sqlAddcollectionSqlBytes()
+func sqlRemovecollectioncontentsSql() (*asset, error) {
+	bytes, err := sqlRemovecollectioncontentsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addCollection.sql", size: 278, mode: os.FileMode(438), modTime: time.Unix(1428963928, 0)}
+	info := bindataFileInfo{name: "sql/removeCollectionContents.sql", size: 161, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlAddresetSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x8f\x3f\x4f\xc3\x30\x10\xc5\x67\x22\xf9\x3b\xbc\xa1\x03\xad\x0c\x15\x7f\x26\x36\x86\x0e\x15\xa8\x48\x24\x74\x41\x0c\x17\xf9\x02\x56\x1b\xa7\xf2\x5d\x83\xf2\xed\x39\x07\x46\x06\x4b\x96\xde\xef\xe9\xfd\x6e\xbd\x72\x55\xcd\x29\x08\x08\x42\x89\x8f\x13\x02\xe7\x38\x72\x40\x66\x61\xc5\xd0\x75\xd0\x01\xfa\xc5\x08\xa4\xd4\x92\xb0\xab\x5c\xd5\xd0\x81\xe5\xc1\x55\x17\x89\x7a\xc6\x15\x44\x73\x4c\x9f\x1e\x67\xe1\x6c\x30\x59\xf1\x3b\x09\xa2\x1a\x22\x2c\x12\x87\xf4\xc4\x93\x81\xef\x1f\xed\xa4\xec\x6d\x6e\xa4\x63\x0c\xf8\x0b\x71\xe0\xa9\xa0\x4a\x59\xf7\x25\xf0\x30\xab\xf9\x67\x25\x8d\x3d\x5b\xd4\x9f\xc4\xa3\x1b\x32\x4e\x99\x47\x4e\x6a\x8b\xa0\xf6\x5c\x8c\x56\xeb\x62\xb5\xdd\xd5\x9b\xd7\x06\xdb\x5d\xf3\x32\x9b\xc8\xf5\x7c\x84\xc0\x55\x97\x45\xd4\xff\x1e\x65\x26\x1e\xff\x4d\x2d\x0d\xdc\x3f\x3e\xbf\x6d\x6a\x2b\x2c\x6e\x3c\x16\xb7\xf6\xee\xec\xdd\x2f\x7f\x02\x00\x00\xff\xff\x0d\xce\x15\x28\x2a\x01\x00\x00")
+var _sqlRemovecollectionhistorySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8d\xb1\x0a\x02\x31\x10\x44\x6b\x03\xf9\x87\x29\xac\x0e\xf5\xb0\x15\xec\x8c\x5c\xa1\x08\xe1\xc0\x3a\x1c\xab\x09\x9e\x09\x64\xd7\x13\xff\xde\x18\x05\xbb\xe1\xf1\x66\xa6\x6d\xb4\xb2\x74\x4f\x13\x31\xc4\x13\x28\x4a\xc8\x04\x1f\x58\x52\x7e\x21\x5d\x40\x13\x95\x30\xa4\x71\xa4\x41\x42\x8a\x70\x78\x30\x65\xa4\x67\x64\xad\xb4\xea\xdd\x8d\x78\xa3\xd5\xac\x80\x82\x97\x60\xc9\x21\x5e\x17\x5f\x4b\xbc\x93\xaa\x22\x88\x56\x4d\xfb\x69\xec\xcc\xc1\xf4\x06\x7b\x7b\x3a\x56\x89\x57\xff\xf5\xee\x77\x7c\xee\x8c\x35\xa8\x93\xdb\xf9\xfa\x0d\x42\xc4\x3b\x7a\xa6\x00\x00\x00")
 
-func sqlAddresetSqlBytes() ([]byte, error) {
+func sqlRemovecollectionhistorySqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAddresetSql,
-		"sql/addReset.sql",
+		_sqlRemovecollectionhistorySql,
+		"sql/removeCollectionHistory.sql",
 	)
 }
 
-func sqlAddresetSql() (*asset, error) {
-	bytes, err := sqlAddresetSqlBytes()
+func sqlRemovecollectionhistorySql() (*asset, error) {
+	bytes, err := sqlRemovecollectionhistorySqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addReset.sql", size: 298, mode: os.FileMode(438), modTime: time.Unix(1428963994, 0)}
+	info := bindataFileInfo{name: "sql/removeCollectionHistory.sql", size: 166, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlAddsessionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x8e\x3f\x4f\x02\x41\x10\xc5\x6b\xe6\x53\xbc\x82\x02\xc8\x2a\xf1\x4f\x65\x67\x41\x41\x34\x98\x78\x27\x8d\xb1\x98\xcb\xcd\xe9\x06\x6e\x97\xec\x8c\x67\xee\xdb\x3b\x10\x12\x1a\x8a\x49\x26\xbb\x6f\xde\xef\xb7\x5c\x50\x25\xa9\x55\x30\x94\x93\xec\x47\xb4\x52\xe2\x20\x2d\x54\x54\x63\x4e\xc8\x5d\x07\xcb\xb0\x1f\x41\xcb\xc6\x0d\xab\x10\xd5\xbc\x13\x7d\xa2\x49\xe2\x5e\x70\x03\xb5\x12\xd3\x77\xc0\xaf\x4a\xf1\x24\x1b\xf2\x5f\x52\x44\xa3\xc9\xb9\xe6\x45\x46\xcf\x7d\x7e\x35\xa3\x49\x70\xd8\xc0\xfb\x78\x61\xec\x64\xf4\xa4\x71\xb1\xed\xf1\x3d\xc0\x95\x4e\x9b\xdf\x58\xec\xc5\xbf\xfa\x83\x06\x74\xb9\xe0\x50\x64\x90\x64\xce\x03\x37\x0e\xa4\xc5\x92\x68\xbd\xa9\x56\xef\x35\xd6\x9b\xfa\xed\x24\xa1\xb7\xe7\x6a\x05\xcd\x8e\x92\x01\x17\x11\xdf\xaf\xa0\xe6\xa0\xed\xf3\xeb\xc7\xaa\xa2\xd9\xf4\x2e\x60\x7a\xef\xf3\xe0\xf3\x38\xff\x0f\x00\x00\xff\xff\x92\x18\x13\x59\x24\x01\x00\x00")
+var _sqlRemovecollectionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8d\xb1\x0a\xc2\x30\x14\x45\x67\x03\xf9\x87\x3b\x38\x95\x6a\x71\x15\xdc\x8c\x38\x28\x42\x28\x38\xc7\xfa\xd4\x60\x9b\x40\xde\x6b\xc5\xbf\x37\xc6\xc1\xf5\x72\xce\xb9\x4d\xa5\x95\xa5\x21\x4e\xc4\xa0\x89\xd2\x1b\x5d\xec\x7b\xea\xc4\xc7\x00\x87\x91\x29\x21\xbe\x02\xd7\x79\x0f\x42\x41\x18\xc3\xc8\x82\x0b\x21\x15\xed\x8a\x9b\x4f\x2c\x5a\x69\xd5\xba\x27\xf1\x5a\xab\x59\x16\xb2\xb6\x00\x4b\xf2\xe1\x5e\xff\x2a\xf2\x70\x52\x52\xf0\x99\xae\x9a\xaf\xb1\x35\x07\xd3\x1a\xec\xec\xe9\x58\x20\x5e\xfe\xdf\x19\xe7\xbd\xb1\x06\x25\xb6\x99\xaf\x3e\xfe\x8b\xe5\xb9\xaa\x00\x00\x00")
 
-func sqlAddsessionSqlBytes() ([]byte, error) {
+func sqlRemovecollectionsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAddsessionSql,
-		"sql/addSession.sql",
+		_sqlRemovecollectionsSql,
+		"sql/removeCollections.sql",
 	)
 }
 
-func sqlAddsessionSql() (*asset, error) {
-	bytes, err := sqlAddsessionSqlBytes()
+func sqlRemovecollectionsSql() (*asset, error) {
+	bytes, err := sqlRemovecollectionsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addSession.sql", size: 292, mode: os.FileMode(438), modTime: time.Unix(1428963914, 0)}
+	info := bindataFileInfo{name: "sql/removeCollections.sql", size: 170, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlAdduserSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x8f\x4d\x4f\x32\x31\x10\xc7\xcf\x4f\x93\x7e\x87\x39\x70\x00\xb2\x0f\x44\xf1\xe4\xcd\x03\x89\x24\x8a\x89\xa0\xf7\xa1\x9d\x95\xc6\xdd\x96\x74\x06\x56\xbe\xbd\xd3\x5d\x21\x7a\x98\xa4\x69\x7e\xff\xb7\xf9\xd4\x9a\x0d\x45\xcf\x80\x70\xa2\x1c\xea\x40\x1e\x8e\x4c\x19\x52\x5d\x83\x24\x90\x3d\x81\x47\xc1\x1d\x32\xcd\xac\xb1\xe6\x19\xbf\xc0\xa5\xa6\x21\x27\x21\x45\x86\xc0\xc0\x24\x57\x94\x6a\x3c\x36\xa2\x6a\x58\xf4\xf8\x16\x3f\x89\xef\xad\xf9\x17\xb1\x25\xf8\x0f\x2c\x39\xc4\x8f\x6a\xc8\x90\x3d\x2a\xda\x15\x17\x51\x84\x5a\x0c\xcd\x2f\xa6\x18\xa2\xf7\x99\x98\xa1\x23\x70\x18\x35\x39\x0a\x3a\xd1\xb6\xbd\x01\x16\xd9\x01\x99\x1f\x91\xf7\xaa\xdc\x9d\x85\x70\x10\xaa\xea\xa7\x08\xbb\x7c\x3e\xc8\xb8\x60\x5d\xca\xbe\x82\x98\xa2\xa3\x49\xe9\x54\x1e\x7f\x65\xc3\x97\x9a\x7b\xa8\x53\xd6\x3d\x39\x9c\xb4\x0c\x5c\x42\xac\x99\xce\xcb\xae\xd5\x7a\xb3\x7c\xdd\xc2\x6a\xbd\x7d\xe9\xab\xf0\xac\x25\x41\xb0\x66\x5c\x86\x56\xd0\x6f\xa9\xae\xb2\x4b\xa8\x02\xef\x0f\x4f\x6f\xcb\x8d\x82\xa3\x9b\x0a\x46\xb7\x7a\x0b\xbd\xbb\xc9\x77\x00\x00\x00\xff\xff\x4f\x6f\x3b\x5c\x8b\x01\x00\x00")
+var _sqlRemoverecoverycodeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\xb1\x8a\xc2\x40\x14\x45\x6b\x07\xe6\x1f\x6e\x61\x25\x6e\x82\x82\x16\x0b\x29\x44\x47\x2c\x5c\x05\x11\xb6\x90\x2d\x9e\xc9\xcb\x26\x98\xcc\xc0\xbc\xd1\xe0\xdf\xef\xcc\x82\x85\xfd\xb9\xe7\xdc\x7c\xa2\xd5\xda\x59\xb9\xf7\x2c\x20\x48\x6b\x7f\x3b\x86\xe7\xd2\x3d\xd8\x3f\x51\xba\x8a\x33\x1c\x1c\xbc\x1b\x40\x75\xcd\x65\xe0\x0a\x3d\x93\x15\x84\x86\xb5\x4a\x00\x06\x12\x58\x17\xf0\xa0\xae\xad\x32\xad\xb4\x3a\xd3\x8d\xe5\x53\xab\x91\xa5\x9e\xf1\x01\x09\x3e\x9a\xa7\xb8\x0b\xfb\x38\xa4\x00\x37\x44\x45\x1b\x22\x92\x14\x3b\x92\x26\x62\x97\x9f\xeb\x33\xf0\x14\xd2\xd0\x7c\xb1\x84\xab\x53\xe4\xfd\x8d\x56\x93\x3c\x15\x36\x66\x6f\xce\x06\xdb\xd3\xf1\xeb\xdf\x2a\xd9\x0b\x5b\x47\x4a\xf0\xbd\x33\x27\x83\x94\x2f\xc6\x33\xac\x0e\x1b\xbc\x3a\xc5\x78\xae\xd5\x1f\xde\xa4\xa8\x38\xf8\x00\x00\x00")
 
-func sqlAdduserSqlBytes() ([]byte, error) {
+func sqlRemoverecoverycodeSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlAdduserSql,
-		"sql/addUser.sql",
+		_sqlRemoverecoverycodeSql,
+		"sql/removeRecoveryCode.sql",
 	)
 }
 
-func sqlAdduserSql() (*asset, error) {
-	bytes, err := sqlAdduserSqlBytes()
+func sqlRemoverecoverycodeSql() (*asset, error) {
+	bytes, err := sqlRemoverecoverycodeSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addUser.sql", size: 395, mode: os.FileMode(438), modTime: time.Unix(1428963868, 0)}
+	info := bindataFileInfo{name: "sql/removeRecoveryCode.sql", size: 248, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetallresetsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3c\x8d\xbd\x6a\xc3\x30\x14\x46\xe7\x0a\xf4\x0e\xdf\xd0\xa1\x35\xaa\x4d\xd7\x42\x0b\xa6\x55\x09\xe4\x0f\x1c\x93\xcc\x22\xba\x49\x84\x13\x29\x91\x64\x1b\xbf\x7d\x6c\x05\xb2\x5d\x2e\xe7\x9c\xaf\xc8\x38\x2b\xf7\xb7\xd6\x78\x0a\x88\x27\x02\x75\xe4\x07\x74\xea\x6c\x34\xc6\x1f\x45\x34\x34\xe0\xe0\x3c\x14\xae\xde\x75\x46\x93\x46\x1b\xc8\xe7\x9c\x71\x56\xab\x86\xc2\x17\x67\x2f\x56\x5d\x08\x1f\x08\xd1\x1b\x7b\x14\x09\x18\x73\x2a\xc2\xf5\x36\xc0\x44\xce\xb2\x62\x12\x36\x72\x21\x7f\x6b\x4c\xb8\x78\xf4\xe7\x34\x88\xd1\x53\x3e\x6e\xa7\x51\x01\xb2\x3a\x5d\x9c\xfd\x57\xeb\x65\x4a\x85\x3c\xa1\x81\xb3\xdd\x4c\x56\x32\xe9\xdf\xaf\x9f\x28\x57\x7f\x4f\x1c\x3f\xb0\xae\x7f\x7b\xbf\x07\x00\x00\xff\xff\xc7\x94\x70\x4a\xd2\x00\x00\x00")
+var _sqlRemoverecoverycodesSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x35\xcc\xb1\x0a\xc2\x40\x10\x04\xd0\xda\x85\xfd\x87\x29\xac\x82\x1a\x6c\x05\x2b\x3d\xb1\x50\x84\x23\x60\x7d\xc4\x55\x43\xc8\x2d\xdc\x9e\x11\xff\x5e\x73\x60\x37\x30\x33\xaf\xae\x98\xbc\x0c\x3a\x8a\x41\x46\x49\x1f\x24\x69\xb5\x84\x56\x6f\x82\xbb\x26\x04\xbc\x4c\x12\x13\x53\x13\x7a\xb1\x0d\xd3\x2c\x86\x41\xb0\x84\xe5\xd4\xc5\xc7\xa2\xf4\xc8\xcf\x90\xa1\xef\x68\xe8\x32\x53\x55\x4f\x87\xbd\x3b\xb9\xc6\xe1\xe0\x2f\xe7\x32\xb2\xd5\x9f\xdf\xfd\x74\xc3\xf5\xe8\xbc\xc3\xa4\x6d\xe7\x6b\xa6\x2f\x62\x14\x19\x55\x8e\x00\x00\x00")
 
-func sqlGetallresetsSqlBytes() ([]byte, error) {
+func sqlRemoverecoverycodesSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetallresetsSql,
-		"sql/getAllResets.sql",
+		_sqlRemoverecoverycodesSql,
+		"sql/removeRecoveryCodes.sql",
 	)
 }
 
-func sqlGetallresetsSql() (*asset, error) {
-	bytes, err := sqlGetallresetsSqlBytes()
+func sqlRemoverecoverycodesSql() (*asset, error) {
+	bytes, err := sqlRemoverecoverycodesSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getAllResets.sql", size: 210, mode: os.FileMode(438), modTime: time.Unix(1433183741, 0)}
+	info := bindataFileInfo{name: "sql/removeRecoveryCodes.sql", size: 142, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetcardSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x50\x4f\x6b\xfb\x30\x0c\x3d\xff\x0c\xfe\x0e\x3a\x04\x7e\x50\xb2\x96\xfd\xbb\x0c\x72\x28\x5d\xc6\x0e\x5b\x07\x5d\xc7\xce\x26\x51\x5b\xb3\xd4\x5e\x2d\xa5\xa5\xdf\x7e\xb2\x93\x51\x5f\x76\xb2\xac\xf7\x9e\x9e\x9e\x66\x13\xad\xe6\xcd\xa1\xb7\x01\x09\x78\x87\xd0\x19\x46\x62\x20\x96\x17\xfc\x06\x0c\x34\x26\xb4\x60\x9d\x54\x3d\x61\xf8\x4f\xd0\xf8\xae\xc3\x86\xad\x77\x53\xad\xb4\x5a\x9b\x2f\xa4\x07\xad\xfe\xf9\x93\xc3\x00\x57\xa2\x0d\xd6\x6d\xcb\x44\x97\x99\x86\x41\x10\x02\xcb\xc2\xb9\x68\x33\x62\xd6\x14\xc7\xa4\x88\xda\x48\x17\xef\xa5\xd9\x63\x46\x8e\x4b\xee\x79\x9b\xd6\x12\x06\x21\xff\x41\x10\x44\xf0\x43\x6f\x3a\xcb\xe7\x0c\xf7\x0e\x07\x1b\x84\x01\xb4\x12\xfd\x84\xb0\x33\x47\x8c\x22\x30\x04\x47\xe9\xb7\xb0\xf1\x21\xd9\x90\x56\x93\x59\x8c\xfa\x5e\xbf\xd4\x8b\x35\xfc\x6e\x55\xc2\xe8\x5e\x8e\x93\xce\xa9\x70\x9c\xaa\xce\x10\x7f\x7c\xb7\x72\x47\xad\x9e\x56\x6f\xaf\xa0\x55\x4c\x45\xd3\x4b\xdc\x85\x77\x8c\x8e\x65\xfe\xe7\x73\xbd\xaa\x85\x91\x6e\x58\x15\xd7\x30\x5f\x3e\x66\x77\xa9\x8a\x9b\xa1\x33\x3a\x57\xc5\x6d\xfa\x8f\xfe\x55\x71\x97\xbe\xe3\x16\x55\x71\xff\x13\x00\x00\xff\xff\x5d\xee\x86\xf6\xd8\x01\x00\x00")
+var _sqlRemoveresetsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8c\x3d\x0b\xc2\x30\x10\x86\x67\x0f\xf2\x1f\xde\xc1\xa9\x68\x8b\xab\xe0\xe6\x15\x07\x8b\x10\x0a\xce\x19\x4e\x2d\xd2\x04\xee\xce\x8a\xff\x5e\x9a\xf9\xf9\xe8\x9a\x40\x51\xe6\xb2\x88\x41\x16\xd1\x1f\x54\x4c\x1c\x8f\xa2\x48\xf8\x98\x68\xa0\x40\x63\x7a\x8b\x1d\x03\x6d\x72\x9a\x05\x7b\x98\xeb\x94\x9f\xbb\xca\xe1\xaf\xe4\x28\xdf\x6c\x98\x3c\x50\xd3\xad\xc1\x99\xaf\x3c\x32\xfa\x78\x1b\xaa\x64\x6d\xdd\x1a\xee\x17\x8e\x8c\x75\x73\xda\x1e\xfe\x19\x9d\x9a\xc5\x7d\x00\x00\x00")
 
-func sqlGetcardSqlBytes() ([]byte, error) {
+func sqlRemoveresetsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetcardSql,
-		"sql/getCard.sql",
+		_sqlRemoveresetsSql,
+		"sql/removeResets.sql",
 	)
 }
 
-func sqlGetcardSql() (*asset, error) {
-	bytes, err := sqlGetcardSqlBytes()
+func sqlRemoveresetsSql() (*asset, error) {
+	bytes, err := sqlRemoveresetsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getCard.sql", size: 472, mode: os.FileMode(438), modTime: time.Unix(1428963905, 0)}
+	info := bindataFileInfo{name: "sql/removeResets.sql", size: 125, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetcollectioncontentsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8f\x41\x4b\x03\x31\x10\x85\xcf\x06\xf2\x1f\xe6\x50\x10\xca\xda\xa2\x47\xa1\x87\x52\x23\x1e\xb4\x42\xad\x78\x1e\xb2\xa3\x06\xb3\x89\x9b\x99\x45\xfc\xf7\x4e\xe2\x61\xf7\x94\x47\xe6\x7d\xef\xcd\x6c\xd7\xd6\xec\xfd\x38\x85\x42\x0c\xf2\x49\x10\x51\x88\x05\x58\xf4\x85\xfc\x0e\x08\x13\x53\xb9\x64\xf0\x39\x46\xf2\x12\x72\xda\x58\x63\xcd\x19\xbf\x88\x6f\xad\xb9\xc8\x3f\x89\x0a\x5c\x29\x51\x42\xfa\xe8\x9a\x5d\x93\x50\x40\x27\x0c\x41\xd4\x33\xb3\x0b\xe3\xe2\x53\x7b\x1a\x51\x59\x6b\xd6\xdb\x5a\xf0\xe2\x1e\xdd\xe1\x0c\x1e\x4b\x7f\xc4\x81\x3a\x60\x92\x7f\x31\x4e\x18\x83\xfc\x36\x91\xa4\x29\x9f\x87\x81\x92\x74\xba\x7e\x8d\x8e\xc8\xf2\xfa\xdd\xeb\x09\xd6\xdc\x9f\x9e\x9f\xac\xa9\xc9\xbc\x99\x2b\x0f\x39\x89\x02\x0c\x6f\x0f\xee\xe4\xa0\x1d\xb1\x5b\x5d\xc3\xfe\x78\xb7\x58\x6c\xb7\xba\xf9\x0b\x00\x00\xff\xff\xb9\xca\x61\x15\x21\x01\x00\x00")
+var _sqlRemovesessionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x44\xcd\x4d\x8b\x83\x30\x10\xc6\xf1\xf3\x06\xf2\x1d\x9e\x83\x27\x71\x57\x76\x8f\x0b\x1e\x16\xcc\x52\xe8\x1b\x88\xd0\x43\xe9\x21\xc5\x69\x1b\xac\x49\xc9\xa4\x16\xbf\x7d\xa3\x08\x5e\x67\xfe\xfc\x9e\x3c\x95\xa2\xa2\xce\xf5\xc4\xd0\x78\x78\xd7\x9b\x86\x1a\x30\x31\x1b\x67\x71\x71\x3e\x9e\x9f\x4c\x5e\x0a\x29\x6a\xdd\x12\xff\x4a\xf1\x61\x75\x47\xf8\x04\x07\x6f\xec\x35\x9b\xfe\x08\x37\x1d\xe0\x5e\x96\x61\x42\x4c\x66\x61\x4d\x43\x0c\x8f\xa7\xf3\x10\x28\x8b\x54\xaf\xef\x66\xe1\x5b\x1a\xa4\x48\xf3\xd1\x2e\xd5\x46\xd5\x0a\xff\xd5\x7e\x3b\x79\xfc\x35\x47\x8c\xc3\x4a\x55\x0a\xe3\x66\x91\x7c\xe3\x6f\x57\x62\xc1\x8b\xe4\xe7\x1d\x00\x00\xff\xff\xc3\xcb\x8c\x89\xc3\x00\x00\x00")
 
-func sqlGetcollectioncontentsSqlBytes() ([]byte, error) {
+func sqlRemovesessionSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetcollectioncontentsSql,
-		"sql/getCollectionContents.sql",
+		_sqlRemovesessionSql,
+		"sql/removeSession.sql",
 	)
 }
 
-func sqlGetcollectioncontentsSql() (*asset, error) {
-	bytes, err := sqlGetcollectioncontentsSqlBytes()
+func sqlRemovesessionSql() (*asset, error) {
+	bytes, err := sqlRemovesessionSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getCollectionContents.sql", size: 289, mode: os.FileMode(438), modTime: time.Unix(1429059255, 0)}
+	info := bindataFileInfo{name: "sql/removeSession.sql", size: 195, mode: os.FileMode(438), modTime: time.Unix(1429029700, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetcollectionhistorySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8f\x41\x4b\x03\x31\x14\x84\xcf\x06\xf2\x1f\xe6\x50\x10\xca\xda\xa2\x47\xa1\x87\xa2\x2b\x3d\x68\x85\x5a\xf1\xfc\x48\x9f\x36\x98\x4d\x6c\xde\x5b\xa4\xff\xde\x4d\x2c\xec\xde\x86\x64\x66\xbe\x37\xcb\xb9\x35\x6b\x77\xea\x7d\x66\x81\x1e\x19\x9a\x94\x02\x8e\x5e\x34\xe5\x33\xd2\x27\x08\xbd\x70\xbe\x16\xb8\x14\x02\x3b\xf5\x29\x2e\xac\xb1\x66\x4f\xdf\x2c\xf7\xd6\x5c\xa5\xdf\xc8\x19\x37\x10\xcd\x3e\x7e\x35\xd5\x3e\x54\x91\x62\xf8\x11\x78\x1d\x3c\x63\x76\x62\x9c\x3c\x0e\x9c\x9a\x28\x59\x6b\xe6\xcb\x02\x78\x6b\x9f\xdb\x87\x3d\x1c\xe5\xc3\x96\x3a\x6e\x20\xac\xff\xe2\xd4\x53\xf0\x7a\xae\x22\x6a\x55\x2e\x75\x1d\x47\x6d\x10\xa8\x54\x07\x12\x7d\xff\x39\x90\xb2\x35\x4f\xbb\xd7\x17\x6b\x4a\xb3\x2c\x46\xe4\xe6\xb2\xf0\x63\xd3\xee\x5a\xd4\x0d\xab\xd9\x2d\xd6\xdb\xc7\xc9\x5d\xab\xd9\xdd\x5f\x00\x00\x00\xff\xff\x79\xc1\x1c\x3d\x21\x01\x00\x00")
+var _sqlRemovesessionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x8c\xbd\x0a\xc2\x30\x14\x85\x67\x03\xf7\x1d\xce\xe0\x54\xd4\xe2\x2a\xb8\x79\xc5\xc1\x22\x84\x82\x73\x86\xab\x06\x69\x02\x39\xb1\xc5\xb7\x97\x16\xe7\xef\xa7\x6d\xc4\x79\x1b\xf2\x68\x84\x8d\x56\xbe\xa0\x91\x31\x27\x3c\x72\x41\xc0\x87\x56\xc4\x89\xeb\xc3\xdb\x78\x10\xb7\x4a\x61\x30\x6c\xc1\x5a\x62\x7a\x6e\x16\x8e\xfa\x0a\x15\x79\x4a\x44\xac\xe2\x9a\x76\x0e\x4e\x7a\xd5\x5e\x71\xf6\xb7\x6e\x91\xb8\xfb\x8f\x89\xfb\x45\xbd\x62\x1e\x1d\xd7\xfb\x1f\xed\xda\x63\xfc\x81\x00\x00\x00")
 
-func sqlGetcollectionhistorySqlBytes() ([]byte, error) {
+func sqlRemovesessionsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetcollectionhistorySql,
-		"sql/getCollectionHistory.sql",
+		_sqlRemovesessionsSql,
+		"sql/removeSessions.sql",
 	)
 }
 
-func sqlGetcollectionhistorySql() (*asset, error) {
-	bytes, err := sqlGetcollectionhistorySqlBytes()
+func sqlRemovesessionsSql() (*asset, error) {
+	bytes, err := sqlRemovesessionsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getCollectionHistory.sql", size: 289, mode: os.FileMode(438), modTime: time.Unix(1429059247, 0)}
+	info := bindataFileInfo{name: "sql/removeSessions.sql", size: 129, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetcollectionlistSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x4f\x8b\xc2\x30\x10\xc5\xcf\x3b\x30\xdf\x61\x0e\x7b\x2a\xdd\x2d\x7b\x5d\xf0\x20\x12\xf1\xa0\x08\xb5\xe0\x39\x84\xd1\x04\x6b\xa2\x99\x54\xf1\xdb\x9b\xd4\x43\x7b\x7d\xef\xf7\xfe\x34\x15\xc2\xd2\xdc\x07\x17\x59\x28\x59\x26\xaf\xaf\x4c\xe1\x44\xac\x8d\x25\x13\xfa\x9e\x4d\x72\xc1\x93\xa6\x41\x38\x92\xd5\x82\x80\xd0\xe9\x0b\xcb\x3f\xc2\x57\x78\xfa\xac\xfe\x90\xa4\xe8\xfc\xb9\xfe\x40\xc9\xea\x44\xd9\x11\x72\x29\x33\xb3\x96\x09\x9c\x89\x79\x6d\x4c\x94\x2c\x42\xd5\x94\x81\x83\xda\xaa\x55\x87\x50\xee\xd4\x74\x8b\xee\xa1\xcd\x0b\x61\xdd\xee\x77\x08\x05\x94\xdf\xa9\x41\xe8\xb8\x51\xad\xa2\xf1\xcc\xe2\xfb\xef\x1d\x00\x00\xff\xff\x68\xd5\xb8\xc7\xd5\x00\x00\x00")
+var _sqlRemovesubSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x35\x8e\x31\x0b\xc2\x30\x10\x46\x67\x03\xf9\x0f\xdf\xe0\x54\xb4\xc5\x55\x70\x33\xe2\xa0\x14\x4a\xc1\x39\xb5\xa7\x0d\xb6\x17\xc9\xa5\x16\xff\xbd\x4d\xc1\xed\xe0\x7b\xef\x71\x45\xa6\x55\x45\x83\xff\x90\x20\x76\x04\x19\x1b\xb9\x07\xf7\x8e\xce\x33\x1e\x3e\xc0\x62\x14\x0a\x5a\x69\x55\x72\xff\x45\xeb\x99\x30\x75\xc4\x0b\x9d\xa6\x74\x0c\x42\x7d\x2a\x38\x41\x43\x8e\x9f\x08\x4b\xb2\xcd\x93\x57\xdb\x17\xc9\x5e\xab\x15\xdb\x81\xb0\x85\xc4\x30\x23\x9b\xbf\x6c\x23\xfc\xc4\xb3\x1b\xb5\xca\x8a\x24\x1c\xcd\xc5\xd4\x06\xa7\xaa\xbc\x2e\x90\xe4\xe9\x2b\xdc\xce\xa6\x32\x48\x91\xc3\x7a\xf7\x03\x19\xbb\x75\x35\xb8\x00\x00\x00")
 
-func sqlGetcollectionlistSqlBytes() ([]byte, error) {
+func sqlRemovesubSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetcollectionlistSql,
-		"sql/getCollectionList.sql",
+		_sqlRemovesubSql,
+		"sql/removeSub.sql",
 	)
 }
 
-func sqlGetcollectionlistSql() (*asset, error) {
-	bytes, err := sqlGetcollectionlistSqlBytes()
+func sqlRemovesubSql() (*asset, error) {
+	bytes, err := sqlRemovesubSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getCollectionList.sql", size: 213, mode: os.FileMode(438), modTime: time.Unix(1429212792, 0)}
+	info := bindataFileInfo{name: "sql/removeSub.sql", size: 184, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetcollectionmetaSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x4f\x4b\xc4\x40\x0c\xc5\xcf\x06\xf2\x1d\x72\x58\x10\x96\xba\x8b\x1e\x85\x3d\x2c\x3a\xe2\xc1\x3f\x50\x2b\x9e\xc3\x18\x75\xb0\x9d\xa9\x93\x54\xf1\xdb\x3b\x53\x0f\xed\xf5\xe5\xfd\xde\x2f\xfb\x2d\xc2\xd1\x7f\x4d\x21\x8b\x92\x7d\x08\x0d\x62\xfc\xca\xc6\x94\xde\x88\x69\x52\xc9\xa7\x4a\x3e\xf5\xbd\x78\x0b\x29\xee\x10\x10\x3a\xfe\x14\xbd\x44\x38\x49\x3f\x51\x32\x9d\x91\x5a\x0e\xf1\xbd\x99\xeb\x65\x85\x8d\xca\x45\x29\x58\xe9\x2c\xec\xaa\xb8\x0a\x8b\x67\x26\x2a\x8b\xb0\xdd\x57\xc1\x93\xbb\x73\x57\x1d\x42\xe4\x41\x1a\x9a\x2d\x0d\xf5\xac\xf6\x3c\x96\xdf\x4a\x34\xe6\xf0\xcd\xfe\x17\xe1\xa6\x7d\xbc\x47\xa8\xac\xee\x96\x51\xa5\x97\x5b\xd7\xba\x7f\xf2\xb0\x39\xa7\xe3\xc3\x35\xd5\xb1\xc3\xe6\xe2\x2f\x00\x00\xff\xff\xac\xf3\xba\xe2\xf2\x00\x00\x00")
+var _sqlRemovetotpSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8c\xb1\x0a\xc2\x40\x10\x05\x6b\x17\xf6\x1f\x5e\x61\x15\xd4\x60\x2b\xd8\x79\x62\xa1\x08\x47\xc0\x7a\x89\x1b\x0d\x92\x3b\xb9\x5d\xf5\xf7\x4d\x52\xbc\x6a\x66\x5e\x5d\x31\x45\x1d\xf2\x57\x0d\xfe\x54\x98\xb6\x39\xdd\xd1\x49\xeb\xb9\xa0\x1b\x27\xf8\x98\x16\x26\xa6\x46\x5e\x6a\x3b\xa6\x45\x92\x41\xb1\x86\x79\xe9\xd3\x63\x35\xf3\x31\x16\x47\xfe\x25\x43\xef\x4c\x55\x3d\x05\x87\x70\x0e\x4d\xc0\x31\x5e\x2f\xb3\x64\x1b\xcf\xfe\xc6\xed\x14\x62\xc0\x74\xb2\x5f\x6e\x99\xfe\x48\x10\xcd\xe5\x83\x00\x00\x00")
 
-func sqlGetcollectionmetaSqlBytes() ([]byte, error) {
+func sqlRemovetotpSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetcollectionmetaSql,
-		"sql/getCollectionMeta.sql",
+		_sqlRemovetotpSql,
+		"sql/removeTOTP.sql",
 	)
 }
 
-func sqlGetcollectionmetaSql() (*asset, error) {
-	bytes, err := sqlGetcollectionmetaSqlBytes()
+func sqlRemovetotpSql() (*asset, error) {
+	bytes, err := sqlRemovetotpSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getCollectionMeta.sql", size: 242, mode: os.FileMode(438), modTime: time.Unix(1429212867, 0)}
+	info := bindataFileInfo{name: "sql/removeTOTP.sql", size: 131, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetresetSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x90\x4f\x4b\x03\x31\x10\xc5\xcf\x06\xf2\x1d\xe6\xd0\x83\x96\x6d\x8b\x1e\x85\x0a\x45\x57\x04\xff\x41\x2d\xf6\x20\x1e\xa6\x9b\x69\x1b\x76\x37\xd1\x24\xbb\xcb\x7e\x7b\x27\x59\xdb\xea\x2d\x43\xde\xef\xbd\x37\x33\x1b\x4b\xb1\x28\xbe\x1b\xed\xc8\x43\xd8\x13\x50\x4b\xae\x07\x9e\x28\x40\x49\x3d\x6c\xad\x03\x84\x2f\x67\x5b\xad\x48\x41\xe3\xc9\xb1\x0e\x03\xd4\x18\x8a\x3d\x79\x29\x22\x75\xfc\x3f\x81\x68\x14\x68\x0f\x2d\x56\x5a\x4d\xa5\x90\x62\xc5\xba\x2d\x16\x61\xc0\x11\x9c\xed\xa2\xc0\x51\x68\x9c\x61\xb4\x26\x34\x7e\xf8\xfc\x67\xe9\xc9\x7b\x6d\xcd\x2c\x46\x4b\x51\xd8\x7a\x63\x4f\xc6\xb0\x26\xf0\x41\x57\x15\x70\x99\xa2\x04\x6d\x12\x5c\x6b\xa5\x2a\xea\xd0\x11\x8f\xb6\xd9\xed\x87\x06\x58\x92\xbf\x96\xe2\xcc\x60\x4d\x30\x61\xd0\x69\xb3\xcb\xfe\x2c\x65\x3b\xae\xa0\x03\x4b\x7e\x53\x1f\x79\x93\x09\x7c\x7c\x6e\xfa\x40\x19\x97\x4e\xa9\x87\x4a\x71\x4f\x29\xc6\xb3\xe8\xfd\x96\x3f\xe5\xb7\x2b\x88\xce\xd9\x70\x05\x46\x33\x8e\x40\x17\xde\x23\x94\x01\x19\x95\x5e\x52\xdc\x2f\x5f\x9f\x53\xaa\x9f\x26\x29\x5f\x71\xfd\x90\x2f\xf3\x84\xcf\x47\x97\xb0\x78\xb9\x3b\x9a\xcc\x47\x57\x69\x3e\xe0\x70\x03\xc6\x76\xe7\x17\x3f\x01\x00\x00\xff\xff\xc3\xa7\x47\xc9\xbb\x01\x00\x00")
+var _sqlRemovetradesSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x25\x8d\xb1\x0a\xc2\x40\x10\x05\x6b\x17\xf6\x1f\x5e\x61\x15\xd4\x60\x2b\xd8\x79\xc1\x22\x41\x38\x02\xd6\x27\x59\xcc\x21\x49\xe0\x76\x13\xf1\xef\x8d\x67\x3d\xf3\xe6\x95\x05\x93\x97\x61\x5a\x44\x21\x8b\xa4\x0f\x2c\x85\x4e\x10\x30\xab\x24\xf4\x41\xa1\xf3\x63\x88\x66\xd2\x31\x31\xb5\xe1\x25\x7a\x62\xda\x4c\xef\x71\xe5\x7b\xa8\xa5\x38\x3e\x77\x7f\xdd\xfa\x60\x58\x89\x22\x1a\x53\x51\xfe\x16\x17\x57\xbb\xd6\xa1\xf2\xb7\x26\x4b\x7a\xc8\x0f\x8a\xfb\xd5\x79\x87\xdc\x39\x6f\x8f\x5f\xf7\x66\x23\xc8\x89\x00\x00\x00")
 
-func sqlGetresetSqlBytes() ([]byte, error) {
+func sqlRemovetradesSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetresetSql,
-		"sql/getReset.sql",
+		_sqlRemovetradesSql,
+		"sql/removeTrades.sql",
 	)
 }
 
-func sqlGetresetSql() (*asset, error) {
-	bytes, err := sqlGetresetSqlBytes()
+func sqlRemovetradesSql() (*asset, error) {
+	bytes, err := sqlRemovetradesSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getReset.sql", size: 443, mode: os.FileMode(438), modTime: time.Unix(1428964012, 0)}
+	info := bindataFileInfo{name: "sql/removeTrades.sql", size: 137, mode: os.FileMode(438), modTime: time.Unix(1792416657, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetsessionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x90\x4f\x4b\xf3\x40\x10\xc6\xcf\xef\xc2\x7e\x87\xe7\xd0\xc3\x6b\x49\x5b\xf4\x28\x54\x28\x1a\x11\xfc\x07\xb5\xe8\x41\x3c\x4c\x93\xa9\x5d\x6a\x76\x75\x67\x93\x90\x6f\xef\x6e\x5a\x35\x88\xb7\x61\xe7\xf9\xfd\xe6\x61\x67\x63\xad\x16\xc5\x47\x6d\x3c\x0b\xc2\x96\xc1\x0d\xfb\x0e\xc2\x22\xc6\x59\xec\xb8\xc3\xc6\x79\x10\xde\xbd\x6b\x4c\xc9\x25\x6a\x61\x1f\x93\x14\x50\x51\x28\xb6\x2c\x5a\x25\xee\x7b\x3f\x44\xc9\x96\x30\x82\x86\xde\x4c\x39\xd5\x4a\xab\x55\x4c\x6e\xa8\x08\x7b\x01\xc1\xbb\x36\x05\x3c\x87\xda\xdb\x08\x57\x4c\x56\xf6\xcb\xbf\xa4\xb3\x74\x5c\xab\xc2\x55\x6b\xf7\x4b\x4c\x3b\x96\x53\xad\xfe\x59\xaa\x18\x13\x48\xf0\xc6\xbe\x66\x83\xb6\xae\x8d\x66\x13\x62\xe4\x20\xbb\x8e\x05\x27\x78\x7e\x59\x77\x81\xb3\xd8\xa5\x97\x0d\xeb\x6b\x35\x9e\x25\xf7\x43\x7e\x93\x9f\xaf\x90\xcc\x19\x7e\xe0\x38\x07\xf2\xe1\x31\x61\x19\xd8\x96\xfd\xa4\xd5\xe5\xf2\xfe\x56\xab\x74\x58\xa6\x87\x74\xfc\xa3\xa7\xab\x7c\x99\xf7\x8e\xf9\xe8\x18\x8b\xbb\x8b\x81\x69\x3e\x3a\xe9\x5f\xbe\x1c\x38\x83\x75\xed\xff\xa3\xcf\x00\x00\x00\xff\xff\x33\xa1\xf1\x1e\x9d\x01\x00\x00")
+var _sqlRemoveuserSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x8c\xb1\x0a\xc2\x30\x14\x45\x67\x03\xf9\x87\x3b\x38\x95\x6a\x71\x15\xdc\x8c\x38\x28\x42\x28\x38\x47\xbd\xb5\x45\xd2\x42\xde\x6b\xc1\xbf\xb7\x29\x6e\x17\xee\x39\xa7\x2a\xac\xf1\x8c\xc3\x44\x41\xc0\x28\x4c\x25\x38\x31\x7d\xb5\xed\xfa\x37\x12\x1b\x26\xf6\xcf\xbc\xb5\x65\x44\x1c\x45\xf1\xe0\x7c\x64\xe7\x85\xa6\x4b\xa2\xd6\x58\x53\x87\x0f\x65\x6f\xcd\xaa\x0f\x91\xd8\x40\x34\xcd\x52\xb9\x24\xa1\xc3\x5f\xb0\xa6\xa8\x32\x7d\x74\x17\x57\x3b\x9c\xfc\xed\xba\x10\xb2\x8d\xd4\x80\xfb\xd9\x79\x87\x5c\x38\xac\x77\x3f\x60\x39\xed\x29\x9b\x00\x00\x00")
 
-func sqlGetsessionsSqlBytes() ([]byte, error) {
+func sqlRemoveuserSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetsessionsSql,
-		"sql/getSessions.sql",
+		_sqlRemoveuserSql,
+		"sql/removeUser.sql",
 	)
 }
 
-func sqlGetsessionsSql() (*asset, error) {
-	bytes, err := sqlGetsessionsSqlBytes()
+func sqlRemoveuserSql() (*asset, error) {
+	bytes, err := sqlRemoveuserSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getSessions.sql", size: 413, mode: os.FileMode(438), modTime: time.Unix(1428963981, 0)}
+	info := bindataFileInfo{name: "sql/removeUser.sql", size: 155, mode: os.FileMode(438), modTime: time.Unix(1792415586, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetsubSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1c\x8e\xc1\x4a\x43\x31\x10\x45\xd7\x06\xf2\x0f\x77\xe1\xaa\x44\x8b\x5b\xc1\x85\xd4\x88\x82\xa2\xb4\x0f\x5c\x4f\x1f\xa3\x1d\x34\x89\xce\x4c\xec\xef\xf7\xa5\xab\x81\xe1\x9c\xc3\x5d\xaf\x62\xb8\x9f\xff\xba\x28\x1b\x08\xd6\xf7\x36\xab\xfc\xba\xb4\x8a\x4f\x6d\x05\x7e\x60\x18\xeb\x3f\x2b\x8e\xe2\x07\xd4\x06\xea\xcb\xb3\xba\xcc\x34\xb0\x18\x62\x98\xe8\x9b\xed\x36\x86\x8b\x4a\x85\x71\x05\x73\x95\xfa\x95\xd0\x17\x73\x29\x90\xa3\x1d\xab\x41\x3c\x86\xd5\x7a\x08\xbb\xfc\x92\x37\x13\x06\x9e\xf0\xfe\x43\x35\x61\xd3\xcd\x5b\x61\x7d\x7e\x48\xd8\xf5\xfd\xf9\x38\xa9\x4f\x52\x38\x86\xc7\xed\xdb\x6b\x0c\xa3\x67\xd7\x63\x24\x3e\x9e\xf2\x36\x9f\x03\x77\x97\x37\xa7\x00\x00\x00\xff\xff\x60\x75\xd1\x8c\xc6\x00\x00\x00")
+var _sqlRemoveverificationsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8d\x31\x0b\xc2\x30\x14\x84\x67\x03\xf9\x0f\x37\x38\x95\x6a\x71\x15\xdc\x8c\x38\x28\x42\x28\x38\x3f\xf4\x55\x1f\xb5\x09\xe4\xc5\x88\xff\xde\xb6\x93\xcb\x0d\xc7\xf7\xdd\x35\x95\x35\x9e\x87\x58\x58\xc1\x85\xd3\x17\x63\x48\x27\x37\xca\x12\x03\xba\x98\x40\x78\x2b\xa7\x1a\x85\x5e\x72\xc7\x58\x84\x98\xad\xb1\xa6\xa5\x9e\x75\x6b\xcd\x22\xd0\xc0\x58\x41\x73\x92\xf0\xa8\x67\x1a\xf9\x49\x19\xf1\x13\x14\x32\xc2\x55\x33\x09\x7b\x77\x72\xad\xc3\xc1\x5f\xce\x33\xa4\xeb\xff\x2f\xc5\xf5\xe8\xbc\xc3\xb4\xb6\x5b\x6e\xac\xf9\x01\x65\x79\x65\x9a\x9b\x00\x00\x00")
 
-func sqlGetsubSqlBytes() ([]byte, error) {
+func sqlRemoveverificationsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetsubSql,
-		"sql/getSub.sql",
+		_sqlRemoveverificationsSql,
+		"sql/removeVerifications.sql",
 	)
 }
 
-func sqlGetsubSql() (*asset, error) {
-	bytes, err := sqlGetsubSqlBytes()
+func sqlRemoveverificationsSql() (*asset, error) {
+	bytes, err := sqlRemoveverificationsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getSub.sql", size: 198, mode: os.FileMode(438), modTime: time.Unix(1431384383, 0)}
+	info := bindataFileInfo{name: "sql/removeVerifications.sql", size: 155, mode: os.FileMode(438), modTime: time.Unix(1792413950, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlGetuserSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x24\x8d\x31\x4b\x04\x31\x10\x46\x6b\x03\xf9\x0f\x5f\x61\x75\x44\x0f\x5b\xc1\x42\x64\xc5\x42\x11\xce\x03\xeb\x21\x8c\x97\xc1\xcd\x44\x77\x66\x6f\xfd\xf9\x26\x5a\x7e\x8f\x99\xf7\xf6\xbb\x18\xee\xf3\xf7\x2a\x0b\x1b\x08\xab\xf1\x82\x8f\xa5\x55\x78\x61\xf4\x71\xee\x7b\x13\x2f\xd0\x06\x5a\x3b\x54\x97\x4c\x2e\x4d\x63\x88\xe1\x48\x9f\x6c\xb7\x31\x5c\x28\x55\xc6\x15\xcc\x17\xd1\x53\xfa\xd7\x78\x21\x47\xdb\xd4\x20\x1e\xc3\x6e\x3f\x1e\xde\xa6\xe7\xe9\xe1\x88\x71\x9e\xc0\x95\x64\x4e\xf8\x22\xb3\x42\x56\x52\x6f\x68\xee\xbc\xd2\x4f\x6e\xf3\xcc\x79\x64\x2c\x61\x6e\x7a\x62\xf3\xb3\xf0\x16\xc3\xe3\xe1\xf5\x25\x86\x11\xb0\xeb\xca\x4e\x78\x7f\x9a\x0e\xd3\x9f\xf1\xee\xf2\xe6\x37\x00\x00\xff\xff\xd6\xc1\x75\xc2\xcf\x00\x00\x00")
+var _sqlSearchusersSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8f\x4f\x4f\x83\x40\x10\xc5\xcf\x6e\xb2\xdf\xe1\x1d\x7a\x6a\x90\x46\x8f\x26\x1e\xd4\xae\x11\xa5\x25\x41\x92\xc6\xe3\x52\xa6\xb2\x91\x85\xba\x3b\x68\xfc\xf6\xb2\x80\xb6\xb7\xf9\xf3\x9b\xf7\xde\xac\x96\x52\xdc\xed\x3f\x7b\xe3\xc8\xa3\xf7\xe4\x3c\xbe\xeb\xce\x13\x5a\x6d\x09\x9d\x03\x59\x6d\x1a\x58\xcd\xfb\x1a\x1a\x47\xcd\x4c\xae\x8d\x86\x4d\x45\x8e\x2a\x94\x3f\x23\x29\x85\x14\x85\xfe\x20\x7f\x23\xc5\xc5\x0c\xe1\x12\x9e\x9d\x69\xdf\x23\xe8\x16\x49\x9a\xbc\xa8\xbf\xfb\x01\x6a\x8c\x35\x3c\x20\xa6\xe5\x08\x5c\x13\x6c\xe7\x79\x4e\xc0\x1d\x1c\x71\x1f\xb8\xe5\x2a\x48\xbf\xaa\x54\x3d\x14\xb0\xc4\x3a\x0e\x76\xd1\x54\x8e\xd9\xe6\xfa\x8b\x9c\x39\x18\xaa\xe6\xb6\x32\x5e\x97\x4d\x68\x7d\x5f\xfa\xf8\xd8\xe8\x41\xec\x31\xcf\x36\x52\x8c\x1e\x71\xa0\xf0\x9c\x25\xdb\xc9\x33\x0e\x18\xb2\xed\x84\x8f\xcf\xdf\x9e\xfc\xa4\xd8\x3d\xa9\x5c\x9d\x06\xf3\x3b\x8b\x2b\x64\xf9\x59\x96\xff\xb1\x14\x59\xbe\x56\x39\xee\xdf\xce\x45\xd2\x64\x93\x14\x58\x5c\xff\x02\x7b\x04\x0c\x24\x75\x01\x00\x00")
 
-func sqlGetuserSqlBytes() ([]byte, error) {
+func sqlSearchusersSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlGetuserSql,
-		"sql/getUser.sql",
+		_sqlSearchusersSql,
+		"sql/searchUsers.sql",
 	)
 }
 
-func sqlGetuserSql() (*asset, error) {
-	bytes, err := sqlGetuserSqlBytes()
+func sqlSearchusersSql() (*asset, error) {
+	bytes, err := sqlSearchusersSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/getUser.sql", size: 207, mode: os.FileMode(438), modTime: time.Unix(1431477671, 0)}
+	info := bindataFileInfo{name: "sql/searchUsers.sql", size: 373, mode: os.FileMode(438), modTime: time.Unix(1792416032, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlModsubSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x51\x41\x6f\xea\x30\x0c\x3e\x3f\x24\xfe\x83\x0f\x48\x05\xd4\x07\x7a\x6f\xdb\x65\x1c\x19\x87\x49\x3b\x4c\x6b\x77\x9b\x34\xa5\xc4\x94\x88\x34\xae\x6a\x07\xc4\xbf\x9f\x13\x40\x9a\x34\xed\x90\xc6\x76\xbe\xef\xb3\xfd\x75\x39\x1f\x8f\xc6\xa3\xf7\xd7\x6a\xf3\x56\x33\xb8\x20\x04\x91\x71\xe0\x05\xc7\x86\x35\x74\xa1\x05\xd9\x23\x74\x64\x3f\xb5\x04\xbb\x18\xb6\xe2\x28\x2c\x12\x6d\x4d\xd1\x5b\xe8\x49\x30\x88\x33\xde\x9f\xc1\x13\xf5\xb0\xa3\x01\x8f\x38\x40\x13\x05\x5a\x22\xab\x1f\x0b\x96\x90\x15\xca\xd2\x0e\x1a\x04\x44\xab\xba\x4e\x23\x23\xee\x88\xfe\x9c\x05\x6f\x5d\xf6\x86\x73\x57\x55\xea\x8c\x8c\x47\x7f\xae\x0f\xd3\x22\x09\x7b\xd3\x16\x25\x14\x15\x06\x46\xf7\x51\x30\xd4\xd4\x6b\x21\xd0\xa9\x54\x68\xc1\xd4\xe1\x3a\xb2\xe8\x35\xd4\x74\xc0\x90\xc0\xa9\x58\xc5\xe6\x92\xcf\x56\xa9\x59\x6d\x0e\xc8\x8f\xca\x08\xa6\x43\xf8\x0b\x2c\x83\x6e\x5b\xe6\xfd\xb5\xbb\x11\xa0\x53\x50\x4f\x52\xff\xde\x9b\xa0\x10\x9d\x9f\x5d\xe3\x93\x52\x99\x07\xcc\xf5\xcc\x4f\x59\x66\x5a\x64\xa7\x2b\x2a\x49\x5c\xd6\x4d\x17\x8b\xe9\xfa\x0b\xc5\x1b\xd1\x14\xb6\x7b\x13\x5a\x54\xd4\xf6\x3a\xea\xf3\xd3\xb7\x19\x12\xf0\xf6\xa0\x23\x58\x50\x43\xfa\x81\x8e\xce\xaa\x6f\xcd\x39\xe3\xfa\xc4\x56\x53\x7e\x10\x93\x83\xbf\x53\xe6\xcb\xb4\x7c\xb5\x79\xd9\xac\xeb\xdb\x6f\x9d\x4e\xfe\x95\x30\xf9\xaf\xe7\x4e\xcf\xbd\x9e\x87\xd9\xea\x2b\x00\x00\xff\xff\x23\x93\xa7\xaf\x1b\x02\x00\x00")
+var _sqlSetcollectionpermissionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x41\x4b\x03\x41\x0c\x85\xcf\x0e\xcc\x7f\x78\x87\x3d\x15\xb5\xa8\x37\x61\x0f\x85\x2e\x78\x92\xa2\x5b\x3d\xa7\xdb\x60\x83\xdb\x99\x65\x92\x6e\xf1\xdf\x9b\x51\xc1\x42\xc8\x21\xef\x7b\x2f\x6f\xb9\x88\x61\x3b\xed\xc9\x58\x41\x18\xf2\x38\xf2\x60\x92\x13\x7c\xec\xc0\x70\x85\x76\xa4\x0c\xcb\x38\xd0\xcc\xbf\x47\x56\x29\xbc\xc7\xc4\xe5\x28\xaa\x8e\x6b\x0c\x31\xf4\xf4\xc9\xfa\x18\xc3\x55\xa2\x23\xe3\x06\x6a\x45\xd2\xc7\x35\x4e\xca\xc5\x7d\x64\xc8\xe7\xa4\x10\x73\x64\x3a\xed\x46\x19\xde\x84\xcf\x8e\x5c\xb0\x84\x99\x46\xf1\xe8\x22\x33\x0d\x5f\x50\x36\x73\xa1\xc6\x2f\x96\x75\x6f\x37\xeb\x55\xdf\xfd\x64\xea\xed\x7f\x5f\x2f\xf0\xda\xf5\xd8\xfc\xd9\x5a\x34\x0f\x31\xbc\x3f\x75\x2f\x5d\x7d\xca\xa5\x6d\xee\xb0\x7a\x5e\xa3\x56\x6b\x9b\xfb\xef\x00\x00\x00\xff\xff\xcb\xb3\x51\x19\xf7\x00\x00\x00")
 
-func sqlModsubSqlBytes() ([]byte, error) {
+func sqlSetcollectionpermissionsSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlModsubSql,
-		"sql/modSub.sql",
+		_sqlSetcollectionpermissionsSql,
+		"sql/setCollectionPermissions.sql",
 	)
 }
 
-func sqlModsubSql() (*asset, error) {
-	bytes, err := sqlModsubSqlBytes()
+func sqlSetcollectionpermissionsSql() (*asset, error) {
+	bytes, err := sqlSetcollectionpermissionsSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/modSub.sql", size: 539, mode: os.FileMode(438), modTime: time.Unix(1431382801, 0)}
+	info := bindataFileInfo{name: "sql/setCollectionPermissions.sql", size: 247, mode: os.FileMode(438), modTime: time.Unix(1429074766, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlRemovesessionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x44\xcd\x4d\x8b\x83\x30\x10\xc6\xf1\xf3\x06\xf2\x1d\x9e\x83\x27\x71\x57\x76\x8f\x0b\x1e\x16\xcc\x52\xe8\x1b\x88\xd0\x43\xe9\x21\xc5\x69\x1b\xac\x49\xc9\xa4\x16\xbf\x7d\xa3\x08\x5e\x67\xfe\xfc\x9e\x3c\x95\xa2\xa2\xce\xf5\xc4\xd0\x78\x78\xd7\x9b\x86\x1a\x30\x31\x1b\x67\x71\x71\x3e\x9e\x9f\x4c\x5e\x0a\x29\x6a\xdd\x12\xff\x4a\xf1\x61\x75\x47\xf8\x04\x07\x6f\xec\x35\x9b\xfe\x08\x37\x1d\xe0\x5e\x96\x61\x42\x4c\x66\x61\x4d\x43\x0c\x8f\xa7\xf3\x10\x28\x8b\x54\xaf\xef\x66\xe1\x5b\x1a\xa4\x48\xf3\xd1\x2e\xd5\x46\xd5\x0a\xff\xd5\x7e\x3b\x79\xfc\x35\x47\x8c\xc3\x4a\x55\x0a\xe3\x66\x91\x7c\xe3\x6f\x57\x62\xc1\x8b\xe4\xe7\x1d\x00\x00\xff\xff\xc3\xcb\x8c\x89\xc3\x00\x00\x00")
+var _sqlSetdisabledSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x8e\xc1\x0a\xc2\x30\x0c\x86\xcf\x06\xfa\x0e\x39\x0c\x84\xb1\x39\xf4\x28\xec\x20\x58\xf0\x28\x3a\xf1\xdc\xb1\xb8\x16\xb7\x16\x9a\xc8\xf0\xed\xed\x54\x76\xc9\x7f\xf8\x93\xef\x4b\x95\x2b\xb8\x92\x30\x4e\x96\xc4\x52\x44\x83\x2f\x4e\xe1\x18\x5b\x13\x23\x75\xf8\x88\x61\xc4\x21\xf4\xbd\xf3\x3d\x3a\xaf\x40\x41\x63\x9e\xc4\x7b\x05\x2b\x6f\x46\xc2\x12\x59\x62\x2a\x8b\xdf\xa5\x58\x23\x18\x26\xcf\xe8\x24\xad\x74\x8e\x4d\x3b\x24\x4e\x89\x6d\x08\x43\xb1\x88\xd2\x78\xaf\x23\xfd\x35\x0a\xf2\x6a\x46\xdf\xce\xc7\x43\xa3\xbf\x24\xde\x8c\x24\x26\xbd\xa7\x1b\x5c\x28\x35\x66\x3b\x05\xf7\x93\xbe\x68\x05\xb3\xbe\xce\xb6\x1f\xd8\xd4\x69\x94\xc4\x00\x00\x00")
 
-func sqlRemovesessionSqlBytes() ([]byte, error) {
+func sqlSetdisabledSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlRemovesessionSql,
-		"sql/removeSession.sql",
+		_sqlSetdisabledSql,
+		"sql/setDisabled.sql",
 	)
 }
 
-func sqlRemovesessionSql() (*asset, error) {
-	bytes, err := sqlRemovesessionSqlBytes()
+func sqlSetdisabledSql() (*asset, error) {
+	bytes, err := sqlSetdisabledSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/removeSession.sql", size: 195, mode: os.FileMode(438), modTime: time.Unix(1429029700, 0)}
+	info := bindataFileInfo{name: "sql/setDisabled.sql", size: 196, mode: os.FileMode(438), modTime: time.Unix(1792416032, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlSetcollectionpermissionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8e\x41\x4b\x03\x41\x0c\x85\xcf\x0e\xcc\x7f\x78\x87\x3d\x15\xb5\xa8\x37\x61\x0f\x85\x2e\x78\x92\xa2\x5b\x3d\xa7\xdb\x60\x83\xdb\x99\x65\x92\x6e\xf1\xdf\x9b\x51\xc1\x42\xc8\x21\xef\x7b\x2f\x6f\xb9\x88\x61\x3b\xed\xc9\x58\x41\x18\xf2\x38\xf2\x60\x92\x13\x7c\xec\xc0\x70\x85\x76\xa4\x0c\xcb\x38\xd0\xcc\xbf\x47\x56\x29\xbc\xc7\xc4\xe5\x28\xaa\x8e\x6b\x0c\x31\xf4\xf4\xc9\xfa\x18\xc3\x55\xa2\x23\xe3\x06\x6a\x45\xd2\xc7\x35\x4e\xca\xc5\x7d\x64\xc8\xe7\xa4\x10\x73\x64\x3a\xed\x46\x19\xde\x84\xcf\x8e\x5c\xb0\x84\x99\x46\xf1\xe8\x22\x33\x0d\x5f\x50\x36\x73\xa1\xc6\x2f\x96\x75\x6f\x37\xeb\x55\xdf\xfd\x64\xea\xed\x7f\x5f\x2f\xf0\xda\xf5\xd8\xfc\xd9\x5a\x34\x0f\x31\xbc\x3f\x75\x2f\x5d\x7d\xca\xa5\x6d\xee\xb0\x7a\x5e\xa3\x56\x6b\x9b\xfb\xef\x00\x00\x00\xff\xff\xcb\xb3\x51\x19\xf7\x00\x00\x00")
+var _sqlSetemailSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8e\x41\x4b\xc3\x40\x10\x85\xcf\x0e\xcc\x7f\x78\x87\x82\x50\xa2\xa5\x1e\x0b\x39\x08\x06\x3c\x96\x9a\xe0\x79\xec\x4e\x9b\x25\xcd\x6e\xd9\xd9\x56\xfc\xf7\x26\x5b\xa9\xc7\x61\xbe\xf7\xde\xb7\x5a\x32\x75\x67\x27\x59\x0d\x82\x8b\x69\x7a\x34\xe8\x28\xfe\x84\x18\x90\x7b\xc5\xf4\x93\x2f\x31\x85\x04\x87\x51\xd2\x60\xf0\x19\x57\x4d\xfe\xe0\xd5\x31\x31\xb5\x32\xa8\x6d\x98\x1e\x82\x8c\x8a\x27\x58\x4e\x3e\x1c\xab\xd2\x36\x55\x48\x46\xfc\x0e\x73\x6a\x42\x6e\xd5\xff\x8c\x04\x88\x73\x49\xcd\xca\x58\x89\xf4\x62\x38\xa7\x78\xd5\x22\xf0\x83\x7d\x0c\x39\xc5\x13\xd3\x72\x35\xcf\x75\xdb\xb7\xd7\xb6\x29\xa8\x3d\x8f\x9a\x85\xe9\xa3\x69\xff\xa4\x6b\x2c\x5e\xaa\xbb\xdd\x74\xe6\x74\x51\xa6\xcf\xf7\x66\xd7\x30\xcd\x82\xf5\x62\xcd\xf4\x0b\xc2\xf4\xed\x85\xf7\x00\x00\x00")
 
-func sqlSetcollectionpermissionsSqlBytes() ([]byte, error) {
+func sqlSetemailSqlBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlSetcollectionpermissionsSql,
-		"sql/setCollectionPermissions.sql",
+		_sqlSetemailSql,
+		"sql/setEmail.sql",
 	)
 }
 
-func sqlSetcollectionpermissionsSql() (*asset, error) {
-	bytes, err := sqlSetcollectionpermissionsSqlBytes()
+func sqlSetemailSql() (*asset, error) {
+	bytes, err := sqlSetemailSqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/setCollectionPermissions.sql", size: 247, mode: os.FileMode(438), modTime: time.Unix(1429074766, 0)}
+	info := bindataFileInfo{name: "sql/setEmail.sql", size: 247, mode: os.FileMode(438), modTime: time.Unix(1792413950, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSetlocaleSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x45\x8e\xbb\x0a\xc2\x40\x10\x45\x6b\x17\xf6\x1f\x6e\x91\x2a\x18\x83\x96\x42\x0a\xc1\x80\xa5\x68\xc4\x7a\x8c\x83\x59\x4c\x76\x65\x67\x42\xc0\xaf\x37\xf1\x81\xfd\xb9\xf7\x9c\x3c\xb5\xe6\xc8\x2a\xd0\x86\xd1\x86\x9a\x5a\x46\x47\xae\x85\x06\x10\x7a\xe1\x08\x27\x18\xa2\x53\x65\x0f\xe7\xad\xb1\xa6\xa2\x3b\xcb\xda\x9a\x99\xa7\x8e\x91\x41\x34\x3a\x7f\x9b\x7f\x68\x6d\x48\x11\x06\x2f\x70\x3a\x22\xdf\xcb\x3f\x44\xf0\x21\x76\xd4\xba\x27\x5f\x7f\x42\xe9\xeb\x06\x24\x18\x0d\x21\xe2\xa1\xd9\x25\x5a\x93\xe6\x93\xeb\xb4\xdf\x6e\xaa\xf2\x7d\x2d\x8b\x8e\x95\xc6\xdc\xb2\xfa\x0d\x0b\x24\x2b\x6b\xce\xbb\xf2\x50\x5a\x33\xd5\x14\xc9\xf2\x05\x85\x69\x90\xec\xd2\x00\x00\x00")
+
+func sqlSetlocaleSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSetlocaleSql,
+		"sql/setLocale.sql",
+	)
+}
+
+func sqlSetlocaleSql() (*asset, error) {
+	bytes, err := sqlSetlocaleSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/setLocale.sql", size: 210, mode: os.FileMode(438), modTime: time.Unix(1792415412, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSetlockoutSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8e\x41\x6b\xc2\x40\x10\x85\xcf\x5d\xd8\xff\xf0\x0e\x81\x80\x68\xa5\xf6\x56\xc8\x21\x60\xc0\x53\x91\x1a\xf1\xbc\x35\xa3\x0e\xc9\x4e\xc2\xee\xa6\xe2\xbf\xef\x6e\x10\xe2\x6d\x98\xf9\xe6\xbd\x6f\xbd\xd0\x6a\xef\xe8\x8f\x24\x78\x18\x79\xe0\x32\xba\x70\x23\x07\x13\x02\xd9\x21\x2e\x7f\x89\xe5\x8a\x73\x2f\x9e\x1b\x72\xd4\x60\x94\xc0\x1d\x0c\x02\x5b\xd2\x4a\xab\xda\xb4\xe4\xbf\xb4\x7a\x6b\x59\x1a\xac\xe0\x83\x8b\x1f\x4b\x10\x4f\x41\xb9\x18\x4b\x39\xfa\x38\xf1\x90\x47\x2c\xc6\xc4\x84\x0b\xc7\xdb\x0c\x47\x14\x09\x4c\x1c\x0f\x91\xea\xfa\x73\x4b\xcd\x71\xea\x5a\x4d\x5d\x3e\x18\x3b\x2c\x71\xbf\x91\xcc\x76\xd6\x3c\xe0\xc8\x8f\x49\x65\xb1\x4e\x3a\xc7\xfd\xb6\xac\x2b\x8c\x9e\x9c\x7f\xef\xfa\x2b\x4b\xf9\x84\xb5\x3a\x54\x35\x5e\x83\x0b\x64\x9f\x5a\x9d\x76\xd5\x4f\x85\x64\x5f\x64\x1f\x28\xbf\xb7\x98\x15\x8b\x6c\xa3\xd5\x3f\xbe\xd0\x22\x27\x25\x01\x00\x00")
+
+func sqlSetlockoutSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSetlockoutSql,
+		"sql/setLockout.sql",
+	)
+}
+
+func sqlSetlockoutSql() (*asset, error) {
+	bytes, err := sqlSetlockoutSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/setLockout.sql", size: 293, mode: os.FileMode(438), modTime: time.Unix(1792414359, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -490,7 +1836,7 @@ func sqlSetmaxcollectionsSql() (*asset, error) {
 	return a, nil
 }
 
-var _sqlSetpasswordSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x8f\x41\x4b\xc3\x40\x10\x85\xcf\x0e\xcc\x7f\x78\x87\x80\x5a\xa2\x45\xbd\x09\x39\x08\x06\x7a\x14\x4d\xf1\x3c\x6d\xa6\x36\x68\x77\xc3\xce\xd4\xd2\x7f\xef\x6e\xb4\x42\x6f\xcb\xee\xf7\xde\xf7\x76\x3e\x63\x5a\x8e\xbd\xb8\x1a\x04\x7b\xd3\x74\x69\x18\xc5\xec\x10\x53\x8f\x18\xe0\x5b\x45\x7e\x96\x95\x98\x32\x31\x75\xf2\xa9\xf6\xc8\x74\x11\x64\xa7\xb8\x81\x79\x1a\xc2\x47\x3d\x45\x33\x2c\x8e\x78\x08\x86\xc1\x33\x52\x7a\x16\x62\xdb\x8c\xad\x8e\xae\x52\x4f\x6d\x49\x6d\xff\x95\xb1\x0d\x6c\x9d\x8e\xa3\x5f\x9d\x74\x35\x42\x0c\x6b\xbd\x2e\xe5\xe5\x70\x1e\xfb\xbd\xca\x9a\x1e\x9b\x98\xd0\x6b\x1a\xbe\xb3\x19\x27\x09\xd3\x6c\x5e\x06\x2e\x5f\x9e\x9f\xba\x76\xda\x63\xb7\x3b\x75\x61\x7a\x6b\xbb\x7f\x0c\x0d\xaa\xfb\x3f\x53\x53\x3d\x30\xbd\x2f\xda\xd7\x96\xa9\x7c\xa7\xa9\xee\x7e\x02\x00\x00\xff\xff\xa7\x37\x03\x88\x10\x01\x00\x00")
+var _sqlSetpasswordSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x90\xcd\x6a\xc3\x30\x10\x84\xcf\x15\xe8\x1d\xe6\x60\x28\x04\xb7\xa1\x3f\xa7\x80\x0f\x85\x1a\x72\x0c\xad\x43\xcf\x9b\x68\x13\x9b\xc6\x52\xd0\x2a\x35\x7d\xfb\xae\x6c\x1a\xb7\xa7\x85\xd5\x7c\x33\xb3\x5a\x2e\xac\xd9\x9e\x1d\x25\x16\x10\x2e\xc2\xf1\x56\x70\x26\x91\x21\x44\x87\xe0\x91\x5a\x86\x3e\xd3\x8e\x84\xad\xb1\xa6\xa1\x4f\x96\x95\x35\x37\x9e\x7a\xc6\x1d\x24\xc5\xce\x1f\xcb\x11\x55\x31\x25\x84\xc1\x0b\xba\xa4\x92\xec\xb3\x26\x69\x55\xb6\xfb\x4e\x4c\xe5\xe8\x16\x59\x2e\x27\x95\x1d\xe0\x38\x76\x5f\x4a\x8f\xeb\x6b\xe8\xd0\xa5\x16\x3e\xf8\x3d\xe7\x94\x3c\xff\xf3\xd3\x4a\xf3\x1c\x0e\x21\xce\x26\xbf\x69\x4a\xb5\x3a\x36\x14\xa9\x97\x3f\x0d\x33\x4b\xa7\x63\x88\xea\xdf\x83\xbc\xc3\x3e\x48\xba\x62\x18\x48\x26\x33\x9e\x3a\x58\xb3\x58\xe6\x8b\xb7\x9b\xd7\x97\xa6\x1e\x0f\x94\xfb\x9e\x13\x59\xf3\x5e\x37\x33\x57\xa1\x78\x2c\xa7\x56\x55\xf1\x54\x62\x0e\xaf\x8a\x67\x6b\x3e\xd6\xf5\x5b\x6d\x4d\xfe\xae\xaa\x78\xf8\x01\xe9\x19\x37\xe7\x70\x01\x00\x00")
 
 func sqlSetpasswordSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -505,12 +1851,12 @@ func sqlSetpasswordSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/setPassword.sql", size: 272, mode: os.FileMode(438), modTime: time.Unix(1429031540, 0)}
+	info := bindataFileInfo{name: "sql/setPassword.sql", size: 368, mode: os.FileMode(438), modTime: time.Unix(1792414416, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _sqlSetsubeffectsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x8f\x31\x6b\xc3\x30\x10\x85\xe7\x0a\xf4\x1f\x6e\xc8\x14\xdc\x84\xb6\x5b\x21\x43\xa1\x86\x8e\xa5\x75\xe8\x7c\x56\xce\xb1\x88\x25\x19\xdd\x39\x6e\xfe\x7d\x25\x99\x82\xc9\x22\xd0\xbb\xf7\xbe\x7b\xb7\xdf\x6a\x75\x1c\x4f\x28\xc4\x80\x30\x31\x45\x08\x1e\xa4\x27\x48\x1a\xb6\xc8\x04\x12\xa0\xc7\x2b\x2d\x22\xb1\x8d\x74\x02\x9e\x5a\x36\xd1\x8e\x62\x93\xbb\x43\x43\xc2\x5a\x69\xd5\xe0\x85\xf8\x55\xab\x07\x8f\x8e\xe0\x11\x58\xa2\xf5\xe7\x6a\xe1\x4a\x8f\x02\x61\xf6\x0c\x56\x92\xc5\xe1\xaf\x09\xc3\x40\x26\x33\x38\x99\xad\x97\x0a\xfa\x30\x83\x43\x7f\x83\xf5\xac\x24\x0b\xc3\xe1\xad\x94\x49\xf9\x21\xf8\x33\xb1\x5c\x2d\xcd\x29\x2c\xd6\xa5\x0f\xba\x71\x41\x74\x18\xa1\x45\x73\xc9\xd0\x50\x9a\x77\x93\x4c\x91\xfe\x6f\xcc\x9c\x9c\xdc\xe5\xd6\xdb\x7d\x7e\x8f\x9f\xef\x6f\x4d\x5d\xc6\xbc\x73\x24\xa8\xd5\x77\xdd\xc0\x5d\xcd\x03\x6c\x9e\x2b\x58\xef\x4e\xca\x8b\x56\x3f\x1f\xf5\x57\x0d\xf9\xec\xc3\xe6\xe9\x2f\x00\x00\xff\xff\x34\x1a\xb3\xd5\x55\x01\x00\x00")
+var _sqlSetsubeffectsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8f\x4d\x4f\xc3\x30\x0c\x86\xcf\x44\xca\x7f\xf0\x81\xd3\x34\x3a\xc1\x11\x69\x87\x49\x2b\xe2\xc2\x87\x46\x27\xce\x6e\x6b\xd6\x88\x34\xae\x62\x6f\x61\xff\x9e\xa4\xe3\x30\x4e\xb6\x5f\xdb\xcf\x6b\xaf\x16\xd6\xec\xa7\x1e\x95\x04\x10\x8e\x42\x11\x38\x80\x0e\x04\x59\xc3\x16\x85\x40\x19\x06\x3c\xd1\x2c\x52\x50\xa7\x9e\xc6\x1c\x05\xf8\x2b\xaf\x4c\x1e\x83\x35\xd6\x34\xf8\x4d\xf2\x68\xcd\x4d\xc0\x91\xe0\x0e\x44\xa3\x0b\x87\xe5\x05\xa9\x03\x2a\x70\x0a\x02\x4e\xf3\x48\xd9\xb9\x1a\x29\xe0\x59\xca\xc9\x19\x30\x12\x04\x4e\x20\xc7\x56\xba\xe8\x5a\xea\xf3\x05\xd6\x2c\x56\xc5\x65\xff\xbe\xdd\x34\xf5\x0c\x95\x6a\x24\x45\x6b\x3e\xea\x06\x46\xfc\xe9\xd8\x7b\xea\xd4\x71\x36\x59\xc3\x54\xfd\x97\x96\xe0\x39\x1c\x48\xf4\xe4\x28\xcd\xfd\xc1\x89\x72\x3c\x27\x17\x7a\x4e\xd6\x3c\xed\xde\x5e\xfe\xb0\xe5\x14\x81\xc9\x9a\xcf\xe7\x7a\x77\xed\x55\x95\xd7\xd6\xb7\xf7\xb0\x79\xdd\x66\xc2\xa5\x7a\xb0\xe6\x17\x03\xf1\xef\x6b\x44\x01\x00\x00")
 
 func sqlSetsubeffectsSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -525,7 +1871,47 @@ func sqlSetsubeffectsSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/setSubEffects.sql", size: 341, mode: os.FileMode(438), modTime: time.Unix(1431473969, 0)}
+	info := bindataFileInfo{name: "sql/setSubEffects.sql", size: 324, mode: os.FileMode(438), modTime: time.Unix(1792414715, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSettotpcounterSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8f\x41\x4b\xc3\x40\x10\x85\xcf\x2e\xec\x7f\x78\x87\x9e\x4a\xda\xa2\x88\x07\xb1\x87\x62\x03\x9e\x54\x6a\xc4\xf3\xb2\x99\x98\xc5\x74\x37\xec\x4c\x1a\xf2\xef\xdd\x8d\x08\xe9\x6d\x98\xf7\xe6\x7d\x6f\x76\x6b\xad\x4e\x64\x43\xac\x19\xd2\x12\x7a\x8a\x2e\xd4\x08\x0d\x8c\x87\xb1\x96\x7a\xa1\x1a\x36\xd4\xb4\xd5\x4a\xab\x37\xdf\x4d\x30\x4d\x43\x56\x18\x06\x31\x8c\x18\x5b\xf2\xcb\x53\xc7\xf0\x34\x52\x4c\xbb\x1c\xe1\x27\xf4\x91\x2e\x2e\x0c\xdc\x4d\x5a\xfd\x47\x16\xe9\xce\xd9\x16\x67\xf3\x43\x0c\x32\x69\xcc\x10\xb0\xf3\xdf\x1d\x61\xe0\x3f\x5e\x95\xe5\x47\xad\x6e\xbc\x39\x13\x36\x60\x89\xc9\x50\x64\x7d\x06\x08\xc2\xe8\x19\x4e\x92\xa5\x33\x2c\xcf\x61\xf0\x92\xa4\x0d\x9c\x97\x87\xfb\x62\x59\x2c\x8f\x57\x1f\x61\x34\x8c\x8b\xe9\x5c\x2a\xed\xb5\x5a\xef\x32\xf1\xf3\xfd\x78\xa8\xca\x19\xc0\x5b\x09\xd2\x6b\xf5\x51\x56\x58\x86\xef\xb1\xba\xd3\xea\xeb\xa5\x3c\x95\x5a\xe5\x62\xfb\xd5\x2d\x0e\xaf\xc7\x2b\xd3\xd3\x6c\xfa\x05\x04\xdf\x1b\x68\x5f\x01\x00\x00")
+
+func sqlSettotpcounterSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSettotpcounterSql,
+		"sql/setTOTPCounter.sql",
+	)
+}
+
+func sqlSettotpcounterSql() (*asset, error) {
+	bytes, err := sqlSettotpcounterSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/setTOTPCounter.sql", size: 351, mode: os.FileMode(438), modTime: time.Unix(1792414127, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSettraderevertedSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x8c\xbd\x0a\xc2\x40\x0c\x80\x67\x03\x79\x87\x0c\x9d\x8a\x5a\x74\x14\x3a\x58\x3c\x70\x11\x44\x2b\xce\x91\x0b\xf4\x28\x5c\x21\x97\x0a\x7d\x7b\xaf\xea\x20\x38\x7f\x3f\x55\x89\x70\x62\xed\x13\x31\x99\xb2\x17\xe2\x44\x63\xf4\x43\x14\x7a\x4c\xc4\x71\xb0\x4e\x14\x01\xa1\xe5\x5e\xd2\x0e\x61\x11\x3c\xad\x28\x44\x5b\x52\x46\xdf\xe8\x53\x64\xa8\xf2\x14\x35\xf1\xcd\xf4\x2f\x59\xc7\x36\x9b\x79\x10\x0c\xa1\xac\xe6\xed\xed\x7c\xd8\xb7\x8e\xc6\x24\x9a\xd6\x6f\x2f\x21\x5c\x5d\x4b\x3f\xa7\x9a\x8a\x2d\xc2\xfd\xe8\x2e\x0e\x21\xf8\xba\xd8\xbc\x00\xe9\xa7\x6d\xd9\xb7\x00\x00\x00")
+
+func sqlSettraderevertedSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSettraderevertedSql,
+		"sql/setTradeReverted.sql",
+	)
+}
+
+func sqlSettraderevertedSql() (*asset, error) {
+	bytes, err := sqlSettraderevertedSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/setTradeReverted.sql", size: 183, mode: os.FileMode(438), modTime: time.Unix(1792416657, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -582,28 +1968,94 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"sql/addAdmin.sql": sqlAddadminSql,
 	"sql/addCard.sql": sqlAddcardSql,
 	"sql/addCardHistorical.sql": sqlAddcardhistoricalSql,
+	"sql/addCardValue.sql": sqlAddcardvalueSql,
+	"sql/addChallenge.sql": sqlAddchallengeSql,
 	"sql/addCollection.sql": sqlAddcollectionSql,
+	"sql/addRecoveryCode.sql": sqlAddrecoverycodeSql,
 	"sql/addReset.sql": sqlAddresetSql,
 	"sql/addSession.sql": sqlAddsessionSql,
+	"sql/addTOTP.sql": sqlAddtotpSql,
+	"sql/addTrade.sql": sqlAddtradeSql,
 	"sql/addUser.sql": sqlAdduserSql,
+	"sql/addVerification.sql": sqlAddverificationSql,
+	"sql/addWebhookEvent.sql": sqlAddwebhookeventSql,
+	"sql/claimMail.sql": sqlClaimmailSql,
+	"sql/claimTrial.sql": sqlClaimtrialSql,
+	"sql/clearLoginFailures.sql": sqlClearloginfailuresSql,
+	"sql/enableTOTP.sql": sqlEnabletotpSql,
+	"sql/enqueueMail.sql": sqlEnqueuemailSql,
+	"sql/getAdmin.sql": sqlGetadminSql,
 	"sql/getAllResets.sql": sqlGetallresetsSql,
+	"sql/getAllSessions.sql": sqlGetallsessionsSql,
+	"sql/getAuditLog.sql": sqlGetauditlogSql,
 	"sql/getCard.sql": sqlGetcardSql,
+	"sql/getChallenge.sql": sqlGetchallengeSql,
 	"sql/getCollectionContents.sql": sqlGetcollectioncontentsSql,
 	"sql/getCollectionHistory.sql": sqlGetcollectionhistorySql,
+	"sql/getCollectionHistoryPage.sql": sqlGetcollectionhistorypageSql,
 	"sql/getCollectionList.sql": sqlGetcollectionlistSql,
 	"sql/getCollectionMeta.sql": sqlGetcollectionmetaSql,
+	"sql/getCollectionPage.sql": sqlGetcollectionpageSql,
+	"sql/getCoupon.sql": sqlGetcouponSql,
+	"sql/getLoginAttempts.sql": sqlGetloginattemptsSql,
+	"sql/getMail.sql": sqlGetmailSql,
+	"sql/getOutboxStats.sql": sqlGetoutboxstatsSql,
+	"sql/getPlan.sql": sqlGetplanSql,
+	"sql/getPlans.sql": sqlGetplansSql,
 	"sql/getReset.sql": sqlGetresetSql,
 	"sql/getSessions.sql": sqlGetsessionsSql,
 	"sql/getSub.sql": sqlGetsubSql,
+	"sql/getSubByCustomer.sql": sqlGetsubbycustomerSql,
+	"sql/getTOTP.sql": sqlGettotpSql,
+	"sql/getTrade.sql": sqlGettradeSql,
+	"sql/getTradeCards.sql": sqlGettradecardsSql,
 	"sql/getUser.sql": sqlGetuserSql,
+	"sql/getUserPlan.sql": sqlGetuserplanSql,
+	"sql/getVerification.sql": sqlGetverificationSql,
+	"sql/markMailFailed.sql": sqlMarkmailfailedSql,
+	"sql/markMailSent.sql": sqlMarkmailsentSql,
 	"sql/modSub.sql": sqlModsubSql,
+	"sql/recordAPIRequest.sql": sqlRecordapirequestSql,
+	"sql/recordAdminAction.sql": sqlRecordadminactionSql,
+	"sql/recordAudit.sql": sqlRecordauditSql,
+	"sql/recordLoginFailure.sql": sqlRecordloginfailureSql,
+	"sql/redactOutbox.sql": sqlRedactoutboxSql,
+	"sql/redeemCoupon.sql": sqlRedeemcouponSql,
+	"sql/rehashPassword.sql": sqlRehashpasswordSql,
+	"sql/releaseCoupon.sql": sqlReleasecouponSql,
+	"sql/releaseTrial.sql": sqlReleasetrialSql,
+	"sql/removeAPIUsage.sql": sqlRemoveapiusageSql,
+	"sql/removeAuditLog.sql": sqlRemoveauditlogSql,
+	"sql/removeCardValue.sql": sqlRemovecardvalueSql,
+	"sql/removeChallenge.sql": sqlRemovechallengeSql,
+	"sql/removeChallenges.sql": sqlRemovechallengesSql,
+	"sql/removeCollectionContents.sql": sqlRemovecollectioncontentsSql,
+	"sql/removeCollectionHistory.sql": sqlRemovecollectionhistorySql,
+	"sql/removeCollections.sql": sqlRemovecollectionsSql,
+	"sql/removeRecoveryCode.sql": sqlRemoverecoverycodeSql,
+	"sql/removeRecoveryCodes.sql": sqlRemoverecoverycodesSql,
+	"sql/removeResets.sql": sqlRemoveresetsSql,
 	"sql/removeSession.sql": sqlRemovesessionSql,
+	"sql/removeSessions.sql": sqlRemovesessionsSql,
+	"sql/removeSub.sql": sqlRemovesubSql,
+	"sql/removeTOTP.sql": sqlRemovetotpSql,
+	"sql/removeTrades.sql": sqlRemovetradesSql,
+	"sql/removeUser.sql": sqlRemoveuserSql,
+	"sql/removeVerifications.sql": sqlRemoveverificationsSql,
+	"sql/searchUsers.sql": sqlSearchusersSql,
 	"sql/setCollectionPermissions.sql": sqlSetcollectionpermissionsSql,
+	"sql/setDisabled.sql": sqlSetdisabledSql,
+	"sql/setEmail.sql": sqlSetemailSql,
+	"sql/setLocale.sql": sqlSetlocaleSql,
+	"sql/setLockout.sql": sqlSetlockoutSql,
 	"sql/setMaxCollections.sql": sqlSetmaxcollectionsSql,
 	"sql/setPassword.sql": sqlSetpasswordSql,
 	"sql/setSubEffects.sql": sqlSetsubeffectsSql,
+	"sql/setTOTPCounter.sql": sqlSettotpcounterSql,
+	"sql/setTradeReverted.sql": sqlSettraderevertedSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -647,50 +2099,182 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"sql": &bintree{nil, map[string]*bintree{
+		"addAdmin.sql": &bintree{sqlAddadminSql, map[string]*bintree{
+		}},
 		"addCard.sql": &bintree{sqlAddcardSql, map[string]*bintree{
 		}},
 		"addCardHistorical.sql": &bintree{sqlAddcardhistoricalSql, map[string]*bintree{
 		}},
+		"addCardValue.sql": &bintree{sqlAddcardvalueSql, map[string]*bintree{
+		}},
+		"addChallenge.sql": &bintree{sqlAddchallengeSql, map[string]*bintree{
+		}},
 		"addCollection.sql": &bintree{sqlAddcollectionSql, map[string]*bintree{
 		}},
+		"addRecoveryCode.sql": &bintree{sqlAddrecoverycodeSql, map[string]*bintree{
+		}},
 		"addReset.sql": &bintree{sqlAddresetSql, map[string]*bintree{
 		}},
 		"addSession.sql": &bintree{sqlAddsessionSql, map[string]*bintree{
 		}},
+		"addTOTP.sql": &bintree{sqlAddtotpSql, map[string]*bintree{
+		}},
+		"addTrade.sql": &bintree{sqlAddtradeSql, map[string]*bintree{
+		}},
 		"addUser.sql": &bintree{sqlAdduserSql, map[string]*bintree{
 		}},
+		"addVerification.sql": &bintree{sqlAddverificationSql, map[string]*bintree{
+		}},
+		"addWebhookEvent.sql": &bintree{sqlAddwebhookeventSql, map[string]*bintree{
+		}},
+		"claimMail.sql": &bintree{sqlClaimmailSql, map[string]*bintree{
+		}},
+		"claimTrial.sql": &bintree{sqlClaimtrialSql, map[string]*bintree{
+		}},
+		"clearLoginFailures.sql": &bintree{sqlClearloginfailuresSql, map[string]*bintree{
+		}},
+		"enableTOTP.sql": &bintree{sqlEnabletotpSql, map[string]*bintree{
+		}},
+		"enqueueMail.sql": &bintree{sqlEnqueuemailSql, map[string]*bintree{
+		}},
+		"getAdmin.sql": &bintree{sqlGetadminSql, map[string]*bintree{
+		}},
 		"getAllResets.sql": &bintree{sqlGetallresetsSql, map[string]*bintree{
 		}},
+		"getAllSessions.sql": &bintree{sqlGetallsessionsSql, map[string]*bintree{
+		}},
+		"getAuditLog.sql": &bintree{sqlGetauditlogSql, map[string]*bintree{
+		}},
 		"getCard.sql": &bintree{sqlGetcardSql, map[string]*bintree{
 		}},
+		"getChallenge.sql": &bintree{sqlGetchallengeSql, map[string]*bintree{
+		}},
 		"getCollectionContents.sql": &bintree{sqlGetcollectioncontentsSql, map[string]*bintree{
 		}},
 		"getCollectionHistory.sql": &bintree{sqlGetcollectionhistorySql, map[string]*bintree{
 		}},
+		"getCollectionHistoryPage.sql": &bintree{sqlGetcollectionhistorypageSql, map[string]*bintree{
+		}},
 		"getCollectionList.sql": &bintree{sqlGetcollectionlistSql, map[string]*bintree{
 		}},
 		"getCollectionMeta.sql": &bintree{sqlGetcollectionmetaSql, map[string]*bintree{
 		}},
+		"getCollectionPage.sql": &bintree{sqlGetcollectionpageSql, map[string]*bintree{
+		}},
+		"getCoupon.sql": &bintree{sqlGetcouponSql, map[string]*bintree{
+		}},
+		"getLoginAttempts.sql": &bintree{sqlGetloginattemptsSql, map[string]*bintree{
+		}},
+		"getMail.sql": &bintree{sqlGetmailSql, map[string]*bintree{
+		}},
+		"getOutboxStats.sql": &bintree{sqlGetoutboxstatsSql, map[string]*bintree{
+		}},
+		"getPlan.sql": &bintree{sqlGetplanSql, map[string]*bintree{
+		}},
+		"getPlans.sql": &bintree{sqlGetplansSql, map[string]*bintree{
+		}},
 		"getReset.sql": &bintree{sqlGetresetSql, map[string]*bintree{
 		}},
 		"getSessions.sql": &bintree{sqlGetsessionsSql, map[string]*bintree{
 		}},
 		"getSub.sql": &bintree{sqlGetsubSql, map[string]*bintree{
 		}},
+		"getSubByCustomer.sql": &bintree{sqlGetsubbycustomerSql, map[string]*bintree{
+		}},
+		"getTOTP.sql": &bintree{sqlGettotpSql, map[string]*bintree{
+		}},
+		"getTrade.sql": &bintree{sqlGettradeSql, map[string]*bintree{
+		}},
+		"getTradeCards.sql": &bintree{sqlGettradecardsSql, map[string]*bintree{
+		}},
 		"getUser.sql": &bintree{sqlGetuserSql, map[string]*bintree{
 		}},
+		"getUserPlan.sql": &bintree{sqlGetuserplanSql, map[string]*bintree{
+		}},
+		"getVerification.sql": &bintree{sqlGetverificationSql, map[string]*bintree{
+		}},
+		"markMailFailed.sql": &bintree{sqlMarkmailfailedSql, map[string]*bintree{
+		}},
+		"markMailSent.sql": &bintree{sqlMarkmailsentSql, map[string]*bintree{
+		}},
 		"modSub.sql": &bintree{sqlModsubSql, map[string]*bintree{
 		}},
+		"recordAPIRequest.sql": &bintree{sqlRecordapirequestSql, map[string]*bintree{
+		}},
+		"recordAdminAction.sql": &bintree{sqlRecordadminactionSql, map[string]*bintree{
+		}},
+		"recordAudit.sql": &bintree{sqlRecordauditSql, map[string]*bintree{
+		}},
+		"recordLoginFailure.sql": &bintree{sqlRecordloginfailureSql, map[string]*bintree{
+		}},
+		"redactOutbox.sql": &bintree{sqlRedactoutboxSql, map[string]*bintree{
+		}},
+		"redeemCoupon.sql": &bintree{sqlRedeemcouponSql, map[string]*bintree{
+		}},
+		"rehashPassword.sql": &bintree{sqlRehashpasswordSql, map[string]*bintree{
+		}},
+		"releaseCoupon.sql": &bintree{sqlReleasecouponSql, map[string]*bintree{
+		}},
+		"releaseTrial.sql": &bintree{sqlReleasetrialSql, map[string]*bintree{
+		}},
+		"removeAPIUsage.sql": &bintree{sqlRemoveapiusageSql, map[string]*bintree{
+		}},
+		"removeAuditLog.sql": &bintree{sqlRemoveauditlogSql, map[string]*bintree{
+		}},
+		"removeCardValue.sql": &bintree{sqlRemovecardvalueSql, map[string]*bintree{
+		}},
+		"removeChallenge.sql": &bintree{sqlRemovechallengeSql, map[string]*bintree{
+		}},
+		"removeChallenges.sql": &bintree{sqlRemovechallengesSql, map[string]*bintree{
+		}},
+		"removeCollectionContents.sql": &bintree{sqlRemovecollectioncontentsSql, map[string]*bintree{
+		}},
+		"removeCollectionHistory.sql": &bintree{sqlRemovecollectionhistorySql, map[string]*bintree{
+		}},
+		"removeCollections.sql": &bintree{sqlRemovecollectionsSql, map[string]*bintree{
+		}},
+		"removeRecoveryCode.sql": &bintree{sqlRemoverecoverycodeSql, map[string]*bintree{
+		}},
+		"removeRecoveryCodes.sql": &bintree{sqlRemoverecoverycodesSql, map[string]*bintree{
+		}},
+		"removeResets.sql": &bintree{sqlRemoveresetsSql, map[string]*bintree{
+		}},
 		"removeSession.sql": &bintree{sqlRemovesessionSql, map[string]*bintree{
 		}},
+		"removeSessions.sql": &bintree{sqlRemovesessionsSql, map[string]*bintree{
+		}},
+		"removeSub.sql": &bintree{sqlRemovesubSql, map[string]*bintree{
+		}},
+		"removeTOTP.sql": &bintree{sqlRemovetotpSql, map[string]*bintree{
+		}},
+		"removeTrades.sql": &bintree{sqlRemovetradesSql, map[string]*bintree{
+		}},
+		"removeUser.sql": &bintree{sqlRemoveuserSql, map[string]*bintree{
+		}},
+		"removeVerifications.sql": &bintree{sqlRemoveverificationsSql, map[string]*bintree{
+		}},
+		"searchUsers.sql": &bintree{sqlSearchusersSql, map[string]*bintree{
+		}},
 		"setCollectionPermissions.sql": &bintree{sqlSetcollectionpermissionsSql, map[string]*bintree{
 		}},
+		"setDisabled.sql": &bintree{sqlSetdisabledSql, map[string]*bintree{
+		}},
+		"setEmail.sql": &bintree{sqlSetemailSql, map[string]*bintree{
+		}},
+		"setLocale.sql": &bintree{sqlSetlocaleSql, map[string]*bintree{
+		}},
+		"setLockout.sql": &bintree{sqlSetlockoutSql, map[string]*bintree{
+		}},
 		"setMaxCollections.sql": &bintree{sqlSetmaxcollectionsSql, map[string]*bintree{
 		}},
 		"setPassword.sql": &bintree{sqlSetpasswordSql, map[string]*bintree{
 		}},
 		"setSubEffects.sql": &bintree{sqlSetsubeffectsSql, map[string]*bintree{
 		}},
+		"setTOTPCounter.sql": &bintree{sqlSettotpcounterSql, map[string]*bintree{
+		}},
+		"setTradeReverted.sql": &bintree{sqlSettraderevertedSql, map[string]*bintree{
+		}},
 	}},
 }}
 
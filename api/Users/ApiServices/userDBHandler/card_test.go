@@ -163,4 +163,85 @@ func addSomeCards(t *testing.T) (users []string, keys [][]byte,
 	return
 
 	
-}
\ No newline at end of file
+}
+
+// Add copies of a card differing only in their attributes, ensuring each
+// is kept apart and can be found by where it's kept.
+func TestCardAttributes(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(30)
+	key, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	// Wait for the db to catch up
+	time.Sleep(stepSleepTime)
+
+	collection:= randString(30)
+	err = AddCollection(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	base:= Card{
+		Name: "Mox Diamond", Set: "Legends", Quality: "NM", Lang: "EN",
+		Quantity: 1, LastUpdate: randomTime(),
+	}
+	foil:= base
+	foil.Foil = true
+	graded:= base
+	graded.Grader, graded.Grade = "PSA", "9.5"
+	stored:= base
+	stored.Location = "Binder"
+
+	cards:= []Card{base, foil, graded, stored}
+	err = AddCards(pool, key, user, collection, cards)
+	if err!= nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	acquired, err:= GetCollectionContents(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal(err)
+	}
+	if !equalCardContents(cards, acquired, t) {
+		t.Fatal("attributes did not set copies apart")
+	}
+
+	page, err:= QueryCollectionContents(pool, key, user, collection,
+		CollectionQuery{Location: "Binder"})
+	if err!=nil {
+		t.Fatal(err)
+	}
+	if len(page.Cards) != 1 || page.Cards[0] != stored {
+		t.Fatal("location filter wrong", page.Cards)
+	}
+
+}
+
+// Ensure only gradings we record are accepted.
+func TestValidGrading(t *testing.T) {
+	t.Parallel()
+
+	valid:= [][2]string{{"", ""}, {"PSA", "10"}, {"BGS", "9.5"},
+		{"CGC", "1"}}
+	for _, g:= range valid{
+		if !ValidGrading(g[0], g[1]) {
+			t.Fatal("refused valid grading", g)
+		}
+	}
+
+	invalid:= [][2]string{{"", "9"}, {"PSA", ""}, {"Bob", "9"},
+		{"PSA", "9.50"}, {"PSA", "9.3"}, {"PSA", "11"}, {"PSA", "0.5"},
+		{"PSA", "nine"}}
+	for _, g:= range invalid{
+		if ValidGrading(g[0], g[1]) {
+			t.Fatal("accepted invalid grading", g)
+		}
+	}
+
+}
@@ -3,6 +3,7 @@ package userDB
 import(
 
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/jackc/pgx"
@@ -13,6 +14,46 @@ type Card struct{
 	Name, Set, Quality, Comment, Lang string
 	Quantity int32
 	LastUpdate time.Time
+
+	// Set is never FoilSuffix'd, foils are marked here instead
+	Foil bool
+	// Who graded the card and what they gave it, both empty if ungraded
+	Grader, Grade string
+	// Where the card is physically kept, a binder or box
+	Location string
+}
+
+// How foil printings were once told apart, by their set's name, and how
+// prices still tell them apart.
+const FoilSuffix string = " Foil"
+
+// Companies whose grades we record.
+var Graders = []string{"PSA", "BGS", "CGC", "SGC"}
+
+// Whether a grader and grade are a pairing we record.
+//
+// Grades run from 1 to 10 in half steps written as plainly as possible,
+// 9.5 not 9.50, so each grade has a single spelling. The ungraded have
+// neither.
+func ValidGrading(grader, grade string) bool {
+	if grader == "" {
+		return grade == ""
+	}
+
+	known:= false
+	for _, aGrader:= range Graders{
+		known = known || aGrader == grader
+	}
+	if !known {
+		return false
+	}
+
+	g, err:= strconv.ParseFloat(grade, 64)
+	if err!=nil || strconv.FormatFloat(g, 'f', -1, 64) != grade {
+		return false
+	}
+
+	return g >= 1 && g <= 10 && g * 2 == float64(int(g * 2))
 }
 
 // Safely adds a card using a transaction to apply to
@@ -40,12 +81,18 @@ func AddCard(pool *pgx.ConnPool, sessionKey []byte,
 	}
 
 
+	tradeID, err:= addTradeRecord(tx, user, collection, 0)
+	if err!=nil {
+		return err
+	}
+
 	err = insertCard(tx,
 		user, collection,
-		Name, Set, Comment,
-		Quantity,
-		Quality, Lang,
-		LastUpdate)
+		Card{
+			Name: Name, Set: Set, Comment: Comment,
+			Quality: Quality, Lang: Lang,
+			Quantity: Quantity, LastUpdate: LastUpdate,
+		}, tradeID)
 	if err!=nil {
 		return fmt.Errorf("failed to add to history, ", err)
 	}
@@ -64,11 +111,22 @@ func AddCard(pool *pgx.ConnPool, sessionKey []byte,
 func AddCards(pool *pgx.ConnPool, sessionKey []byte,
 	user, collection string,
 	cards []Card) error {
+
+	_, err:= AddTrade(pool, sessionKey, user, collection, cards)
+	return err
+
+}
+
+// Adds cards as AddCards, returning the id of the trade they were
+// submitted as so it can later be reverted.
+func AddTrade(pool *pgx.ConnPool, sessionKey []byte,
+	user, collection string,
+	cards []Card) (int64, error) {
 	
 	// Start the transaction
 	tx, err:= pool.Begin()
 	if err!=nil {
-		return fmt.Errorf("failed to grab a transaction,", err)
+		return 0, fmt.Errorf("failed to grab a transaction: %v", err)
 	}
 	// Make sure we can safely exit at any time
 	defer tx.Rollback()
@@ -76,45 +134,49 @@ func AddCards(pool *pgx.ConnPool, sessionKey []byte,
 	// Make sure the user's collection exists
 	coll, err:= GetCollectionMeta(pool, sessionKey, user, collection)
 	if err!=nil {
-		return fmt.Errorf("failed to ensure collection exists")
+		return 0, fmt.Errorf("failed to ensure collection exists")
 	}
 	if coll.Name != collection {
-		return fmt.Errorf("no such collection exists")
+		return 0, fmt.Errorf("no such collection exists")
+	}
+
+	tradeID, err:= addTradeRecord(tx, user, collection, 0)
+	if err!=nil {
+		return 0, err
 	}
 
 	for _, aCard:= range cards{
 
-		err:= insertCard(tx,
-						user, collection,
-						aCard.Name, aCard.Set, aCard.Comment,
-						aCard.Quantity, aCard.Lang, aCard.Quality, aCard.LastUpdate)
+		err:= insertCard(tx, user, collection, aCard, tradeID)
 
 		if err!=nil {
-			return fmt.Errorf("failed to insert card", err)
+			return 0, fmt.Errorf("failed to insert card: %v", err)
 		}
 	}
 
-	tx.Commit()
+	err = tx.Commit()
+	if err!=nil {
+		return 0, fmt.Errorf("failed to commit trade: %v", err)
+	}
 
-	return nil
+	return tradeID, nil
 
 }
 
 // Inserts a card into the db using a passed transaction
 func insertCard(tx *pgx.Tx,
-	user, collection,
-	Name, Set, Comment string,
-	Quantity int32, Lang string,
-	Quality string, LastUpdate time.Time) error {
+	user, collection string,
+	c Card, tradeID int64) error {
 
 	var err error
 
 	// Send the contents upsert
 	_, err = tx.Exec("addCard",
 					user, collection,
-					Name, Set, Comment,
-					Quantity, Quality, Lang,
-					LastUpdate)
+					c.Name, c.Set, c.Comment,
+					c.Quantity, c.Quality, c.Lang,
+					c.LastUpdate,
+					c.Foil, c.Grader, c.Grade, c.Location)
 	if err!=nil {
 		return fmt.Errorf("failed to add to contents, ", err)
 	}
@@ -122,9 +184,10 @@ func insertCard(tx *pgx.Tx,
 	// Send the historical row
 	_, err = tx.Exec("addCardHistorical",
 					user, collection,
-					Name, Set, Comment,
-					Quantity, Quality, Lang,
-					LastUpdate)
+					c.Name, c.Set, c.Comment,
+					c.Quantity, c.Quality, c.Lang,
+					c.LastUpdate, tradeID,
+					c.Foil, c.Grader, c.Grade, c.Location)
 	if err!=nil {
 		return fmt.Errorf("failed to add to history, ", err)
 	}
@@ -134,6 +197,8 @@ func insertCard(tx *pgx.Tx,
 }
 
 // Acquires every change to a specified user's collection
+//
+// Changes older than the owner's plan allows them to view are omitted.
 func GetCollectionHistory(pool *pgx.ConnPool, sessionKey []byte,
 	user, collection string) ([]Card, error) {
 	
@@ -147,8 +212,15 @@ func GetCollectionHistory(pool *pgx.ConnPool, sessionKey []byte,
 		}	
 	}
 
+	// Their plan determines how far back they may look
+	u, err:= GetUser(pool, user)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to fetch user")
+	}
+	since:= time.Now().Add(-u.Longestview)
+
 	// Grab everything and pack it nicely to be returned
-	rows, err := pool.Query("getCollectionHistory", user, collection)
+	rows, err := pool.Query("getCollectionHistory", user, collection, since)
 	if err!=nil {
 		return nil, err
 	}
@@ -159,7 +231,8 @@ func GetCollectionHistory(pool *pgx.ConnPool, sessionKey []byte,
 		c:= Card{}
 		err = rows.Scan(&c.Name, &c.Set,
 			&c.Quality, &c.Quantity,
-			&c.Comment, &c.Lang, &c.LastUpdate)
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location)
 		if err!=nil {
 			return nil, errorHandle(err, ScanError)
 		}
@@ -195,7 +268,8 @@ func GetCollectionContents(pool *pgx.ConnPool, sessionKey []byte,
 		c:= Card{}
 		err = rows.Scan(&c.Name, &c.Set,
 			&c.Quality, &c.Quantity,
-			&c.Comment, &c.Lang, &c.LastUpdate)
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location)
 		if err!=nil {
 			return nil, errorHandle(err, ScanError)
 		}
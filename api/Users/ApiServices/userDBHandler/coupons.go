@@ -0,0 +1,74 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+	"fmt"
+)
+
+var ErrBadCoupon = fmt.Errorf("coupon invalid, expired, or exhausted")
+
+// A promo code we accept, mirroring a coupon on stripe.
+type Coupon struct{
+	Code string
+	// Empty if valid for any plan
+	Plan string
+	ValidUntil time.Time
+	MaxRedemptions, Redemptions int32
+}
+
+// Whether the coupon may be used on a plan at a given time
+func (c *Coupon) Valid(plan string, at time.Time) bool {
+	return (c.Plan == "" || c.Plan == plan) &&
+		at.Before(c.ValidUntil) &&
+		(c.MaxRedemptions == 0 || c.Redemptions < c.MaxRedemptions)
+}
+
+// Acquires a coupon by its code.
+func GetCoupon(pool *pgx.ConnPool, code string) (*Coupon, error) {
+
+	c:= Coupon{}
+	var plan pgx.NullString
+	err:= pool.QueryRow("getCoupon", code).Scan(&c.Code, &plan,
+		&c.ValidUntil, &c.MaxRedemptions, &c.Redemptions)
+	if err!=nil {
+		return nil, errorHandle(err, ScanError)
+	}
+
+	c.Plan = plan.String
+
+	return &c, nil
+
+}
+
+// Claims a redemption of a coupon for a plan.
+//
+// Returns ErrBadCoupon if it can't be used, checking and claiming
+// are a single statement so limits hold under concurrency.
+func RedeemCoupon(pool *pgx.ConnPool, code, plan string,
+	at time.Time) error {
+
+	tag, err:= pool.Exec("redeemCoupon", code, plan, at)
+	if err!=nil {
+		return errorHandle(err, "failed to redeem coupon")
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrBadCoupon
+	}
+
+	return nil
+
+}
+
+// Returns a redemption claimed by RedeemCoupon after whatever it was
+// for failed.
+func ReleaseCoupon(pool *pgx.ConnPool, code string) error {
+
+	_, err:= pool.Exec("releaseCoupon", code)
+
+	return err
+
+}
@@ -0,0 +1,51 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Ensure coupon restrictions are honoured
+func TestCouponValidity(t *testing.T) {
+	t.Parallel()
+
+	now:= time.Now()
+	c:= Coupon{
+		Code: randString(10),
+		Plan: "Preordain",
+		ValidUntil: now.Add(time.Hour),
+		MaxRedemptions: 2,
+		Redemptions: 1,
+	}
+
+	if !c.Valid("Preordain", now) {
+		t.Fatal("valid coupon was refused")
+	}
+	if c.Valid("Sensei's Top", now) {
+		t.Fatal("coupon was accepted for another plan")
+	}
+	if c.Valid("Preordain", now.Add(2 * time.Hour)) {
+		t.Fatal("expired coupon was accepted")
+	}
+
+	c.Redemptions = 2
+	if c.Valid("Preordain", now) {
+		t.Fatal("exhausted coupon was accepted")
+	}
+
+	c.Plan = ""
+	c.MaxRedemptions = 0
+	if !c.Valid("Sensei's Top", now) {
+		t.Fatal("unrestricted coupon was refused")
+	}
+
+	// Codes we've never heard of can't be redeemed
+	err:= RedeemCoupon(pool, randString(20), "Preordain", now)
+	if err != ErrBadCoupon {
+		t.Fatal("redeemed a coupon that doesn't exist", err)
+	}
+
+}
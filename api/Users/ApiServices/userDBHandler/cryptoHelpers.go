@@ -5,8 +5,10 @@ import(
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"code.google.com/p/go.crypto/scrypt"
+	"golang.org/x/crypto/argon2"
 	"crypto/rand"
 
 	"crypto/x509"
@@ -25,34 +27,118 @@ func getArrayOfRandBytes(arrayLength int) ([]byte, error) {
 	return workingArray, nil
 }
 
-// Derives a password using scrypt. Requires plaintext and nonce
-func derivePasswordWithNonce(plaintext, nonce []byte) ([]byte, error) {
+const algScrypt string = "scrypt"
+const algArgon2id string = "argon2id"
+
+// The algorithm and cost a password hash was derived with.
+//
+// Stored alongside each hash as its String form, eg
+// 'argon2id$t=1,m=65536,p=4,l=32', so the policy can be raised
+// without breaking existing users.
+type hashParams struct{
+	Algorithm string
+	// argon2id: time, memory in KiB, and threads
+	// scrypt: N, r, and p
+	Cost, Memory, Parallelism uint32
+	KeyLen uint32
+}
 
-	// Output a 32 byte hash using
-	passwordHash, err := scrypt.Key([]byte(plaintext), nonce, 32768, 2, 1, 32)
-	if err != nil {
-		return nil, fmt.Errorf("failed to derive password, try again")
+// What every user had before parameters were recorded.
+var legacyHashParams = hashParams{
+	Algorithm: algScrypt,
+	Cost: 32768, Memory: 2, Parallelism: 1,
+	KeyLen: 32,
+}
+
+// What new and rehashed passwords are derived with.
+var currentHashParams = hashParams{
+	Algorithm: algArgon2id,
+	Cost: 1, Memory: 64 * 1024, Parallelism: 4,
+	KeyLen: 32,
+}
+
+func (h hashParams) String() string {
+	switch h.Algorithm {
+	case algScrypt:
+		return fmt.Sprintf("%s$n=%d,r=%d,p=%d,l=%d", h.Algorithm,
+			h.Cost, h.Memory, h.Parallelism, h.KeyLen)
+	default:
+		return fmt.Sprintf("%s$t=%d,m=%d,p=%d,l=%d", h.Algorithm,
+			h.Cost, h.Memory, h.Parallelism, h.KeyLen)
 	}
+}
+
+// Parses hashParams from their stored String form.
+func parseHashParams(encoded string) (hashParams, error) {
+	
+	h:= hashParams{}
 
-	return passwordHash, nil
+	parts:= strings.SplitN(encoded, "$", 2)
+	if len(parts) != 2 {
+		return h, fmt.Errorf("malformed hash parameters")
+	}
+	h.Algorithm = parts[0]
+
+	var format string
+	switch h.Algorithm {
+	case algScrypt:
+		format = "n=%d,r=%d,p=%d,l=%d"
+	case algArgon2id:
+		format = "t=%d,m=%d,p=%d,l=%d"
+	default:
+		return h, fmt.Errorf("unknown hash algorithm")
+	}
+
+	_, err:= fmt.Sscanf(parts[1], format,
+		&h.Cost, &h.Memory, &h.Parallelism, &h.KeyLen)
+	if err!=nil {
+		return h, fmt.Errorf("malformed hash parameters")
+	}
+
+	return h, nil
+
+}
+
+// Derives a password according to params. Requires plaintext and nonce
+func derivePasswordWithParams(plaintext, nonce []byte,
+	params hashParams) ([]byte, error) {
+
+	switch params.Algorithm {
+	case algArgon2id:
+		return argon2.IDKey(plaintext, nonce, params.Cost, params.Memory,
+			uint8(params.Parallelism), params.KeyLen), nil
+	case algScrypt:
+		passwordHash, err := scrypt.Key(plaintext, nonce,
+			int(params.Cost), int(params.Memory), int(params.Parallelism),
+			int(params.KeyLen))
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive password, try again")
+		}
+		return passwordHash, nil
+	}
+
+	return nil, fmt.Errorf("unknown hash algorithm")
 
 }
 
-// Derives a password using scrypt. Requires plaintext, nonce
-// is returned alongside the hash
-func derivePassword(plaintext []byte) (passwordHash, nonce []byte, err error) {
+// Derives a password using the current policy. Requires plaintext, nonce
+// and the encoded parameters are returned alongside the hash
+func derivePassword(plaintext []byte) (passwordHash, nonce []byte,
+	params string, err error) {
 
 	nonce, err = getArrayOfRandBytes(32)
 	if err!=nil {
 		return
 	}
 
-	// Output a 32 byte hash using
-	passwordHash, err= scrypt.Key([]byte(plaintext), nonce, 32768, 2, 1, 32)
+	passwordHash, err = derivePasswordWithParams(plaintext, nonce,
+		currentHashParams)
 	if err != nil {
 		return
 	}
 
+	params = currentHashParams.String()
+
 	return
 
 }
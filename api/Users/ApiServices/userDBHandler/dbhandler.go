@@ -17,27 +17,10 @@ import(
 )
 
 // The basic free tier of subs.
+//
+// Every other plan, and what each entitles, lives in users.plans.
 const DefaultSubLevel = "Peek"
 
-// Which collections we allow
-var SubTiers = []string{
-	DefaultSubLevel,
-	"Preordain",
-	"Sensei's Top",
-}
-
-// How many collections each tier is allowed to own
-var SubTiersToCollections = map[string]int{
-	DefaultSubLevel: 1,
-	"Preordain": 4,
-	"Sensei's Top": 30,
-}
-
-// 290 years from now there should be no back records.
-const noTimeLimit = time.Duration(31560000000000000 * 270)
-// A full year!
-const defaultTimeLimit = time.Duration(31560000000000000)
-
 
 const DefaultID string = "invalidByDesign"
 
@@ -52,9 +35,36 @@ var statements = []string{"addCard", "addCardHistorical" , "getCard",
 						"getCollectionContents", "getCollectionHistory",
 						"getSessions", "addSession", "removeSession",
 						"getReset", "getAllResets", "addReset",
-						"addUser", "getUser", "setPassword",
+						"addUser", "getUser", "setPassword", "rehashPassword",
 						"setMaxCollections", "setCollectionPermissions",
-						"getSub", "modSub", "setSubEffects"}
+						"getSub", "modSub", "setSubEffects",
+						"getPlan", "getPlans", "getUserPlan", "recordAPIRequest",
+						"claimTrial", "releaseTrial",
+						"getCoupon", "redeemCoupon", "releaseCoupon",
+						"getSubByCustomer", "addWebhookEvent",
+						"addVerification", "getVerification",
+						"removeVerifications", "setEmail", "setLocale",
+						"addTOTP", "getTOTP", "enableTOTP",
+						"setTOTPCounter", "removeTOTP",
+						"addRecoveryCode", "removeRecoveryCode",
+						"removeRecoveryCodes",
+						"addChallenge", "getChallenge", "removeChallenge",
+						"getLoginAttempts", "recordLoginFailure",
+						"setLockout", "clearLoginFailures",
+						"enqueueMail", "claimMail", "markMailSent",
+						"markMailFailed", "getOutboxStats", "getMail",
+						"getAllSessions", "removeCollectionHistory",
+						"removeCollectionContents", "removeCollections",
+						"removeSessions", "removeResets", "removeChallenges",
+						"removeAPIUsage", "removeSub", "removeUser",
+						"redactOutbox",
+						"recordAudit", "getAuditLog", "removeAuditLog",
+						"addAdmin", "getAdmin", "recordAdminAction",
+						"searchUsers", "setDisabled",
+						"getCollectionPage", "getCollectionHistoryPage",
+						"addCardValue", "removeCardValue",
+						"addTrade", "getTrade", "getTradeCards",
+						"setTradeReverted", "removeTrades"}
 const statementLoc string = "sql"
 const statementExtension string = ".sql"
 
@@ -67,6 +77,11 @@ const hoursPerDay int = 24
 const hoursPerMonth int = 30 * hoursPerDay
 const sessionValidTime = time.Duration(hoursPerMonth) * time.Hour
 const resetValidTime = time.Duration(hoursPerDay) * time.Hour
+const verifyValidTime = time.Duration(3 * hoursPerDay) * time.Hour
+
+// A password has been checked when a challenge is issued so it
+// should be exchanged quickly.
+const challengeValidTime = time.Duration(5) * time.Minute
 
 var ScanError string = "failed to scan row"
 
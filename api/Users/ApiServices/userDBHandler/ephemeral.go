@@ -134,6 +134,15 @@ func SendReset(pool *pgx.ConnPool, reset Reset) error {
 
 // Generates a request reset by inserting a reset key valid for this user
 func RequestReset(pool *pgx.ConnPool, user string) (string, error) {
+	return RequestResetMail(pool, user, nil)
+}
+
+// Generates a reset as RequestReset and queues the email carrying it.
+//
+// The email is composed from the fresh key and queued in the same
+// transaction as the reset so one can't exist without the other.
+func RequestResetMail(pool *pgx.ConnPool, user string,
+	compose MailComposer) (string, error) {
 
 	// Ensure the user hasn't received a reset code within
 	// the possible duration of another valid reset code
@@ -152,6 +161,27 @@ func RequestReset(pool *pgx.ConnPool, user string) (string, error) {
 		}
 	}
 
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return "", fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	key, err:= addReset(tx, user, compose, now)
+	if err!=nil {
+		return "", err
+	}
+
+	return key, tx.Commit()
+
+}
+
+// Inserts a fresh reset valid from the provided time, queueing the
+// composed email carrying it if compose is non-nil.
+func addReset(tx *pgx.Tx, user string, compose MailComposer,
+	now time.Time) (string, error) {
+
 	// Acquire a fresh session key of length 256 bits
 	key:= randString(ResetLength)
 	// Store the hash of the key and use that for comparisons
@@ -166,12 +196,25 @@ func RequestReset(pool *pgx.ConnPool, user string) (string, error) {
 	}
 
 	// Send the session off
-	err = SendReset(pool, freshReset)
+	_, err:= tx.Exec("addReset",
+		freshReset.Name, freshReset.ResetKey,
+		freshReset.StartValid, freshReset.EndValid)
 	if err!=nil {
 		return "", errorHandle(err, "failed to send fresh reset off to db")
 	}
 
-	return key, err
+	if compose!=nil {
+		m, err:= compose(key)
+		if err!=nil {
+			return "", err
+		}
+		err = enqueueMail(tx, m, now)
+		if err!=nil {
+			return "", err
+		}
+	}
+
+	return key, nil
 
 }
 
@@ -0,0 +1,197 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+	"fmt"
+)
+
+// The states a message in the outbox can be in.
+const(
+	MailPending string = "pending"
+	MailSent string = "sent"
+	MailDead string = "dead"
+)
+
+// How many delivery attempts a message gets before it is dead-lettered.
+const MaxMailAttempts int = 8
+
+// Failed deliveries back off exponentially from the base up to the max.
+//
+// With MaxMailAttempts this gives a message roughly a day to get out.
+const mailBaseBackoff = time.Duration(1) * time.Minute
+const mailMaxBackoff = time.Duration(6) * time.Hour
+
+// How long a claimed message is held before another sender can pick it
+// up. Only matters if a sender dies mid delivery.
+const mailLease = time.Duration(5) * time.Minute
+
+// A rendered email ready for delivery.
+//
+// Body is plaintext, HTML is an optional alternative to it.
+type Mail struct{
+	ID int64
+	To, Subject, Body, HTML string
+	Attempts int
+}
+
+// Builds the email to go out alongside a freshly generated key.
+//
+// Used where the key only exists inside of the transaction creating it.
+type MailComposer func(key string) (*Mail, error)
+
+// How many messages a queue holds in a given state.
+type OutboxStatus struct{
+	Status string
+	Count int64
+	// When the oldest message in this state was queued.
+	Oldest time.Time
+}
+
+// Queues a message as part of a larger transaction.
+func enqueueMail(tx *pgx.Tx, m *Mail, at time.Time) error {
+	if m == nil {
+		return nil
+	}
+
+	_, err:= tx.Exec("enqueueMail", m.To, m.Subject, m.Body, at, m.HTML)
+	if err!=nil {
+		return errorHandle(err, "failed to queue mail")
+	}
+
+	return nil
+}
+
+// Queues a message which doesn't accompany any other change.
+func EnqueueMail(pool *pgx.ConnPool, m *Mail) error {
+
+	_, err:= pool.Exec("enqueueMail", m.To, m.Subject, m.Body,
+		time.Now(), m.HTML)
+	if err!=nil {
+		return errorHandle(err, "failed to queue mail")
+	}
+
+	return nil
+
+}
+
+// Claims up to count messages which are due for delivery.
+//
+// Claimed messages must be passed to either MarkMailSent or
+// MarkMailFailed, otherwise they're retried after a lease expires.
+func ClaimMail(pool *pgx.ConnPool, count int,
+	at time.Time) ([]Mail, error) {
+
+	rows, err:= pool.Query("claimMail", at, at.Add(mailLease), count)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to claim mail")
+	}
+	defer rows.Close()
+
+	claimed:= make([]Mail, 0)
+	for rows.Next() {
+		var m Mail
+		var attempts int32
+		err = rows.Scan(&m.ID, &m.To, &m.Subject, &m.Body, &m.HTML,
+			&attempts)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+		m.Attempts = int(attempts)
+		claimed = append(claimed, m)
+	}
+
+	return claimed, rows.Err()
+
+}
+
+// Records that a claimed message went out.
+func MarkMailSent(pool *pgx.ConnPool, id int64, at time.Time) error {
+	_, err:= pool.Exec("markMailSent", id, at)
+
+	return err
+}
+
+// Records a failed attempt to deliver a claimed message.
+//
+// The message is retried after a backoff unless it has run out of
+// attempts, in which case it is dead-lettered and true is returned.
+func MarkMailFailed(pool *pgx.ConnPool, m Mail, reason error,
+	at time.Time) (bool, error) {
+
+	attempts:= m.Attempts + 1
+	dead:= attempts >= MaxMailAttempts
+
+	status:= MailPending
+	if dead {
+		status = MailDead
+	}
+
+	_, err:= pool.Exec("markMailFailed", m.ID, status,
+		fmt.Sprint(reason), at.Add(mailBackoff(attempts)))
+	if err!=nil {
+		return false, err
+	}
+
+	return dead, nil
+
+}
+
+// Fetches a message by ID whatever its state.
+func getMail(pool *pgx.ConnPool, id int64) (*Mail, error) {
+
+	var m Mail
+	var attempts int32
+	err:= pool.QueryRow("getMail", id).Scan(&m.ID, &m.To, &m.Subject,
+		&m.Body, &m.HTML, &attempts)
+	if err!=nil {
+		return nil, errorHandle(err, ScanError)
+	}
+	m.Attempts = int(attempts)
+
+	return &m, nil
+
+}
+
+// Determines how long to wait before the next delivery attempt.
+func mailBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	backoff:= mailBaseBackoff
+	for i:= 1; i < attempts; i++ {
+		backoff*= 2
+		if backoff >= mailMaxBackoff {
+			return mailMaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// Summarizes the outbox by message state.
+func GetOutboxStats(pool *pgx.ConnPool) ([]OutboxStatus, error) {
+
+	rows, err:= pool.Query("getOutboxStats")
+	if err!=nil {
+		return nil, errorHandle(err, "failed to get outbox stats")
+	}
+	defer rows.Close()
+
+	stats:= make([]OutboxStatus, 0)
+	for rows.Next() {
+		var s OutboxStatus
+		err = rows.Scan(&s.Status, &s.Count, &s.Oldest)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+
+}
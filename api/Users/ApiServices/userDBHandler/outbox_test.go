@@ -0,0 +1,187 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+	"fmt"
+
+)
+
+// Finds a message to a recipient among those claimed.
+func claimFor(t *testing.T, to string, at time.Time) *Mail {
+	claimed, err:= ClaimMail(pool, 1000, at)
+	if err!=nil {
+		t.Fatal("failed to claim mail", err)
+	}
+
+	for _, m:= range claimed{
+		if m.To == to {
+			return &m
+		}
+	}
+
+	return nil
+}
+
+// Fail a message until it is dead-lettered, ensuring it backs off
+// between attempts and is never claimed once dead.
+//
+// Claiming takes whatever is due so outbox tests can't run in parallel.
+func TestOutboxRetry(t *testing.T) {
+
+	to:= randString(30)
+	err:= EnqueueMail(pool, &Mail{To: to, Subject: "foo", Body: "bar"})
+	if err!=nil {
+		t.Fatal("failed to queue mail", err)
+	}
+
+	now:= time.Now().Add(time.Second)
+	m:= claimFor(t, to, now)
+	if m == nil || m.Attempts != 0 || m.Body != "bar" {
+		t.Fatal("failed to claim queued mail", m)
+	}
+
+	// A claimed message is held until its lease is up
+	if claimFor(t, to, now) != nil {
+		t.Fatal("claimed mail was claimed twice")
+	}
+
+	dead, err:= MarkMailFailed(pool, *m, fmt.Errorf("bounced"), now)
+	if err!=nil || dead {
+		t.Fatal("failed to record first failure", err, dead)
+	}
+
+	// Not due until after the backoff
+	if claimFor(t, to, now) != nil {
+		t.Fatal("failed mail retried without backoff")
+	}
+	retryAt:= now.Add(mailBackoff(1) + time.Second)
+	m = claimFor(t, to, retryAt)
+	if m == nil || m.Attempts != 1 {
+		t.Fatal("failed mail was not retried", m)
+	}
+
+	m.Attempts = MaxMailAttempts - 1
+	dead, err = MarkMailFailed(pool, *m, fmt.Errorf("bounced"), retryAt)
+	if err!=nil || !dead {
+		t.Fatal("failed to dead-letter mail", err, dead)
+	}
+
+	if claimFor(t, to, retryAt.Add(mailMaxBackoff * 2)) != nil {
+		t.Fatal("dead mail was claimed")
+	}
+
+	stats, err:= GetOutboxStats(pool)
+	if err!=nil {
+		t.Fatal("failed to get outbox stats", err)
+	}
+	found:= false
+	for _, s:= range stats{
+		if s.Status == MailDead && s.Count > 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("dead mail missing from stats", stats)
+	}
+
+}
+
+// A reset whose email can't be composed must not be created.
+func TestResetMailAtomic(t *testing.T) {
+
+	user:= randString(int(randByte()))
+	_, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	compose:= func(key string) (*Mail, error) {
+		return nil, fmt.Errorf("no template")
+	}
+	_, err = RequestResetMail(pool, user, compose)
+	if err==nil {
+		t.Fatal("reset created without its email")
+	}
+
+	resets, err:= getAllResets(pool, user)
+	if err!=nil {
+		t.Fatal("failed to get resets", err)
+	}
+	if len(resets) != 0 {
+		t.Fatal("reset persisted without its email", resets)
+	}
+
+	to:= randString(30)
+	compose = func(key string) (*Mail, error) {
+		return &Mail{To: to, Subject: "reset", Body: key}, nil
+	}
+	key, err:= RequestResetMail(pool, user, compose)
+	if err!=nil {
+		t.Fatal("failed to request reset", err)
+	}
+
+	m:= claimFor(t, to, time.Now().Add(time.Second))
+	if m == nil || m.Body != key {
+		t.Fatal("reset email was not queued with its key", m)
+	}
+	MarkMailSent(pool, m.ID, time.Now())
+
+}
+
+// Mail which went out or gave up must not keep its contents around.
+func TestOutboxBlanked(t *testing.T) {
+
+	sentTo:= randString(30)
+	deadTo:= randString(30)
+	for _, to:= range []string{sentTo, deadTo} {
+		err:= EnqueueMail(pool, &Mail{To: to, Subject: "foo",
+			Body: "bar", HTML: "baz"})
+		if err!=nil {
+			t.Fatal("failed to queue mail", err)
+		}
+	}
+
+	now:= time.Now().Add(time.Second)
+	sent:= claimFor(t, sentTo, now)
+	dead:= claimFor(t, deadTo, now)
+	if sent == nil || dead == nil {
+		t.Fatal("failed to claim queued mail", sent, dead)
+	}
+
+	err:= MarkMailSent(pool, sent.ID, now)
+	if err!=nil {
+		t.Fatal("failed to mark mail sent", err)
+	}
+	dead.Attempts = MaxMailAttempts - 1
+	_, err = MarkMailFailed(pool, *dead, fmt.Errorf("bounced"), now)
+	if err!=nil {
+		t.Fatal("failed to dead-letter mail", err)
+	}
+
+	for _, id:= range []int64{sent.ID, dead.ID} {
+		m, err:= getMail(pool, id)
+		if err!=nil {
+			t.Fatal("failed to get mail", err)
+		}
+		if m.Body != "" || m.HTML != "" || m.Subject != "foo" {
+			t.Fatal("mail kept its contents", m)
+		}
+	}
+
+}
+
+func TestMailBackoff(t *testing.T) {
+
+	if mailBackoff(1) != mailBaseBackoff ||
+		mailBackoff(2) != 2 * mailBaseBackoff {
+		t.Fatal("backoff did not double", mailBackoff(1), mailBackoff(2))
+	}
+
+	if mailBackoff(MaxMailAttempts * 10) != mailMaxBackoff {
+		t.Fatal("backoff was not capped", mailBackoff(MaxMailAttempts * 10))
+	}
+
+}
@@ -0,0 +1,307 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"strings"
+	"time"
+
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Orders a collection's contents may be sorted in
+const(
+	SortName string = "name"
+	SortQuantity string = "quantity"
+	SortUpdated string = "updated"
+	SortValue string = "value"
+)
+
+// The most cards a single page may hold.
+const MaxCollectionPage int = 500
+
+// Returned when a cursor wasn't produced by the query it's used with.
+var ErrBadCursor = fmt.Errorf("invalid cursor")
+
+// Narrows and orders what's acquired from a collection.
+//
+// Empty filters match everything. Name and Comment match substrings
+// regardless of case, the rest match exactly.
+type CollectionQuery struct{
+	Set, Name, Quality, Lang, Comment, Location string
+
+	// One of the Sort constants, SortName by default. Ignored for history
+	// which is always newest first.
+	Sort string
+	Descending bool
+
+	// Where the previous page left off, empty for the first page
+	Cursor string
+	// The most cards to acquire, zero or less acquiring every match
+	Limit int
+}
+
+// Whether the provided sort is one we support.
+func ValidSort(sort string) bool {
+	switch sort {
+	case SortName, SortQuantity, SortUpdated, SortValue:
+		return true
+	}
+
+	return false
+}
+
+// A single page of a collection.
+//
+// Next resumes after the final card, empty when nothing is left.
+type CollectionPage struct{
+	Cards []Card
+	Next string
+}
+
+// Where a page ended, handed to clients as an opaque string.
+type collectionCursor struct{
+	// The sort and direction it was produced under, 'history' for history
+	Sort string
+	Descending bool
+
+	SortText string
+	SortNum int64
+	Updated time.Time
+
+	Name, Set, Quality, Lang string
+	Foil bool
+	Grader, Grade, Location string
+}
+
+func (c collectionCursor) encode() string {
+	encoded, _:= json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// Decodes a cursor, ensuring it belongs to the provided sort.
+func decodeCursor(raw, sort string,
+	descending bool) (*collectionCursor, error) {
+
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err:= base64.RawURLEncoding.DecodeString(raw)
+	if err!=nil {
+		return nil, ErrBadCursor
+	}
+
+	var c collectionCursor
+	err = json.Unmarshal(decoded, &c)
+	if err!=nil {
+		return nil, ErrBadCursor
+	}
+
+	if c.Sort != sort || c.Descending != descending {
+		return nil, ErrBadCursor
+	}
+
+	return &c, nil
+
+}
+
+// Turns a substring into an ILIKE pattern matching it literally.
+func substringPattern(s string) string {
+	escaper:= strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + escaper.Replace(s) + "%"
+}
+
+// How many rows to ask for, one more than the limit so we know if
+// there's another page.
+func pageLimit(limit int) interface{} {
+	if limit <= 0 {
+		return nil
+	}
+
+	return limit + 1
+}
+
+// Acquires a page of a user's collection matching the query.
+func QueryCollectionContents(pool *pgx.ConnPool, sessionKey []byte,
+	user, collection string, q CollectionQuery) (*CollectionPage, error) {
+
+	// Authenticate the request
+	if sessionKey != nil {
+		err:= SessionAuth(pool, user, sessionKey)
+		if err!=nil{
+			return nil, errorHandle(err, "authorization Failed, invalid session key")
+		}
+	}
+
+	if q.Sort == "" {
+		q.Sort = SortName
+	}
+	if !ValidSort(q.Sort) {
+		return nil, fmt.Errorf("unknown sort")
+	}
+
+	cursor, err:= decodeCursor(q.Cursor, q.Sort, q.Descending)
+	if err!=nil {
+		return nil, err
+	}
+	resume:= cursor != nil
+	if !resume {
+		cursor = &collectionCursor{}
+	}
+
+	rows, err:= pool.Query("getCollectionPage", user, collection,
+		q.Set, substringPattern(q.Name), q.Quality, q.Lang,
+		substringPattern(q.Comment), q.Location,
+		q.Sort, q.Descending, resume,
+		cursor.SortText, cursor.SortNum,
+		cursor.Name, cursor.Set, cursor.Quality, cursor.Lang,
+		cursor.Foil, cursor.Grader, cursor.Grade, cursor.Location,
+		pageLimit(q.Limit))
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page:= CollectionPage{Cards: make([]Card, 0)}
+	var last collectionCursor
+	for rows.Next(){
+		if q.Limit > 0 && len(page.Cards) == q.Limit {
+			// There's more to come, resume after the final card we keep
+			page.Next = last.encode()
+			break
+		}
+
+		c:= Card{}
+		last = collectionCursor{Sort: q.Sort, Descending: q.Descending}
+		err = rows.Scan(&c.Name, &c.Set,
+			&c.Quality, &c.Quantity,
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location,
+			&last.SortText, &last.SortNum)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+		last.Name, last.Set = c.Name, c.Set
+		last.Quality, last.Lang = c.Quality, c.Lang
+		last.Foil, last.Grader = c.Foil, c.Grader
+		last.Grade, last.Location = c.Grade, c.Location
+
+		page.Cards = append(page.Cards, c)
+	}
+
+	return &page, rows.Err()
+
+}
+
+// Acquires a page of the history of a user's collection matching the
+// query, newest first.
+//
+// Changes older than the owner's plan allows them to view are omitted.
+func QueryCollectionHistory(pool *pgx.ConnPool, sessionKey []byte,
+	user, collection string, q CollectionQuery) (*CollectionPage, error) {
+
+	// Authenticate the request
+	if sessionKey != nil {
+		err:= SessionAuth(pool, user, sessionKey)
+		if err!=nil{
+			return nil, errorHandle(err, "authorization Failed, invalid session key")
+		}
+	}
+
+	// Their plan determines how far back they may look
+	u, err:= GetUser(pool, user)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to fetch user")
+	}
+	since:= time.Now().Add(-u.Longestview)
+
+	cursor, err:= decodeCursor(q.Cursor, "history", false)
+	if err!=nil {
+		return nil, err
+	}
+	resume:= cursor != nil
+	if !resume {
+		cursor = &collectionCursor{}
+	}
+
+	rows, err:= pool.Query("getCollectionHistoryPage", user, collection,
+		since, q.Set, substringPattern(q.Name), q.Quality, q.Lang,
+		substringPattern(q.Comment), q.Location, resume,
+		cursor.Updated, cursor.Name, cursor.Set, cursor.Quality, cursor.Lang,
+		cursor.Foil, cursor.Grader, cursor.Grade, cursor.Location,
+		pageLimit(q.Limit))
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page:= CollectionPage{Cards: make([]Card, 0)}
+	for rows.Next(){
+		if q.Limit > 0 && len(page.Cards) == q.Limit {
+			last:= page.Cards[len(page.Cards) - 1]
+			page.Next = collectionCursor{
+				Sort: "history",
+				Updated: last.LastUpdate,
+				Name: last.Name, Set: last.Set,
+				Quality: last.Quality, Lang: last.Lang,
+				Foil: last.Foil, Grader: last.Grader,
+				Grade: last.Grade, Location: last.Location,
+			}.encode()
+			break
+		}
+
+		c:= Card{}
+		err = rows.Scan(&c.Name, &c.Set,
+			&c.Quality, &c.Quantity,
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		page.Cards = append(page.Cards, c)
+	}
+
+	return &page, rows.Err()
+
+}
+
+// What a single copy of a printing is worth.
+//
+// Foil printings are valued under their FoilSuffix'd set.
+type CardValue struct{
+	Name, Set string
+	// In cents
+	Price int32
+	Updated time.Time
+}
+
+// Replaces the recorded value of each provided printing.
+func SetCardValues(pool *pgx.ConnPool, values []CardValue) error {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	for _, v:= range values{
+		_, err = tx.Exec("removeCardValue", v.Name, v.Set)
+		if err!=nil {
+			return errorHandle(err, "failed to remove card value")
+		}
+
+		_, err = tx.Exec("addCardValue", v.Name, v.Set, v.Price, v.Updated)
+		if err!=nil {
+			return errorHandle(err, "failed to add card value")
+		}
+	}
+
+	return tx.Commit()
+
+}
@@ -0,0 +1,225 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"strings"
+	"time"
+
+)
+
+// Adds a user with a collection of random cards, returning the user,
+// their session key, and the collection's name.
+func addPagedCollection(t *testing.T) (string, []byte, string) {
+	user:= randString(30)
+	key, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	// Wait for the db to catch up
+	time.Sleep(stepSleepTime)
+
+	collection:= randString(30)
+	err = AddCollection(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	err = AddCards(pool, key, user, collection, randomCards(testCount * 2))
+	if err!= nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	return user, key, collection
+}
+
+// Acquires every page of a query, ensuring no card is seen twice.
+func allPages(t *testing.T, key []byte, user, collection string,
+	q CollectionQuery, history bool) []Card {
+
+	seen:= make(map[Card]bool)
+	var cards []Card
+	for {
+		var page *CollectionPage
+		var err error
+		if history {
+			page, err = QueryCollectionHistory(pool, key, user, collection, q)
+		}else{
+			page, err = QueryCollectionContents(pool, key, user, collection, q)
+		}
+		if err!=nil {
+			t.Fatal("failed to query page", err)
+		}
+		if len(page.Cards) > q.Limit {
+			t.Fatal("page exceeded its limit", len(page.Cards))
+		}
+
+		for _, c:= range page.Cards{
+			id:= printingOf(c)
+			id.LastUpdate = c.LastUpdate
+			if seen[id] {
+				t.Fatal("card repeated across pages", c)
+			}
+			seen[id] = true
+		}
+		cards = append(cards, page.Cards...)
+
+		if page.Next == "" {
+			return cards
+		}
+		q.Cursor = page.Next
+	}
+}
+
+// Page through a collection under each sort, ensuring every card turns
+// up exactly once and in order.
+func TestCollectionPages(t *testing.T) {
+	t.Parallel()
+
+	user, key, collection:= addPagedCollection(t)
+
+	all, err:= GetCollectionContents(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get contents", err)
+	}
+
+	// Every printing is worth something different, foils most of all
+	now:= time.Now()
+	var values []CardValue
+	worth:= make(map[string]int64)
+	for i, name:= range cardNames{
+		for j, set:= range setNames{
+			price:= int32(i * len(setNames) + j)
+			for _, s:= range []string{set, set + FoilSuffix}{
+				values = append(values, CardValue{
+					Name: name, Set: s, Price: price, Updated: now,
+				})
+				worth[name + s] = int64(price)
+				price*= 3
+			}
+		}
+	}
+	valueOf:= func(c Card) int64 {
+		set:= c.Set
+		if c.Foil {
+			set+= FoilSuffix
+		}
+		return worth[c.Name + set] * int64(c.Quantity)
+	}
+	err = SetCardValues(pool, values)
+	if err!=nil {
+		t.Fatal("failed to set card values", err)
+	}
+
+	ordered:= map[string]func(a, b Card) bool{
+		SortName: func(a, b Card) bool {
+			return a.Name <= b.Name
+		},
+		SortQuantity: func(a, b Card) bool {
+			return a.Quantity <= b.Quantity
+		},
+		SortUpdated: func(a, b Card) bool {
+			return !a.LastUpdate.After(b.LastUpdate)
+		},
+		SortValue: func(a, b Card) bool {
+			return valueOf(a) <= valueOf(b)
+		},
+	}
+
+	for sort, inOrder:= range ordered{
+		for _, descending:= range []bool{false, true}{
+			cards:= allPages(t, key, user, collection, CollectionQuery{
+				Sort: sort,
+				Descending: descending,
+				Limit: 7,
+			}, false)
+			if len(cards) != len(all) {
+				t.Fatal("pages missed cards", sort, len(cards), len(all))
+			}
+
+			for i:= 1; i < len(cards); i++ {
+				a, b:= cards[i - 1], cards[i]
+				if descending {
+					a, b = b, a
+				}
+				if !inOrder(a, b) {
+					t.Fatal("cards out of order", sort, descending, a, b)
+				}
+			}
+		}
+	}
+
+	_, err = QueryCollectionContents(pool, key, user, collection,
+		CollectionQuery{Sort: SortValue, Cursor: "garbage"})
+	if err!=ErrBadCursor {
+		t.Fatal("accepted a garbage cursor", err)
+	}
+
+}
+
+// Filter a collection, ensuring exactly the matching cards are returned
+// from both its contents and history.
+func TestCollectionFilters(t *testing.T) {
+	t.Parallel()
+
+	user, key, collection:= addPagedCollection(t)
+
+	all, err:= GetCollectionContents(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get contents", err)
+	}
+	allHistory, err:= GetCollectionHistory(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get history", err)
+	}
+
+	q:= CollectionQuery{
+		Set: setNames[0],
+		Name: "OF",
+		Limit: 5,
+	}
+	matches:= func(c Card) bool {
+		return c.Set == q.Set &&
+			strings.Contains(strings.ToLower(c.Name), "of")
+	}
+
+	expected:= 0
+	for _, c:= range all{
+		if matches(c) {
+			expected++
+		}
+	}
+	cards:= allPages(t, key, user, collection, q, false)
+	if len(cards) != expected {
+		t.Fatal("filtered contents wrong size", len(cards), expected)
+	}
+	for _, c:= range cards{
+		if !matches(c) {
+			t.Fatal("filter let through", c)
+		}
+	}
+
+	expected = 0
+	for _, c:= range allHistory{
+		if matches(c) {
+			expected++
+		}
+	}
+	cards = allPages(t, key, user, collection, q, true)
+	if len(cards) != expected {
+		t.Fatal("filtered history wrong size", len(cards), expected)
+	}
+	for i, c:= range cards{
+		if !matches(c) {
+			t.Fatal("filter let through", c)
+		}
+		if i > 0 && c.LastUpdate.After(cards[i - 1].LastUpdate) {
+			t.Fatal("history not newest first")
+		}
+	}
+
+}
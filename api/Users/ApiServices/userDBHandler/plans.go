@@ -0,0 +1,119 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+)
+
+// What subscribing to a plan entitles a user to.
+type Plan struct{
+	Name string
+	MaxCollections int32
+	// How far back collection history may be viewed
+	HistoryWindow time.Duration
+	MaxAlerts int32
+	// Whether collections may be exported
+	Export bool
+	// Authenticated requests allowed per day, 0 is unlimited
+	DailyQuota int32
+	// How long a first subscription is free
+	TrialDays int32
+}
+
+// When a trial of the plan started at a given time would end.
+//
+// Zero if the plan has no trial.
+func (p *Plan) TrialEnd(at time.Time) time.Time {
+	if p.TrialDays <= 0 {
+		return time.Time{}
+	}
+
+	return at.AddDate(0, 0, int(p.TrialDays))
+}
+
+// Whether the plan limits how many requests may be made
+func (p *Plan) Metered() bool {
+	return p.DailyQuota > 0
+}
+
+func scanPlan(row interface{
+	Scan(dest ...interface{}) error
+}) (*Plan, error) {
+
+	p:= Plan{}
+	var historyWindowAsInt int64
+	err:= row.Scan(&p.Name, &p.MaxCollections, &historyWindowAsInt,
+		&p.MaxAlerts, &p.Export, &p.DailyQuota, &p.TrialDays)
+	if err!=nil {
+		return nil, errorHandle(err, ScanError)
+	}
+
+	p.HistoryWindow = time.Duration(historyWindowAsInt)
+
+	return &p, nil
+
+}
+
+// Acquires a plan by name.
+func GetPlan(pool *pgx.ConnPool, plan string) (*Plan, error) {
+	return scanPlan(pool.QueryRow("getPlan", plan))
+}
+
+// Acquires every plan a user may subscribe to.
+func GetPlans(pool *pgx.ConnPool) ([]Plan, error) {
+
+	rows, err:= pool.Query("getPlans")
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []Plan
+	for rows.Next(){
+		p, err:= scanPlan(rows)
+		if err!=nil {
+			return nil, err
+		}
+
+		plans = append(plans, *p)
+	}
+
+	return plans, nil
+
+}
+
+// Acquires the plan a user is currently subscribed to with no
+// authentication.
+func GetUserPlan(pool *pgx.ConnPool, user string) (*Plan, error) {
+	return scanPlan(pool.QueryRow("getUserPlan", user))
+}
+
+// Counts an authenticated request against a user's daily quota.
+//
+// Returns false once they've exceeded it. Unmetered plans are never
+// counted.
+func UseAPIQuota(pool *pgx.ConnPool, user string,
+	at time.Time) (bool, error) {
+
+	p, err:= GetUserPlan(pool, user)
+	if err!=nil {
+		return false, err
+	}
+	if !p.Metered() {
+		return true, nil
+	}
+
+	var requests int32
+	err = pool.QueryRow("recordAPIRequest", user,
+		at.UTC().Truncate(time.Duration(hoursPerDay) * time.Hour)).
+		Scan(&requests)
+	if err!=nil {
+		return false, errorHandle(err, "failed to record request")
+	}
+
+	return requests <= p.DailyQuota, nil
+
+}
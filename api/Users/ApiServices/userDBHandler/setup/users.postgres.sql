@@ -96,15 +96,78 @@ CREATE DOMAIN possiblePrivacy TEXT CHECK(
 	VALUE = 'History'
 );
 
-CREATE DOMAIN possibleSub TEXT CHECK(
-	VALUE = 'Peek' OR
-	VALUE = 'Preordain' OR
-	VALUE = 'Sensei''s Top'
-);
-
 /*Add a schema to work under*/
 CREATE SCHEMA users;
 
+/*
+Create the table of plans a user may subscribe to and what each entitles.
+
+Adding a tier is a matter of inserting a row and a matching plan on
+stripe; nothing in code names a plan apart from the default, 'Peek'.
+
+historywindow is a duration in nanoseconds, how far back a plan may
+view collection history. It is copied into users.meta.longestview.
+
+maxalerts is how many price alerts a plan may have. It's served with
+the plan's other entitlements, alerts themselves check it once they
+exist.
+
+export is whether a plan may download its account and collections
+through /Export.
+
+dailyquota is how many authenticated requests a plan may make
+per day, 0 being unlimited.
+
+trialdays is how long a first subscription to the plan is free.
+*/
+CREATE TABLE users.plans (
+	name standardText NOT NULL,
+	
+	maxcollections int NOT NULL,
+	historywindow bigint NOT NULL,
+	maxalerts int NOT NULL,
+	export boolean NOT NULL,
+	dailyquota int NOT NULL,
+	trialdays int NOT NULL DEFAULT 0,
+	
+	CONSTRAINT uniquePlan UNIQUE (name)
+);
+
+/*
+The tiers we launched with.
+
+Existing deployments should create and fill users.plans then move
+users.subs.plan from the possibleSub domain to the foreign key.
+*/
+INSERT INTO users.plans
+	(name, maxcollections, historywindow, maxalerts, export, dailyquota,
+	trialdays)
+VALUES
+	('Peek', 1, 31560000000000000, 0, false, 1000, 0),
+	('Preordain', 4, 8521200000000000000, 10, true, 10000, 14),
+	('Sensei''s Top', 30, 8521200000000000000, 100, true, 0, 14);
+
+/*
+Create the table of promo codes we accept.
+
+Each code must also exist as a coupon on stripe, this table only
+decides whether a code may be used.
+
+plan restricts a code to a single plan, NULL allowing any.
+
+maxredemptions of 0 allows unlimited redemptions.
+*/
+CREATE TABLE users.coupons (
+	code standardText NOT NULL,
+	plan standardText references users.plans(name),
+	
+	validuntil timestamp NOT NULL,
+	maxredemptions int NOT NULL DEFAULT 0,
+	redemptions int NOT NULL DEFAULT 0,
+	
+	CONSTRAINT uniqueCoupon UNIQUE (code)
+);
+
 /*
 Create the table holding lightweight user metadata.
 
@@ -115,13 +178,53 @@ passhash and nonce require a value.
 No valid sessions or collections is the default state.
 
 longestview is a duration, which is nanoseconds since epoch.
+
+verified is set only once the user has proven they control email.
 */
 CREATE TABLE users.meta (
 	name standardText NOT NULL,
 	email standardText NOT NULL,
+	/*
+	Accounts that predate verification were trusted with their
+	email already, so they start verified rather than locked out
+	of password resets. Existing deployments can migrate with
+	
+	ALTER TABLE users.meta ADD COLUMN verified boolean
+		NOT NULL DEFAULT false;
+	UPDATE users.meta SET verified = true;
+	*/
+	verified boolean NOT NULL DEFAULT false,
 	
 	passhash bytea NOT NULL,
 	nonce bytea NOT NULL,
+	/*
+	Algorithm and cost passhash was derived with.
+	
+	Rows that predate this column were all scrypt at these
+	parameters; existing deployments can migrate with
+	
+	ALTER TABLE users.meta ADD COLUMN hashparams standardText
+		NOT NULL DEFAULT 'scrypt$n=32768,r=2,p=1,l=32';
+	*/
+	hashparams standardText NOT NULL DEFAULT 'scrypt$n=32768,r=2,p=1,l=32',
+	
+	/*
+	Language mail to the user is written in, templates missing for a
+	locale fall back to en. Existing deployments can migrate with
+	
+	ALTER TABLE users.meta ADD COLUMN locale standardText
+		NOT NULL DEFAULT 'en';
+	*/
+	locale standardText NOT NULL DEFAULT 'en',
+	
+	/*
+	Set by support to bar a user from logging in. Existing
+	deployments can migrate with
+	
+	ALTER TABLE users.meta ADD COLUMN disabled boolean
+		NOT NULL DEFAULT false;
+	*/
+	disabled boolean NOT NULL DEFAULT false,
 	
 	maxcollections int DEFAULT 1,
 	longestview bigint DEFAULT 31560000000000000,
@@ -146,16 +249,33 @@ CREATE TABLE users.subs (
 	
 	startTime timestamp NOT NULL,
 
-	plan possibleSub NOT NULL,
+	plan standardText NOT NULL references users.plans(name),
 
 	customerID TEXT NOT NULL,
 	subID TEXT NOT NULL,
 	
+	/*Trials are only offered once per user*/
+	trialed boolean NOT NULL DEFAULT false,
+	
 	CONSTRAINT unique_sub_name UNIQUE (name)
 );
 
 CREATE UNIQUE INDEX subs_name_index on users.subs(name);
 
+CREATE INDEX subs_customer_index on users.subs(customerID);
+
+/*
+Create the table of stripe webhook events we've already handled.
+
+Stripe delivers at least once so this keeps handling idempotent.
+*/
+CREATE TABLE users.webhookEvents (
+	eventID TEXT NOT NULL,
+	received timestamp NOT NULL,
+	
+	CONSTRAINT uniqueWebhookEvent UNIQUE (eventID)
+);
+
 /*
 Create a function that allows us to mostly atomically upsert
 into users.subs
@@ -164,7 +284,7 @@ select mod_sub('everlag', 'Sensei\'s Top', now,
 	'someCustomerToken', 'someSubToken');
 */
 CREATE FUNCTION
-	mod_sub(specName TEXT, specPlan possibleSub, specTime timestamp,
+	mod_sub(specName TEXT, specPlan TEXT, specTime timestamp,
 			specCustomerID TEXT, specSubID TEXT)
 	RETURNS VOID AS
 $$
@@ -200,6 +320,58 @@ END;
 $$
 LANGUAGE plpgsql;
 
+/*
+Create the table counting authenticated requests per user per day
+to enforce users.plans.dailyquota.
+*/
+CREATE TABLE users.apiUsage (
+	name standardText NOT NULL references users.meta(name),
+	day date NOT NULL,
+	requests int NOT NULL DEFAULT 0,
+	
+	CONSTRAINT uniqueUsageDay UNIQUE (name, day)
+);
+
+/*
+Mostly atomically counts a request and returns the day's total.
+
+select record_api_request('everlag', now::date);
+*/
+CREATE FUNCTION
+	record_api_request(specName TEXT, specDay date)
+	RETURNS INT AS
+$$
+DECLARE
+	count INT;
+BEGIN
+    LOOP
+        -- first try to update the key
+        UPDATE users.apiUsage
+			SET requests = requests + 1
+			WHERE
+				name = specName AND
+				day = specDay
+			RETURNING requests INTO count;
+        IF found THEN
+            RETURN count;
+        END IF;
+        -- not there, so try to insert the key
+        -- if someone else inserts the same key concurrently,
+        -- we could get a unique-key failure
+        BEGIN
+            INSERT INTO users.apiUsage
+				(name, day, requests) 
+			VALUES
+				(specName, specDay, 1);
+            RETURN 1;
+        EXCEPTION WHEN unique_violation THEN
+            -- do nothing, and loop to try the UPDATE again
+        END;
+    END LOOP;
+END;
+$$
+LANGUAGE plpgsql;
+
 /*
 Create the table holding the user sessions.
 
@@ -241,6 +413,142 @@ CREATE TABLE users.resets (
 );
 
 
+/*
+Create our email verification requests, very similar to resets.
+
+email is the address being verified. It is only copied into users.meta
+once the key sent to it has been returned, which allows an email change
+to be confirmed before it takes effect.
+*/
+CREATE TABLE users.verifications (
+	name standardText NOT NULL references users.meta(name),
+	email standardText NOT NULL,
+	verifyKey bytea NOT NULL,
+	
+	startValid timestamp DEFAULT now(),
+	endValid timestamp DEFAULT (now()- INTERVAL '1 days'),
+	
+	CONSTRAINT uniqueVerifyKey UNIQUE (verifyKey, name)
+);
+
+CREATE INDEX verifications_name_index on users.verifications(name);
+
+/*
+Create the table holding optional TOTP second factors.
+
+secret is the base32 shared secret, it cannot be hashed as we need it
+to derive codes. enabled is only set once the user has proven they
+enrolled it by providing a valid code.
+
+lastCounter is the most recent period a code was accepted in, codes
+from that period or earlier are refused to prevent replays.
+*/
+CREATE TABLE users.totp (
+	name standardText NOT NULL references users.meta(name),
+	secret TEXT NOT NULL,
+	
+	enabled boolean NOT NULL DEFAULT false,
+	lastCounter bigint NOT NULL DEFAULT 0,
+	
+	CONSTRAINT uniqueTotpName UNIQUE (name)
+);
+
+/*
+Single use recovery codes for when a user loses their second factor.
+
+Only the hash of each code is stored.
+*/
+CREATE TABLE users.recoveryCodes (
+	name standardText NOT NULL references users.meta(name),
+	codeHash bytea NOT NULL,
+	
+	CONSTRAINT uniqueRecoveryCode UNIQUE (codeHash, name)
+);
+
+CREATE INDEX recovery_name_index on users.recoveryCodes(name);
+
+/*
+Short lived challenges handed out after a correct password for users
+with a second factor. Exchanged alongside a valid code for a session.
+*/
+CREATE TABLE users.loginChallenges (
+	name standardText NOT NULL references users.meta(name),
+	challengeKey bytea NOT NULL,
+	
+	startValid timestamp NOT NULL,
+	endValid timestamp NOT NULL,
+	
+	CONSTRAINT uniqueChallengeKey UNIQUE (challengeKey, name)
+);
+
+/*
+Create the table tracking failed logins, both per account and per ip.
+
+kind is either 'name' or 'ip' with identifier holding the value. There is
+deliberately no reference to users.meta so failures against names that
+don't exist are tracked identically to those that do.
+
+failures counts consecutive failures, lockedUntil is when the next
+attempt will be considered.
+*/
+CREATE TABLE users.loginAttempts (
+	kind TEXT NOT NULL,
+	identifier TEXT NOT NULL,
+	
+	failures int NOT NULL DEFAULT 0,
+	lastFailure timestamp NOT NULL,
+	lockedUntil timestamp NOT NULL,
+	
+	CONSTRAINT uniqueAttemptKey UNIQUE (kind, identifier)
+);
+
+/*
+Mostly atomically records a failed login and returns the number of
+consecutive failures.
+
+Failures before specSince are forgotten and counting starts over.
+
+select record_login_failure('name', 'everlag', now, now - '1 hour');
+*/
+CREATE FUNCTION
+	record_login_failure(specKind TEXT, specIdentifier TEXT,
+			specTime timestamp, specSince timestamp)
+	RETURNS INT AS
+$$
+DECLARE
+	count INT;
+BEGIN
+    LOOP
+        -- first try to update the key
+        UPDATE users.loginAttempts
+			SET 
+				failures = CASE WHEN lastFailure < specSince
+					THEN 1 ELSE failures + 1 END,
+				lastFailure = specTime
+			WHERE
+				kind = specKind AND
+				identifier = specIdentifier
+			RETURNING failures INTO count;
+        IF found THEN
+            RETURN count;
+        END IF;
+        -- not there, so try to insert the key
+        -- if someone else inserts the same key concurrently,
+        -- we could get a unique-key failure
+        BEGIN
+            INSERT INTO users.loginAttempts
+				(kind, identifier, failures, lastFailure, lockedUntil) 
+			VALUES
+				(specKind, specIdentifier, 1, specTime, specTime);
+            RETURN 1;
+        EXCEPTION WHEN unique_violation THEN
+            -- do nothing, and loop to try the UPDATE again
+        END;
+    END LOOP;
+END;
+$$
+LANGUAGE plpgsql;
+
 /*
 Create the table that stores the collection metadata of our users.
 */
@@ -261,9 +569,44 @@ A table that stores the actual contents of the collection.
 
 These contents are the most up to date.
 
-Uniquely index by ownere:collection:cardName:setName:quality
+Uniquely index by ownere:collection:cardName:setName:quality:lang along
+with the attributes below that set one copy apart from another.
 
 Update using UPSERT... which needs to be implemented
+
+Foils were once stored under a ' Foil' suffixed setName and cards had
+none of foil, grader, grade, or location. Existing deployments can
+migrate with
+
+ALTER TABLE users.collectionContents
+	ADD COLUMN foil boolean NOT NULL DEFAULT false,
+	ADD COLUMN grader standardText NOT NULL DEFAULT '',
+	ADD COLUMN grade standardText NOT NULL DEFAULT '',
+	ADD COLUMN location standardText NOT NULL DEFAULT '',
+	DROP CONSTRAINT uniqueContentsKey,
+	ADD CONSTRAINT uniqueContentsKey UNIQUE (owner, collection,
+		cardName, setName, quality, lang, foil, grader, grade, location);
+UPDATE users.collectionContents
+	SET setName = left(setName, -5), foil = true
+	WHERE setName LIKE '% Foil';
+
+ALTER TABLE users.collectionHistory
+	ADD COLUMN foil boolean NOT NULL DEFAULT false,
+	ADD COLUMN grader standardText NOT NULL DEFAULT '',
+	ADD COLUMN grade standardText NOT NULL DEFAULT '',
+	ADD COLUMN location standardText NOT NULL DEFAULT '',
+	DROP CONSTRAINT uniqueHistoryKey,
+	ADD CONSTRAINT uniqueHistoryKey UNIQUE (owner, collection,
+		cardName, setName, quality, lang, foil, grader, grade, location,
+		lastUpdate);
+UPDATE users.collectionHistory
+	SET setName = left(setName, -5), foil = true
+	WHERE setName LIKE '% Foil';
+
+DROP FUNCTION add_card(TEXT, TEXT, TEXT, TEXT, TEXT, INT,
+	possibleQuality, possibleLanguage, timestamp);
+
+then creating add_card as below.
 */
 CREATE TABLE users.collectionContents (
 
@@ -281,11 +624,19 @@ CREATE TABLE users.collectionContents (
 	
 	lastUpdate timestamp NOT NULL,
 
+	foil boolean NOT NULL DEFAULT false,
+	/*Both empty for cards nobody has graded*/
+	grader standardText NOT NULL DEFAULT '',
+	grade standardText NOT NULL DEFAULT '',
+	/*Where the cards are physically kept, a binder or box*/
+	location standardText NOT NULL DEFAULT '',
+
 	FOREIGN KEY (owner, collection) REFERENCES users.collections (owner, name),
 
 	CONSTRAINT uniqueContentsKey UNIQUE (owner, collection,
 										cardName, setName,
-										quality, lang)
+										quality, lang,
+										foil, grader, grade, location)
 );
 
 CREATE INDEX contents_completeCollection_index on users.collectionContents(owner, collection);
@@ -318,27 +669,94 @@ CREATE TABLE users.collectionHistory (
 
 	creationTime timestamp DEFAULT now(),
 
+	/*
+	The trade in users.trades that made this change, 0 for changes
+	that predate trades being recorded. Existing deployments can
+	migrate with
+	
+	ALTER TABLE users.collectionHistory ADD COLUMN tradeID bigint
+		NOT NULL DEFAULT 0;
+	CREATE INDEX history_trade_index on users.collectionHistory(owner, tradeID);
+	*/
+	tradeID bigint NOT NULL DEFAULT 0,
+
+	/*As in users.collectionContents*/
+	foil boolean NOT NULL DEFAULT false,
+	grader standardText NOT NULL DEFAULT '',
+	grade standardText NOT NULL DEFAULT '',
+	location standardText NOT NULL DEFAULT '',
+
 	CONSTRAINT uniqueHistoryKey UNIQUE (owner, collection,
 										cardName, setName,
 										quality, lang,
+										foil, grader, grade, location,
 										lastUpdate)
 );
 
 CREATE INDEX history_cardName_index on users.collectionHistory(cardName, owner);
+CREATE INDEX history_trade_index on users.collectionHistory(owner, tradeID);
+
+/*
+Each trade submitted to a collection, its changes are the rows of
+users.collectionHistory carrying its id.
+
+A revert is itself a trade whose revertOf is the trade it undid, the
+undone trade has revertedBy set to it. 0 marks neither.
+*/
+CREATE TABLE users.trades (
+	id bigserial NOT NULL,
+
+	owner standardText NOT NULL,
+	collection standardText NOT NULL,
+
+	submitted timestamp NOT NULL,
+
+	revertOf bigint NOT NULL DEFAULT 0,
+	revertedBy bigint NOT NULL DEFAULT 0,
+
+	CONSTRAINT uniqueTradeID UNIQUE (id)
+);
+
+CREATE INDEX trades_owner_index on users.trades(owner);
+
+/*
+A snapshot of what a single copy of each printing is worth, in cents.
+
+Prices live in their own database so collections can only be sorted by
+value against this copy. The users api refreshes it from the prices api
+set by set, printings missing from it are treated as worthless.
+
+Foil printings are recorded under their ' Foil' suffixed setName as the
+prices themselves are.
+*/
+CREATE TABLE users.cardValues (
+
+	cardName standardText NOT NULL,
+	setName standardText NOT NULL,
+	
+	price int NOT NULL,
+	updated timestamp NOT NULL,
+
+	CONSTRAINT uniqueCardValue UNIQUE (cardName, setName)
+);
 
 /*
 Create a function that allows us to mostly atomically upsert
 into userCollectionContents
 
-select add_card('bleh', 'bleh', 'bleh', 'bleh', 'bleh', 3, 'NM');
-drop function add_card(TEXT, TEXT, TEXT, TEXT, TEXT, INT, possibleQuality);
+select add_card('bleh', 'bleh', 'bleh', 'bleh', 'bleh', 3, 'NM', 'EN', now()::timestamp,
+	false, '', '', '');
+drop function add_card(TEXT, TEXT, TEXT, TEXT, TEXT, INT, possibleQuality,
+	possibleLanguage, timestamp, boolean, TEXT, TEXT, TEXT);
 */
 CREATE FUNCTION
 	add_card(specOwner TEXT, specCollection TEXT,
 			specCardName TEXT, specSetName TEXT, specComment TEXT,
 			specQuantity int,
 			specQuality possibleQuality, specLang possibleLanguage,
-			specTime timestamp)
+			specTime timestamp,
+			specFoil boolean, specGrader TEXT, specGrade TEXT,
+			specLocation TEXT)
 	RETURNS VOID AS
 $$
 BEGIN
@@ -354,6 +772,10 @@ BEGIN
 				setName = specSetName AND
 				quality = specQuality AND
 				lang = specLang AND
+				foil = specFoil AND
+				grader = specGrader AND
+				grade = specGrade AND
+				location = specLocation AND
 				owner = specOwner AND
 				collection = specCollection;
         IF found THEN
@@ -365,11 +787,13 @@ BEGIN
         BEGIN
             INSERT INTO users.collectionContents
 				(owner, collection, cardName, setName, comment,
-					quantity, quality, lang, lastUpdate) 
+					quantity, quality, lang, lastUpdate,
+					foil, grader, grade, location) 
 			VALUES
 				(specOwner, specCollection, specCardName,
 				specSetName, specComment,
-				specQuantity, specQuality, specLang, specTime);
+				specQuantity, specQuality, specLang, specTime,
+				specFoil, specGrader, specGrade, specLocation);
             RETURN;
         EXCEPTION WHEN unique_violation THEN
             -- do nothing, and loop to try the UPDATE again
@@ -380,18 +804,140 @@ $$
 LANGUAGE plpgsql;
 
 
+/*
+Create the outbox of emails waiting to be delivered.
+
+Messages are rendered and inserted in the same transaction as the change
+that prompted them so neither can happen without the other. A background
+sender claims due messages by pushing nextAttempt forward then marks
+them sent or backs off.
+
+status is one of 'pending', 'sent', or 'dead'. Dead messages exhausted
+their attempts and need looking at by hand.
+
+Bodies hold reset and verification codes in plaintext so they're
+blanked once a message is sent or dead, only the recipient, subject
+and any error remain for debugging.
+*/
+CREATE TABLE users.outbox (
+	id bigserial NOT NULL,
+	recipient TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	body TEXT NOT NULL,
+	-- Empty when the message is plaintext alone
+	html TEXT NOT NULL DEFAULT '',
+	
+	status TEXT NOT NULL DEFAULT 'pending',
+	attempts int NOT NULL DEFAULT 0,
+	lastError TEXT NOT NULL DEFAULT '',
+	
+	created timestamp NOT NULL,
+	nextAttempt timestamp NOT NULL,
+	
+	CONSTRAINT uniqueOutboxID UNIQUE (id)
+);
+
+CREATE INDEX outbox_due_index on users.outbox(status, nextAttempt);
+
+
+/*
+Create the append only log of security relevant account events.
+
+name is the user the event concerns while actor is who performed it,
+usually the user themselves but also 'anonymous' for unauthenticated
+attempts or 'stripe' for changes it pushed to us. metadata is a json
+encoded object of string details.
+
+There is deliberately no reference to users.meta, rows are only ever
+removed when their user deletes their account.
+*/
+CREATE TABLE users.auditLog (
+	name standardText NOT NULL,
+	actor TEXT NOT NULL,
+	ip TEXT NOT NULL,
+	event TEXT NOT NULL,
+	metadata TEXT NOT NULL DEFAULT '{}',
+	
+	at timestamp NOT NULL
+);
+
+CREATE INDEX audit_name_index on users.auditLog(name, at);
+
+
+/*
+Create the table of staff allowed to use the admin service.
+
+Admins are entirely separate from users.meta, they authenticate with a
+long lived key of which only the sha256 hash is stored. role is one of
+'support', 'billing', or 'superuser'.
+
+The first superuser is added by hand, they can then add everyone else
+through the service. eg. for a key generated with 'openssl rand -hex 32'
+
+INSERT INTO users.admins (name, role, keyHash, created)
+VALUES ('everlag', 'superuser',
+	digest('theGeneratedKey', 'sha256'), now());
+
+where digest is provided by the pgcrypto extension.
+*/
+CREATE TABLE users.admins (
+	name standardText NOT NULL,
+	role TEXT NOT NULL,
+	keyHash bytea NOT NULL,
+	
+	created timestamp NOT NULL,
+	
+	CONSTRAINT uniqueAdminName UNIQUE (name),
+	CONSTRAINT uniqueAdminKey UNIQUE (keyHash)
+);
+
+/*
+Create the append only log of everything done through the admin service.
+
+Unlike users.auditLog this is kept when the target deletes their account,
+it records what staff did rather than what happened to a user. metadata
+is a json encoded object of string details.
+*/
+CREATE TABLE users.adminLog (
+	admin standardText NOT NULL references users.admins(name),
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	metadata TEXT NOT NULL DEFAULT '{}',
+	
+	at timestamp NOT NULL
+);
+
+CREATE INDEX adminlog_target_index on users.adminLog(target, at);
+
+
 /*
 Lock all permissions down to minimum.
 
 NOTE: Do this as user 'postgres' in the userdata table!
 
 *Assume select for each*
-users.meta - insert and update
+users.meta - insert, update, and delete (account deletion)
+users.subs - insert, update, and delete (account deletion)
 users.Sessions - insert and delete
 users.Resets - insert and delete
+users.Verifications - insert and delete
+users.totp - insert, update, and delete
+users.recoveryCodes - insert and delete
+users.loginChallenges - insert and delete
+users.loginAttempts - insert, update, and delete
+users.webhookEvents - insert
+users.plans - none
+users.coupons - update
+users.apiUsage - insert, update, and delete
+users.outbox - insert and update
+users.auditLog - insert and delete (account deletion)
+users.admins - insert
+users.adminLog - insert
 users.Collections - insert, update, and delete
-users.CollectionContents - insert and update
-users.CollectionHistory - insert
+users.CollectionContents - insert, update, and delete
+users.CollectionHistory - insert and delete (account deletion)
+users.trades - insert, update, and delete (account deletion)
+users.cardValues - insert and delete
 */
 
 /*Make sure all permissions are OFF by default*/
@@ -406,22 +952,62 @@ GRANT usage ON SCHEMA PUBLIC TO usermanager;
 GRANT usage ON SCHEMA users TO usermanager;
 
 /*Whitelist all usage per table*/
-GRANT select, insert, update ON TABLE users.meta to userManager;
+/*Users are only ever deleted at their own request*/
+GRANT select, insert, update, delete ON TABLE users.meta to userManager;
 
-/*Subs cannot be deleted, only added or altered*/
-GRANT select, insert, update ON TABLE users.subs to userManager;
+/*Subs are only deleted alongside their user*/
+GRANT select, insert, update, delete ON TABLE users.subs to userManager;
 
 /*Sessions and resets can be deleted with no issue*/
 GRANT select, insert, delete ON TABLE users.sessions to userManager;
 GRANT select, insert, delete ON TABLE users.resets to userManager;
+GRANT select, insert, delete ON TABLE users.verifications to userManager;
+
+/*Second factors can be replaced or removed entirely*/
+GRANT select, insert, update, delete ON TABLE users.totp to userManager;
+GRANT select, insert, delete ON TABLE users.recoveryCodes to userManager;
+GRANT select, insert, delete ON TABLE users.loginChallenges to userManager;
+
+/*Failures are cleared on a successful login*/
+GRANT select, insert, update, delete ON TABLE users.loginAttempts to userManager;
+
+/*Handled events are only ever recorded*/
+GRANT select, insert ON TABLE users.webhookEvents to userManager;
+
+/*Plans are only ever changed by hand*/
+GRANT select ON TABLE users.plans to userManager;
+GRANT select, update ON TABLE users.coupons to userManager;
+GRANT select, insert, update, delete ON TABLE users.apiUsage to userManager;
+
+/*Sent mail is kept, without its contents, for debugging*/
+GRANT select, insert, update ON TABLE users.outbox to userManager;
+GRANT usage ON SEQUENCE users.outbox_id_seq to userManager;
+
+/*The audit log is append only apart from account deletion*/
+GRANT select, insert, delete ON TABLE users.auditLog to userManager;
+
+/*Admins are never removed and their actions never forgotten*/
+GRANT select, insert ON TABLE users.admins to userManager;
+GRANT select, insert ON TABLE users.adminLog to userManager;
 
 /*Collections needs to be capable of being deleted*/
 GRANT select, insert, update, delete ON TABLE users.collections to userManager;
 
-GRANT select, insert, update ON TABLE users.collectionContents to userManager;
+GRANT select, insert, update, delete ON TABLE users.collectionContents to userManager;
+
+/*
+Append only collection history is VERY important
+
+The only exception is its owner deleting their account.
+*/
+GRANT select, insert, delete ON TABLE users.collectionHistory to userManager;
+
+/*Trades are only ever marked reverted, or deleted with their owner*/
+GRANT select, insert, update, delete ON TABLE users.trades to userManager;
+GRANT usage ON SEQUENCE users.trades_id_seq to userManager;
 
-/*Append only collection history is VERY important*/
-GRANT select, insert ON TABLE users.collectionHistory to userManager;
+/*Values are replaced wholesale as prices move*/
+GRANT select, insert, delete ON TABLE users.cardValues to userManager;
 
 /*
 Set a backup user up so we are able to remotely dump table contents and
@@ -73,6 +73,21 @@ var setNames = []string{
 	"Return to Ravnica",
 }
 
+var Locations = []string{
+	"",
+	"Binder",
+	"Trade Binder",
+	"Deck Box",
+}
+
+// Grader and grade pairs, including the ungraded
+var Gradings = [][2]string{
+	{"", ""},
+	{"PSA", "10"},
+	{"BGS", "9.5"},
+	{"CGC", "7"},
+}
+
 var Qualities = []string{
 	"NM",
 	"LP",
@@ -96,6 +111,8 @@ var Langs = []string{
 // Generates a random card
 func randomCard() Card {
 
+	grading:= Gradings[rand.Intn(len(Gradings))]
+
 	return  Card{
 		Name: randomElement(cardNames),
 		Set: randomElement(setNames),
@@ -104,6 +121,18 @@ func randomCard() Card {
 		Comment: randString(int(randByte()) % 20),
 		Quantity: int32(rand.Intn(6)),
 		LastUpdate: randomTime(),
+		Foil: rand.Intn(2) == 0,
+		Grader: grading[0],
+		Grade: grading[1],
+		Location: randomElement(Locations),
+	}
+}
+
+// Strips a card down to what sets its row in a collection apart
+func printingOf(c Card) Card {
+	return Card{
+		Name: c.Name, Set: c.Set, Quality: c.Quality, Lang: c.Lang,
+		Foil: c.Foil, Grader: c.Grader, Grade: c.Grade, Location: c.Location,
 	}
 }
 
@@ -0,0 +1,17 @@
+/*
+Adds an admin able to use the admin service
+
+Nothing is added when the name is taken.
+
+Takes:
+	name - string, who the admin is
+	role - string, what the admin may do
+	keyHash - []byte, hash of the key the admin authenticates with
+	created - timestamp, when the admin was added
+*/
+
+INSERT INTO users.admins
+	(name, role, keyHash, created)
+VALUES
+	($1, $2, $3, $4)
+ON CONFLICT (name) DO NOTHING
\ No newline at end of file
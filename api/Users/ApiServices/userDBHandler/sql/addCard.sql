@@ -7,7 +7,10 @@ Could potentially loop forever but good god does postgres need this natively.
 add_card has the format
 	add_card(specOwner TEXT, specCollection TEXT,
 			specCardName TEXT, specSetName TEXT, specComment TEXT,
-			specQuantity int, specQuality possibleQuality, specTime timestamp)
+			specQuantity int, specQuality possibleQuality,
+			specLang possibleLanguage, specTime timestamp,
+			specFoil boolean, specGrader TEXT, specGrade TEXT,
+			specLocation TEXT)
 
 Takes:
 	owner - string, user that owns it
@@ -18,6 +21,11 @@ Takes:
 	quality - string, a defined quality
 	lang - string, a language in mtg
 	quantity - int, how many cards
+	lastUpdate - timestamp, when the change was made
+	foil - bool, whether the cards are foil
+	grader - string, who graded the cards or empty
+	grade - string, the grade they were given or empty
+	location - string, where the cards are kept or empty
 */
 
-SELECT add_card($1, $2, $3, $4, $5, $6, $7, $8, $9);
\ No newline at end of file
+SELECT add_card($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
\ No newline at end of file
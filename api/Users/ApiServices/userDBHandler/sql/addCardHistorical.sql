@@ -1,18 +1,25 @@
-/*
-Inserts a row into the userCollectionContents.
-
-Takes:
-	owner - string, user that owns it
-	collection - string, collection of that user
-	cardName - string, the mtg card
-	setName - string, the mtg set
-	comment - string, a user comment
-	quality - string, a defined quality
-	lang - string, a language in mtg
-	quantity - int, how many cards
-*/
-
-INSERT INTO users.collectionHistory 
-(owner, collection, cardName, setName, comment, quantity, quality, lang, lastUpdate) 
-VALUES
-($1, $2, $3, $4, $5, $6, $7, $8, $9)
\ No newline at end of file
+/*
+Inserts a row into the userCollectionContents.
+
+Takes:
+	owner - string, user that owns it
+	collection - string, collection of that user
+	cardName - string, the mtg card
+	setName - string, the mtg set
+	comment - string, a user comment
+	quality - string, a defined quality
+	lang - string, a language in mtg
+	quantity - int, how many cards
+	lastUpdate - timestamp, when the change was made
+	tradeID - int, the trade that made it
+	foil - bool, whether the cards are foil
+	grader - string, who graded the cards or empty
+	grade - string, the grade they were given or empty
+	location - string, where the cards are kept or empty
+*/
+
+INSERT INTO users.collectionHistory 
+(owner, collection, cardName, setName, comment, quantity, quality, lang, lastUpdate, tradeID,
+	foil, grader, grade, location) 
+VALUES
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
\ No newline at end of file
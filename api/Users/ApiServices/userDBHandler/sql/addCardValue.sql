@@ -0,0 +1,14 @@
+/*
+Records what a single copy of a printing is currently worth
+
+Takes:
+	cardName - string, name of the card
+	setName - string, set it was printed in
+	price - int, value in cents
+	updated - timestamp, when the value was taken
+*/
+
+INSERT INTO users.cardValues
+	(cardName, setName, price, updated)
+VALUES
+	($1, $2, $3, $4)
\ No newline at end of file
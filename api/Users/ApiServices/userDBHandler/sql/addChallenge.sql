@@ -0,0 +1,13 @@
+/*
+Sends a sanely derived login challenge off to the database
+
+Takes:
+	name - string, user that owns it
+	challengeKey - []byte, the hash of a challenge
+	startValid, endValid - timestamps, for preventing abuse
+*/
+
+INSERT INTO users.loginChallenges 
+(name, challengeKey, startValid, endValid) 
+VALUES
+($1, $2, $3, $4)
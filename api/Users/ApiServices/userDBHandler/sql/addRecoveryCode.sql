@@ -0,0 +1,12 @@
+/*
+Sends the hash of a recovery code off to the database
+
+Takes:
+	name - string, user that owns it
+	codeHash - []byte, sha256 of the recovery code
+*/
+
+INSERT INTO users.recoveryCodes 
+(name, codeHash) 
+VALUES
+($1, $2)
@@ -0,0 +1,12 @@
+/*
+Sends a freshly enrolled, not yet enabled, second factor off to the database
+
+Takes:
+	name - string, user that owns it
+	secret - string, the base32 shared secret
+*/
+
+INSERT INTO users.totp 
+(name, secret) 
+VALUES
+($1, $2)
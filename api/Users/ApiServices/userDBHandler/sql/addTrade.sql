@@ -0,0 +1,15 @@
+/*
+Records a trade against a user's collection, returning its id.
+
+Takes:
+	owner - string, user that owns it
+	collection - string, collection of that user
+	submitted - timestamp, when it was submitted
+	revertOf - int, the trade this one reverts or 0
+*/
+
+INSERT INTO users.trades
+	(owner, collection, submitted, revertOf)
+VALUES
+	($1, $2, $3, $4)
+RETURNING id
\ No newline at end of file
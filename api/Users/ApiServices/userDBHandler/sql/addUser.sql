@@ -6,11 +6,12 @@ Max collections is set to the default of 3.
 Takes:
 	name - string, user that owns it
 	email - string, the address we can contact a user at
-	passHash - bytea, the result of scrypt(password, nonce)
+	passHash - bytea, the result of deriving the password with nonce
 	nonce - bytea, the nonce used for deriving passHash
+	hashParams - string, the algorithm and cost passHash was derived with
 */
 
 INSERT INTO users.meta 
-(name, email, passHash, nonce) 
+(name, email, passHash, nonce, hashParams) 
 VALUES
-($1, $2, $3, $4)
\ No newline at end of file
+($1, $2, $3, $4, $5)
\ No newline at end of file
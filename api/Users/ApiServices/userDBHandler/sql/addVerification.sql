@@ -0,0 +1,14 @@
+/*
+Sends a sanely derived verification off to the database
+
+Takes:
+	name - string, user that owns it
+	email - string, the address being verified
+	verifyKey - []byte, the hash of a valid verification key
+	startValid, endValid - timestamps, for preventing abuse
+*/
+
+INSERT INTO users.verifications 
+(name, email, verifyKey, startValid, endValid) 
+VALUES
+($1, $2, $3, $4, $5)
@@ -0,0 +1,13 @@
+/*
+Records a webhook event as handled if it hasn't been already.
+
+Affects no rows for an event we've already seen.
+
+Takes:
+	eventID - string, the event id as provided by stripe
+	received - timestamp, when we handled it
+*/
+
+INSERT INTO users.webhookEvents (eventID, received)
+SELECT $1, $2
+WHERE NOT EXISTS (SELECT 1 FROM users.webhookEvents WHERE eventID=$1)
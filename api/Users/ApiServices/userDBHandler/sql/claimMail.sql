@@ -0,0 +1,26 @@
+/*
+
+Claims a batch of pending emails that are due for delivery.
+
+Claiming pushes nextAttempt out to the lease so another sender won't
+pick the same message up while it is being delivered. The outer WHERE
+is rechecked after locking so concurrent claims don't overlap.
+
+Takes:
+	now - timestamp, the current time
+	lease - timestamp, when a claimed message becomes due again
+	count - int, the most messages to claim
+*/
+
+UPDATE users.outbox
+	SET nextAttempt = $2
+WHERE
+	id IN (
+		SELECT id FROM users.outbox
+		WHERE status = 'pending' AND nextAttempt <= $1
+		ORDER BY nextAttempt
+		LIMIT $3
+	) AND
+	status = 'pending' AND
+	nextAttempt <= $1
+RETURNING id, recipient, subject, body, html, attempts;
@@ -0,0 +1,10 @@
+/*
+Claims a user's one trial, affecting no rows when it's already been used
+
+Takes:
+	name - string, user that owns it
+*/
+
+UPDATE users.subs
+SET trialed = true
+WHERE name=$1 AND NOT trialed
\ No newline at end of file
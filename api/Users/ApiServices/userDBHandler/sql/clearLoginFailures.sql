@@ -0,0 +1,9 @@
+/*
+Forgets failed logins after a successful one
+
+Takes:
+	kind - string, either 'name' or 'ip'
+	identifier - string, the name or ip
+*/
+
+DELETE FROM users.loginAttempts WHERE kind=$1 AND identifier=$2
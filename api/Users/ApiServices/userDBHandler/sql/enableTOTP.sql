@@ -0,0 +1,12 @@
+/*
+Marks a user's second factor as enabled after a code has been provided
+
+Takes:
+	name - string, user that owns it
+	lastCounter - int64, the period the enabling code was valid in
+*/
+
+UPDATE users.totp
+SET enabled = true, lastCounter = $2
+WHERE
+name=$1
@@ -0,0 +1,16 @@
+/*
+
+Adds a rendered email to users.outbox to be delivered as soon as possible.
+
+Takes:
+	recipient - string, formatted address the message goes to
+	subject - string, subject line
+	body - string, fully rendered plaintext body
+	time - timestamp, when it was queued
+	html - string, fully rendered html alternative or empty
+*/
+
+INSERT INTO users.outbox
+	(recipient, subject, body, created, nextAttempt, html)
+VALUES
+	($1, $2, $3, $4, $4, $5);
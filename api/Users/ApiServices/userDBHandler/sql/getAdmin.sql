@@ -0,0 +1,10 @@
+/*
+Acquires the admin holding the provided key
+
+Takes:
+	keyHash - []byte, hash of the key the admin authenticated with
+*/
+
+SELECT name, role, keyHash
+FROM
+users.admins WHERE keyHash=$1
\ No newline at end of file
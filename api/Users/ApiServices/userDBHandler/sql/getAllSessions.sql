@@ -0,0 +1,13 @@
+/*
+Acquires when every session a user has been issued, expired or not,
+was valid. The keys themselves are deliberately left out.
+
+Takes:
+	name - string, user that owns it
+*/
+
+SELECT startValid, endValid
+FROM
+users.sessions
+WHERE name=$1
+ORDER BY startValid
\ No newline at end of file
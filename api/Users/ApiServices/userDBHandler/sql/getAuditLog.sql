@@ -0,0 +1,14 @@
+/*
+Acquires the most recent security events for a user, newest first
+
+Takes:
+	name - string, user the events concern
+	limit - int, the most events to return
+*/
+
+SELECT name, actor, ip, event, metadata, at
+FROM
+users.auditLog
+WHERE name=$1
+ORDER BY at DESC
+LIMIT $2
\ No newline at end of file
@@ -0,0 +1,12 @@
+/*
+Acquires every login challenge for a provided user that matches
+the provided challenge and is valid.
+
+Takes:
+	name - string, user that owns it
+	challengeKey - []byte, the hash of a challenge
+*/
+
+SELECT name, challengeKey, startValid, endValid
+FROM users.loginChallenges
+WHERE name=$1 AND challengeKey=$2 AND endValid > now()
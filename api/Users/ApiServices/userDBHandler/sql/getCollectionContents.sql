@@ -6,6 +6,7 @@ Takes:
 	collection - string, collection of that user
 */
 
-SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate
+SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate,
+	foil, grader, grade, location
 FROM
 users.collectionContents WHERE owner=$1 AND collection=$2
\ No newline at end of file
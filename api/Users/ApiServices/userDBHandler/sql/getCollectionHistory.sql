@@ -1,11 +1,13 @@
 /*
-Acquires the total history of a user's collection.
+Acquires the history of a user's collection their plan may view.
 
 Takes:
 	owner - string, user that owns it
 	collection - string, collection of that user
+	since - timestamp, changes before this are hidden
 */
 
-SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate
+SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate,
+	foil, grader, grade, location
 FROM
-users.collectionHistory WHERE owner=$1 AND collection=$2
\ No newline at end of file
+users.collectionHistory WHERE owner=$1 AND collection=$2 AND lastUpdate >= $3
\ No newline at end of file
@@ -0,0 +1,47 @@
+/*
+Acquires a page of the history of a user's collection their plan may
+view matching the provided filters, newest first.
+
+Takes:
+	owner - string, user that owns it
+	collection - string, collection of that user
+	since - timestamp, changes before this are hidden
+	set - string, exact set or empty for any
+	name - string, ILIKE pattern for the card name
+	quality - string, exact quality or empty for any
+	lang - string, exact language or empty for any
+	comment - string, ILIKE pattern for the comment
+	location - string, exact location or empty for any
+	resume - bool, whether to start after the following key
+	lastUpdate - timestamp, unique key of the last row seen
+	cardName - string, unique key of the last row seen
+	setName - string, unique key of the last row seen
+	quality - string, unique key of the last row seen
+	lang - string, unique key of the last row seen
+	foil - bool, unique key of the last row seen
+	grader - string, unique key of the last row seen
+	grade - string, unique key of the last row seen
+	location - string, unique key of the last row seen
+	limit - int, the most rows to return or NULL for all
+*/
+
+SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate,
+	foil, grader, grade, location
+FROM
+users.collectionHistory
+WHERE owner=$1 AND collection=$2 AND lastUpdate >= $3 AND
+	($4::text = '' OR setName = $4::text) AND
+	cardName ILIKE $5::text AND
+	($6::text = '' OR quality::text = $6::text) AND
+	($7::text = '' OR lang::text = $7::text) AND
+	comment ILIKE $8::text AND
+	($9::text = '' OR location = $9::text) AND
+	(NOT $10::boolean OR
+		(lastUpdate, cardName::text, setName::text, quality::text, lang::text,
+			foil, grader::text, grade::text, location::text) <
+		($11::timestamp, $12::text, $13::text, $14::text, $15::text,
+			$16::boolean, $17::text, $18::text, $19::text))
+ORDER BY lastUpdate DESC, cardName::text DESC, setName::text DESC,
+	quality::text DESC, lang::text DESC,
+	foil DESC, grader::text DESC, grade::text DESC, location::text DESC
+LIMIT $20
\ No newline at end of file
@@ -0,0 +1,92 @@
+/*
+Acquires a page of a user's collection matching the provided filters.
+
+Rows are ordered by the chosen sort then by their unique key so a page
+can resume exactly where the previous one ended. Value is the quantity
+held multiplied by users.cardValues, zero for cards without one. Foils
+are valued as their ' Foil' suffixed set.
+
+Takes:
+	owner - string, user that owns it
+	collection - string, collection of that user
+	set - string, exact set or empty for any
+	name - string, ILIKE pattern for the card name
+	quality - string, exact quality or empty for any
+	lang - string, exact language or empty for any
+	comment - string, ILIKE pattern for the comment
+	location - string, exact location or empty for any
+	sort - string, one of name, quantity, updated, or value
+	descending - bool, whether to reverse the order
+	resume - bool, whether to start after the following key
+	sortText - string, sort key of the last row seen
+	sortNum - int64, sort key of the last row seen
+	cardName - string, unique key of the last row seen
+	setName - string, unique key of the last row seen
+	quality - string, unique key of the last row seen
+	lang - string, unique key of the last row seen
+	foil - bool, unique key of the last row seen
+	grader - string, unique key of the last row seen
+	grade - string, unique key of the last row seen
+	location - string, unique key of the last row seen
+	limit - int, the most rows to return or NULL for all
+*/
+
+WITH matching AS (
+	SELECT contents.cardName, contents.setName,
+		contents.quality::text AS quality, contents.quantity,
+		contents.comment, contents.lang::text AS lang, contents.lastUpdate,
+		contents.foil, contents.grader::text AS grader,
+		contents.grade::text AS grade, contents.location::text AS location,
+		CASE WHEN $9::text = 'name' THEN contents.cardName::text
+			ELSE '' END AS sortText,
+		CASE $9::text
+			WHEN 'quantity' THEN contents.quantity::bigint
+			WHEN 'updated' THEN
+				(extract(epoch from contents.lastUpdate) * 1000000)::bigint
+			WHEN 'value' THEN
+				COALESCE(vals.price, 0)::bigint * contents.quantity
+			ELSE 0 END AS sortNum
+	FROM
+	users.collectionContents contents
+	LEFT JOIN users.cardValues vals
+		ON vals.cardName = contents.cardName AND
+			vals.setName = contents.setName ||
+				CASE WHEN contents.foil THEN ' Foil' ELSE '' END
+	WHERE contents.owner=$1 AND contents.collection=$2 AND
+		($3::text = '' OR contents.setName = $3::text) AND
+		contents.cardName ILIKE $4::text AND
+		($5::text = '' OR contents.quality::text = $5::text) AND
+		($6::text = '' OR contents.lang::text = $6::text) AND
+		contents.comment ILIKE $7::text AND
+		($8::text = '' OR contents.location = $8::text)
+)
+SELECT cardName, setName, quality, quantity, comment, lang, lastUpdate,
+	foil, grader, grade, location,
+	sortText, sortNum
+FROM
+matching
+WHERE NOT $11::boolean OR
+	($10::boolean AND
+		(sortText, sortNum, cardName::text, setName::text, quality, lang,
+			foil, grader, grade, location) <
+		($12::text, $13::bigint, $14::text, $15::text, $16::text, $17::text,
+			$18::boolean, $19::text, $20::text, $21::text)) OR
+	(NOT $10::boolean AND
+		(sortText, sortNum, cardName::text, setName::text, quality, lang,
+			foil, grader, grade, location) >
+		($12::text, $13::bigint, $14::text, $15::text, $16::text, $17::text,
+			$18::boolean, $19::text, $20::text, $21::text))
+ORDER BY
+	CASE WHEN $10::boolean THEN sortText END DESC,
+	CASE WHEN $10::boolean THEN sortNum END DESC,
+	CASE WHEN $10::boolean THEN cardName::text END DESC,
+	CASE WHEN $10::boolean THEN setName::text END DESC,
+	CASE WHEN $10::boolean THEN quality END DESC,
+	CASE WHEN $10::boolean THEN lang END DESC,
+	CASE WHEN $10::boolean THEN foil END DESC,
+	CASE WHEN $10::boolean THEN grader END DESC,
+	CASE WHEN $10::boolean THEN grade END DESC,
+	CASE WHEN $10::boolean THEN location END DESC,
+	sortText, sortNum, cardName::text, setName::text, quality, lang,
+	foil, grader, grade, location
+LIMIT $22
\ No newline at end of file
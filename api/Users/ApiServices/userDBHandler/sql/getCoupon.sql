@@ -0,0 +1,10 @@
+/*
+Acquires a promo code
+
+Takes:
+	code - string, the code a user entered
+*/
+
+SELECT code, plan, validuntil, maxredemptions, redemptions
+FROM
+users.coupons WHERE code=$1
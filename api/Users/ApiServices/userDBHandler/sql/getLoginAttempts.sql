@@ -0,0 +1,11 @@
+/*
+Acquires the failed login records for both an account and an ip
+
+Takes:
+	name - string, the account being logged into
+	ip - string, the address the attempt came from
+*/
+
+SELECT kind, identifier, failures, lastFailure, lockedUntil
+FROM users.loginAttempts
+WHERE (kind='name' AND identifier=$1) OR (kind='ip' AND identifier=$2)
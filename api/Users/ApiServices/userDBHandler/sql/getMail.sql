@@ -0,0 +1,11 @@
+/*
+
+Fetches a single message from the outbox regardless of its state.
+
+Takes:
+	id - bigint, the message to fetch
+*/
+
+SELECT id, recipient, subject, body, html, attempts FROM users.outbox
+WHERE
+	id = $1;
\ No newline at end of file
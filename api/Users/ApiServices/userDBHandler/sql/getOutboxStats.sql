@@ -0,0 +1,11 @@
+/*
+
+Summarizes users.outbox by status.
+
+Returns each status present with how many messages have it and
+when the oldest of them was queued.
+*/
+
+SELECT status, count(*), min(created)
+FROM users.outbox
+GROUP BY status;
@@ -0,0 +1,11 @@
+/*
+Acquires a plan and its entitlements
+
+Takes:
+	name - string, the plan
+*/
+
+SELECT name, maxcollections, historywindow, maxalerts, export, dailyquota,
+	trialdays
+FROM
+users.plans WHERE name=$1
@@ -0,0 +1,8 @@
+/*
+Acquires every plan and its entitlements, cheapest first
+*/
+
+SELECT name, maxcollections, historywindow, maxalerts, export, dailyquota,
+	trialdays
+FROM
+users.plans ORDER BY maxcollections
@@ -5,6 +5,6 @@ Takes:
 	name - string, user that owns it
 */
 
-SELECT name, Plan, CustomerID, SubID, StartTime
+SELECT name, Plan, CustomerID, SubID, StartTime, trialed
 FROM
 users.subs WHERE name=$1
\ No newline at end of file
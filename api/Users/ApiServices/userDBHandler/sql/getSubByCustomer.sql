@@ -0,0 +1,10 @@
+/*
+Acquires a subscription by its stripe customer with no authentication
+
+Takes:
+	customerID - string, the customers id as provided by stripe
+*/
+
+SELECT name, Plan, CustomerID, SubID, StartTime, trialed
+FROM
+users.subs WHERE customerID=$1
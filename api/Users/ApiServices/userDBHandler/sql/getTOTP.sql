@@ -0,0 +1,10 @@
+/*
+Acquires the second factor of a user with no authentication
+
+Takes:
+	name - string, user that owns it
+*/
+
+SELECT name, secret, enabled, lastCounter
+FROM
+users.totp WHERE name=$1
@@ -0,0 +1,13 @@
+/*
+Acquires a trade a user submitted, locking it until the transaction ends
+so it can only be reverted once.
+
+Takes:
+	id - int, the trade
+	owner - string, user that owns it
+*/
+
+SELECT collection, submitted, revertOf, revertedBy
+FROM users.trades
+WHERE id=$1 AND owner=$2
+FOR UPDATE
\ No newline at end of file
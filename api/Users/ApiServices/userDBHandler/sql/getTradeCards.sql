@@ -0,0 +1,15 @@
+/*
+Acquires the net change a trade made to each printing, alongside the
+comment it last left.
+
+Takes:
+	owner - string, user that owns it
+	tradeID - int, the trade
+*/
+
+SELECT cardName, setName, quality, SUM(quantity)::int,
+	(array_agg(comment ORDER BY lastUpdate DESC))[1], lang, MAX(lastUpdate),
+	foil, grader, grade, location
+FROM users.collectionHistory
+WHERE owner=$1 AND tradeID=$2
+GROUP BY cardName, setName, quality, lang, foil, grader, grade, location
\ No newline at end of file
@@ -5,6 +5,6 @@ Takes:
 	name - string, user that owns it
 */
 
-SELECT name, email, passhash, nonce, maxcollections, longestview
+SELECT name, email, passhash, nonce, hashparams, maxcollections, longestview, verified, locale, disabled
 FROM
 users.meta WHERE name=$1
\ No newline at end of file
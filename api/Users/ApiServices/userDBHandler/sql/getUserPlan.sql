@@ -0,0 +1,12 @@
+/*
+Acquires the plan a user is subscribed to with no authentication
+
+Takes:
+	name - string, user that owns it
+*/
+
+SELECT p.name, p.maxcollections, p.historywindow,
+	p.maxalerts, p.export, p.dailyquota, p.trialdays
+FROM
+users.subs s JOIN users.plans p ON s.plan = p.name
+WHERE s.name=$1
@@ -0,0 +1,12 @@
+/*
+Acquires every verification for a provided user that matches
+the provided verification key and is valid.
+
+Takes:
+	name - string, user that owns it
+	verifyKey - []byte, the hash of a verification key
+*/
+
+SELECT name, email, verifyKey, startValid, endValid
+FROM users.verifications
+WHERE name=$1 AND verifyKey=$2 AND endValid > now()
@@ -0,0 +1,21 @@
+/*
+
+Records a failed delivery attempt.
+
+Dead messages have their contents blanked as they may hold reset or
+verification codes which can never be delivered now.
+
+Takes:
+	id - bigint, the message that failed
+	status - string, 'pending' to retry or 'dead' to give up
+	error - string, why the attempt failed
+	nextAttempt - timestamp, when to try again
+*/
+
+UPDATE users.outbox
+	SET status = $2, attempts = attempts + 1,
+		lastError = $3, nextAttempt = $4,
+		body = CASE WHEN $2 = 'dead' THEN '' ELSE body END,
+		html = CASE WHEN $2 = 'dead' THEN '' ELSE html END
+WHERE
+	id = $1;
@@ -0,0 +1,18 @@
+/*
+
+Records an email as successfully delivered.
+
+The contents are blanked as they may hold reset or verification codes,
+the recipient and subject are kept for debugging.
+
+Takes:
+	id - bigint, the message that was sent
+	time - timestamp, when it was sent
+*/
+
+UPDATE users.outbox
+	SET status = 'sent', attempts = attempts + 1,
+		lastError = '', nextAttempt = $2,
+		body = '', html = ''
+WHERE
+	id = $1;
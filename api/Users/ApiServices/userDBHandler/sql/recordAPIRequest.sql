@@ -0,0 +1,12 @@
+/*
+
+UPSERTs into users.apiUsage using the record_api_request function.
+
+Returns how many requests the user has made that day.
+
+Takes:
+	name - string, user making the request
+	day - date, the day it was made on
+*/
+
+SELECT record_api_request($1, $2);
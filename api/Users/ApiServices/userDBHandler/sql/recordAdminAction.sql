@@ -0,0 +1,15 @@
+/*
+Appends an action taken through the admin service to users.adminLog
+
+Takes:
+	admin - string, who took the action
+	action - string, what they did
+	target - string, who or what they did it to
+	metadata - string, json encoded details
+	at - timestamp, when it happened
+*/
+
+INSERT INTO users.adminLog
+	(admin, action, target, metadata, at)
+VALUES
+	($1, $2, $3, $4, $5)
\ No newline at end of file
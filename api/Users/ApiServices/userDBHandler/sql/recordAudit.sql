@@ -0,0 +1,19 @@
+/*
+Appends a security event to users.auditLog
+
+Events about names that have no user are dropped so failed logins
+against made up names do not pile up.
+
+Takes:
+	name - string, user the event concerns
+	actor - string, who performed it
+	ip - string, where the request came from
+	event - string, what happened
+	metadata - string, json encoded details
+	at - timestamp, when it happened
+*/
+
+INSERT INTO users.auditLog
+	(name, actor, ip, event, metadata, at)
+SELECT $1::text, $2::text, $3::text, $4::text, $5::text, $6::timestamp
+WHERE EXISTS (SELECT 1 FROM users.meta WHERE name=$1::text)
\ No newline at end of file
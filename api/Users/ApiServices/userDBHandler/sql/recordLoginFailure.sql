@@ -0,0 +1,14 @@
+/*
+
+UPSERTs into users.loginAttempts using the record_login_failure function.
+
+Returns the number of consecutive failures.
+
+Takes:
+	kind - string, either 'name' or 'ip'
+	identifier - string, the name or ip
+	time - timestamp, when the failure occurred
+	since - timestamp, failures before this are forgotten
+*/
+
+SELECT record_login_failure($1, $2, $3, $4);
@@ -0,0 +1,16 @@
+/*
+Blanks the address and contents of mail that was already sent, or gave
+up on, to a user. Pending mail is left to be delivered.
+
+Addresses are formatted as NAME<EMAIL> so the name prefix is
+unambiguous.
+
+Takes:
+	name - string, user the mail was sent to
+*/
+
+UPDATE users.outbox
+	SET recipient = '', subject = '', body = '', html = ''
+WHERE
+	status <> 'pending' AND
+	left(recipient, length($1) + 1) = $1 || '<'
\ No newline at end of file
@@ -0,0 +1,18 @@
+/*
+Counts a redemption of a code only if it may still be used for a plan.
+
+Affects no rows if the code is invalid, expired, exhausted, or for
+another plan.
+
+Takes:
+	code - string, the code a user entered
+	plan - string, the plan being subscribed to
+	time - timestamp, when it is being redeemed
+*/
+
+UPDATE users.coupons
+SET redemptions = redemptions + 1
+WHERE code=$1 AND
+	(plan IS NULL OR plan=$2) AND
+	validuntil > $3 AND
+	(maxredemptions = 0 OR redemptions < maxredemptions)
@@ -0,0 +1,16 @@
+/*
+Upgrades a user's password hash, only if it hasn't changed since
+it was read.
+
+Takes:
+	name - string, user that owns it
+	passHash - bytea, the result of deriving the password with nonce
+	nonce - bytea, the nonce used for deriving passHash
+	hashParams - string, the algorithm and cost passHash was derived with
+	oldPassHash - bytea, the hash being replaced
+*/
+
+UPDATE users.meta
+SET passHash = $2, nonce=$3, hashParams=$4
+WHERE
+name=$1 AND passHash=$5
@@ -0,0 +1,10 @@
+/*
+Returns a redemption of a code after the subscription it was for failed
+
+Takes:
+	code - string, the code a user entered
+*/
+
+UPDATE users.coupons
+SET redemptions = redemptions - 1
+WHERE code=$1 AND redemptions > 0
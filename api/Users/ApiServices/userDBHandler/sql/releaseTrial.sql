@@ -0,0 +1,10 @@
+/*
+Returns a user's trial after the subscription it was for failed
+
+Takes:
+	name - string, user that owns it
+*/
+
+UPDATE users.subs
+SET trialed = false
+WHERE name=$1
\ No newline at end of file
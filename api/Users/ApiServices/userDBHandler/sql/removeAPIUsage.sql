@@ -0,0 +1,8 @@
+/*
+Removes the request counts for a user
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.apiUsage WHERE name=$1
\ No newline at end of file
@@ -0,0 +1,10 @@
+/*
+Removes every security event for a user
+
+Only done when the user themselves is being removed.
+
+Takes:
+	name - string, user the events concern
+*/
+
+DELETE FROM users.auditLog WHERE name=$1
\ No newline at end of file
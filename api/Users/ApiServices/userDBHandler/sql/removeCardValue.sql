@@ -0,0 +1,9 @@
+/*
+Forgets what a printing is worth
+
+Takes:
+	cardName - string, name of the card
+	setName - string, set it was printed in
+*/
+
+DELETE FROM users.cardValues WHERE cardName=$1 AND setName=$2
\ No newline at end of file
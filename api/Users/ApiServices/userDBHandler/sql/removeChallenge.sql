@@ -0,0 +1,9 @@
+/*
+Removes a login challenge once it has been used
+
+Takes:
+	name - string, user that owns it
+	challengeKey - []byte, the hash of a challenge
+*/
+
+DELETE FROM users.loginChallenges WHERE name=$1 AND challengeKey=$2
@@ -0,0 +1,8 @@
+/*
+Removes every login challenge for a user
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.loginChallenges WHERE name=$1
\ No newline at end of file
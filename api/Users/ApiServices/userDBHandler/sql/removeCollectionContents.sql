@@ -0,0 +1,8 @@
+/*
+Removes the contents of every collection a user owns
+
+Takes:
+	owner - string, user that owns it
+*/
+
+DELETE FROM users.collectionContents WHERE owner=$1
\ No newline at end of file
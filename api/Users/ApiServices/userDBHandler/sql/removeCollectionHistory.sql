@@ -0,0 +1,8 @@
+/*
+Removes the entire history of every collection a user owns
+
+Takes:
+	owner - string, user that owns it
+*/
+
+DELETE FROM users.collectionHistory WHERE owner=$1
\ No newline at end of file
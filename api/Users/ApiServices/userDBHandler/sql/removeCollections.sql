@@ -0,0 +1,8 @@
+/*
+Removes every collection a user owns, contents must be removed first
+
+Takes:
+	owner - string, user that owns it
+*/
+
+DELETE FROM users.collections WHERE owner=$1
\ No newline at end of file
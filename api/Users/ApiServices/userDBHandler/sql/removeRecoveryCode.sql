@@ -0,0 +1,10 @@
+/*
+Consumes a single recovery code. No row affected means the
+code was not valid.
+
+Takes:
+	name - string, user that owns it
+	codeHash - []byte, sha256 of the recovery code
+*/
+
+DELETE FROM users.recoveryCodes WHERE name=$1 AND codeHash=$2
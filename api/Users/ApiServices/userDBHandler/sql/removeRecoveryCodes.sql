@@ -0,0 +1,8 @@
+/*
+Removes every recovery code for a user
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.recoveryCodes WHERE name=$1
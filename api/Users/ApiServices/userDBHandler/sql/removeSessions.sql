@@ -0,0 +1,8 @@
+/*
+Removes every session for a user
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.sessions WHERE name=$1
\ No newline at end of file
@@ -0,0 +1,10 @@
+/*
+Removes the subscription for a user
+
+Only done when the user themselves is being removed.
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.subs WHERE name=$1
\ No newline at end of file
@@ -0,0 +1,8 @@
+/*
+Removes the second factor for a user
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.totp WHERE name=$1
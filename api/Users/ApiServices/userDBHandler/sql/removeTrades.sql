@@ -0,0 +1,8 @@
+/*
+Removes every trade a user has submitted
+
+Takes:
+	owner - string, user that owns it
+*/
+
+DELETE FROM users.trades WHERE owner=$1
\ No newline at end of file
@@ -0,0 +1,8 @@
+/*
+Removes a user, everything referencing them must be removed first
+
+Takes:
+	name - string, user to remove
+*/
+
+DELETE FROM users.meta WHERE name=$1
\ No newline at end of file
@@ -0,0 +1,8 @@
+/*
+Removes every verification for a user, valid or not
+
+Takes:
+	name - string, user that owns it
+*/
+
+DELETE FROM users.verifications WHERE name=$1
@@ -0,0 +1,14 @@
+/*
+Acquires users whose name or email match a pattern, ordered by name
+
+Takes:
+	pattern - string, an ILIKE pattern
+	limit - int, the most users to return
+*/
+
+SELECT meta.name, meta.email, meta.verified, meta.disabled, subs.plan
+FROM
+users.meta JOIN users.subs ON subs.name = meta.name
+WHERE meta.name ILIKE $1 OR meta.email ILIKE $1
+ORDER BY meta.name
+LIMIT $2
\ No newline at end of file
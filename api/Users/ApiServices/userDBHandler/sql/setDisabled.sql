@@ -0,0 +1,12 @@
+/*
+Sets whether a user is barred from logging in
+
+Takes:
+	name - string, user that owns it
+	disabled - bool, whether they're barred
+*/
+
+UPDATE users.meta
+SET disabled = $2
+WHERE
+name=$1
\ No newline at end of file
@@ -0,0 +1,12 @@
+/*
+Updates a user's email on the database and marks it verified
+
+Takes:
+	name - string, user that owns it
+	email - string, an address the user has proven they control
+*/
+
+UPDATE users.meta
+SET email = $2, verified = true
+WHERE
+name=$1
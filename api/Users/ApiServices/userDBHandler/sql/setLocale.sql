@@ -0,0 +1,12 @@
+/*
+Sets the locale mail to a user is written in
+
+Takes:
+	name - string, user that owns it
+	locale - string, a normalized locale such as en or pt-br
+*/
+
+UPDATE users.meta
+SET locale = $2
+WHERE
+name=$1
\ No newline at end of file
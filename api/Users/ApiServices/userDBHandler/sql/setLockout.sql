@@ -0,0 +1,12 @@
+/*
+Prevents any further attempts being considered until a time
+
+Takes:
+	kind - string, either 'name' or 'ip'
+	identifier - string, the name or ip
+	lockedUntil - timestamp, when attempts may resume
+*/
+
+UPDATE users.loginAttempts
+SET lockedUntil = $3
+WHERE kind=$1 AND identifier=$2
@@ -3,11 +3,12 @@ Updates a user's password on the database
 
 Takes:
 	name - string, user that owns it
-	passHash - bytea, the result of scrypt(password, nonce)
+	passHash - bytea, the result of deriving the password with nonce
 	nonce - bytea, the nonce used for deriving passHash
+	hashParams - string, the algorithm and cost passHash was derived with
 */
 
 UPDATE users.meta
-SET passHash = $2, nonce=$3
+SET passHash = $2, nonce=$3, hashParams=$4
 WHERE
 name=$1
\ No newline at end of file
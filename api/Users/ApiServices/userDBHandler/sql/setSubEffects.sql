@@ -1,13 +1,12 @@
 /*
-Updates a user on the database to have the desired subscription facets
+Updates a user on the database to have the entitlements of a plan
 
 Takes:
 	name - string, user that owns it
-	maxcollections - int, how many collections that user may have
-	longestview - timestamp, how far back into the future a user may view.
-
+	plan - string, the plan they are now subscribed to
 */
 
 UPDATE users.meta
-SET maxcollections = $2, longestview = $3
-WHERE name=$1
\ No newline at end of file
+SET maxcollections = p.maxcollections, longestview = p.historywindow
+FROM users.plans p
+WHERE users.meta.name=$1 AND p.name=$2
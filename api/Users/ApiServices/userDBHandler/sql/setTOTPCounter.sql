@@ -0,0 +1,15 @@
+/*
+Records the period of an accepted code.
+
+Only affects a row when the period is newer than any previously
+accepted, which makes each code single use.
+
+Takes:
+	name - string, user that owns it
+	lastCounter - int64, the period the accepted code was valid in
+*/
+
+UPDATE users.totp
+SET lastCounter = $2
+WHERE
+name=$1 AND lastCounter < $2
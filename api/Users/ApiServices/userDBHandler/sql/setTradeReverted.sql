@@ -0,0 +1,12 @@
+/*
+Marks a trade as undone by another
+
+Takes:
+	id - int, the trade undone
+	revertedBy - int, the trade that undid it
+*/
+
+UPDATE users.trades
+SET revertedBy = $2
+WHERE
+id=$1
\ No newline at end of file
@@ -12,6 +12,8 @@ import(
 type Subscription struct{
 	Name, Plan, CustomerID, SubID string
 	StartTime time.Time
+	// Whether they've already had their one trial
+	Trialed bool
 }
 
 // Adds a new subscription to a user or updates an existing one.
@@ -23,6 +25,13 @@ type Subscription struct{
 // as long as we check to ensure that we aren't setting the same twice.
 func ModSub(pool *pgx.ConnPool, user, sub,
 	customerID, subID string, sessionKey []byte) (error) {
+	return ModSubMail(pool, user, sub, customerID, subID, sessionKey, nil)
+}
+
+// Changes a subscription as ModSub and queues the provided email in
+// the same transaction, the email goes out only if the change sticks.
+func ModSubMail(pool *pgx.ConnPool, user, sub,
+	customerID, subID string, sessionKey []byte, m *Mail) (error) {
 
 	if sessionKey!=nil {
 		err:= SessionAuth(pool, user, sessionKey)
@@ -52,7 +61,8 @@ func ModSub(pool *pgx.ConnPool, user, sub,
 	defer tx.Rollback()
 
 	// Send the new subscription details off to the db.
-	_, err = tx.Exec("modSub", user, sub, time.Now(),
+	now:= time.Now()
+	_, err = tx.Exec("modSub", user, sub, now,
 		customerID, subID)
 	if err!=nil {
 		return err
@@ -63,9 +73,12 @@ func ModSub(pool *pgx.ConnPool, user, sub,
 		return err
 	}
 
-	tx.Commit()
-	
-	return err
+	err = enqueueMail(tx, m, now)
+	if err!=nil {
+		return err
+	}
+
+	return tx.Commit()
 
 }
 
@@ -102,7 +115,7 @@ func GetSub(pool *pgx.ConnPool, user string,
 	err:= pool.QueryRow("getSub", user).Scan(
 		&s.Name , &s.Plan ,
 		&s.CustomerID, &s.SubID,
-		&s.StartTime)
+		&s.StartTime, &s.Trialed)
 	if err!=nil{
 		return nil, errorHandle(err, ScanError)
 	}
@@ -113,29 +126,22 @@ func GetSub(pool *pgx.ConnPool, user string,
 
 // Sets the user's current subscription into effect.
 //
-// Currently, this sets maxcollections and longestview for a users.meta
-// entry matching this user.
+// Copies the plan's maxcollections and historywindow onto the users.meta
+// entry matching this user, the remaining entitlements are read from
+// users.plans as they're needed.
 //
 // Use as a transaction to work alongside changing the sub status.
 func setSubEffects(tx *pgx.Tx, user, sub string) error {
-	var maxCollections int
-	var longestview time.Duration
-
-	switch sub{
-	case "Sensei's Top":
-		maxCollections = SubTiersToCollections[sub]
-		longestview = noTimeLimit
-	case "Preordain":
-		maxCollections = SubTiersToCollections[sub]
-		longestview = noTimeLimit
-	default:
-		maxCollections = SubTiersToCollections[sub]
-		longestview = defaultTimeLimit
-	}
 	
-	_, err:= tx.Exec("setSubEffects", user, maxCollections, int64(longestview))
+	tag, err:= tx.Exec("setSubEffects", user, sub)
+	if err!=nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("unknown plan")
+	}
 
-	return err
+	return nil
 	
 }
 
@@ -148,4 +154,25 @@ func addSub(tx *pgx.Tx, user string) error {
 		DefaultID, DefaultID)
 
 	return err
-}
\ No newline at end of file
+}
+
+// Claims a user's one trial, false when it's already been used.
+//
+// Checking and claiming are a single statement so concurrent
+// subscriptions can't both take it.
+func ClaimTrial(pool *pgx.ConnPool, user string) (bool, error) {
+	tag, err:= pool.Exec("claimTrial", user)
+	if err!=nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Returns a trial claimed by ClaimTrial after the subscription it was
+// for failed.
+func ReleaseTrial(pool *pgx.ConnPool, user string) error {
+	_, err:= pool.Exec("releaseTrial", user)
+
+	return err
+}
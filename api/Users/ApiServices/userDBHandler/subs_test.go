@@ -26,6 +26,14 @@ func TestSubAdd(t *testing.T) {
 
 	var err error
 
+	plans, err:= GetPlans(pool)
+	if err!=nil {
+		t.Fatal("failed to get plans", err)
+	}
+	var tiers []string
+	for _, p:= range plans{
+		tiers = append(tiers, p.Name)
+	}
 
 	for i := 0; i < testCount; i++ {
 
@@ -38,9 +46,9 @@ func TestSubAdd(t *testing.T) {
 
 		// Change the sub only if we choose one different from
 		// the default.
-		sub = randomElement(SubTiers)
+		sub = randomElement(tiers)
 		for sub == DefaultSubLevel{
-			sub = randomElement(SubTiers)
+			sub = randomElement(tiers)
 		}
 		custID = randString(int(randByte()))
 		subID = randString(int(randByte()))
@@ -89,7 +97,13 @@ func TestSubEffects(t *testing.T) {
 	var session []byte
 	var err error
 
-	for sub, count:= range SubTiersToCollections{
+	plans, err:= GetPlans(pool)
+	if err!=nil {
+		t.Fatal("failed to get plans", err)
+	}
+
+	for _, plan:= range plans{
+		sub:= plan.Name
 
 		// Add the user
 		user = randString(int(randByte()))
@@ -113,15 +127,60 @@ func TestSubEffects(t *testing.T) {
 		if err!=nil {
 			t.Fatal("failed to get user", err)
 		}
-		if u.MaxCollections != int32(count){
+		if u.MaxCollections != plan.MaxCollections {
 			t.Fatal("failed to get right max coll count back!")
 		}
-		// If we got here then we changed to a non-default sub plan
-		// and thus their max viewtime should always be strictly before
-		// the default start time. 
-		if u.Longestview != noTimeLimit {
+		if u.Longestview != plan.HistoryWindow {
 			t.Fatal("failed to get right longestview back")
 		}
 
+		// The rest of the entitlements come straight from the plan
+		p, err:= GetUserPlan(pool, user)
+		if err!=nil {
+			t.Fatal("failed to get user plan", err)
+		}
+		if *p != plan {
+			t.Fatal("user plan did not match subscribed plan")
+		}
+
+	}
+}
+
+// Ensure metered plans are cut off once they exceed their quota
+func TestAPIQuota(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(int(randByte()))
+	_, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
 	}
-}
\ No newline at end of file
+
+	p, err:= GetUserPlan(pool, user)
+	if err!=nil {
+		t.Fatal("failed to get user plan", err)
+	}
+	if !p.Metered() {
+		t.Skip("default plan is unmetered")
+	}
+
+	now:= time.Now()
+	for i := int32(0); i < p.DailyQuota; i++ {
+		allowed, err:= UseAPIQuota(pool, user, now)
+		if err!=nil || !allowed {
+			t.Fatal("refused a request within quota", i, err)
+		}
+	}
+
+	allowed, err:= UseAPIQuota(pool, user, now)
+	if err!=nil || allowed {
+		t.Fatal("allowed a request beyond quota", err)
+	}
+
+	// Tomorrow is a fresh day
+	allowed, err = UseAPIQuota(pool, user, now.Add(time.Duration(hoursPerDay) * time.Hour))
+	if err!=nil || !allowed {
+		t.Fatal("quota did not reset the next day", err)
+	}
+
+}
@@ -0,0 +1,173 @@
+package userDB
+
+import(
+
+	"time"
+
+	"github.com/jackc/pgx"
+
+)
+
+// What a failed login is tracked against
+const attemptName string = "name"
+const attemptIP string = "ip"
+
+// Failures older than this are forgotten
+const failureWindow = time.Duration(hoursPerDay) * time.Hour
+
+// After this many failures against an account or ip a recaptcha
+// must accompany further attempts.
+const CaptchaThreshold int32 = 3
+
+// After this many failures attempts are refused outright for an
+// exponentially increasing period.
+//
+// An ip may legitimately be shared so it is allowed more room.
+const NameLockoutThreshold int32 = 5
+const IPLockoutThreshold int32 = 20
+
+// The first lockout lasts baseLockout, each further failure doubles
+// it up to maxLockout.
+const baseLockout = time.Duration(1) * time.Minute
+const maxLockout = time.Duration(hoursPerDay) * time.Hour
+
+type LoginAttempt struct{
+	Kind, Identifier string
+	Failures int32
+	LastFailure, LockedUntil time.Time
+}
+
+// The restrictions in place for an attempt to login.
+type LoginThrottle struct{
+	CaptchaRequired bool
+	LockedUntil time.Time
+	// Set when recording a failure started a fresh lockout
+	NewLockout bool
+}
+
+// Whether an attempt made at a given time should be refused
+func (l *LoginThrottle) Locked(at time.Time) bool {
+	return at.Before(l.LockedUntil)
+}
+
+// Returns how long a lockout should last given a number of failures
+// and the threshold they are measured against.
+func lockoutDuration(failures, threshold int32) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+
+	d:= baseLockout
+	for i := threshold; i < failures && d < maxLockout; i++ {
+		d *= 2
+	}
+	if d > maxLockout {
+		d = maxLockout
+	}
+
+	return d
+}
+
+// Acquires the restrictions on an attempt to log into an account
+// from an ip.
+//
+// This is cheap and should happen before any password is derived.
+func CheckLoginThrottle(pool *pgx.ConnPool,
+	user, ip string, at time.Time) (*LoginThrottle, error) {
+
+	rows, err:= pool.Query("getLoginAttempts", user, ip)
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	since:= at.Add(-failureWindow)
+
+	l:= LoginThrottle{}
+	for rows.Next(){
+		a:= LoginAttempt{}
+		err = rows.Scan(&a.Kind, &a.Identifier, &a.Failures,
+			&a.LastFailure, &a.LockedUntil)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		if a.LastFailure.Before(since) {
+			continue
+		}
+
+		if a.Failures >= CaptchaThreshold {
+			l.CaptchaRequired = true
+		}
+		if a.LockedUntil.After(l.LockedUntil) {
+			l.LockedUntil = a.LockedUntil
+		}
+	}
+
+	return &l, nil
+
+}
+
+// Records a failed login against both the account and ip, locking
+// either out when they cross their threshold.
+//
+// Returns the restrictions now in place.
+func RecordLoginFailure(pool *pgx.ConnPool,
+	user, ip string, at time.Time) (*LoginThrottle, error) {
+
+	l:= LoginThrottle{}
+
+	kinds:= []struct{
+		kind, identifier string
+		threshold int32
+	}{
+		{attemptName, user, NameLockoutThreshold},
+		{attemptIP, ip, IPLockoutThreshold},
+	}
+
+	since:= at.Add(-failureWindow)
+	for _, k:= range kinds{
+
+		var failures int32
+		err:= pool.QueryRow("recordLoginFailure",
+			k.kind, k.identifier, at, since).Scan(&failures)
+		if err!=nil {
+			return nil, errorHandle(err, "failed to record login failure")
+		}
+
+		if failures >= CaptchaThreshold {
+			l.CaptchaRequired = true
+		}
+
+		lockout:= lockoutDuration(failures, k.threshold)
+		if lockout == 0 {
+			continue
+		}
+
+		lockedUntil:= at.Add(lockout)
+		_, err = pool.Exec("setLockout", k.kind, k.identifier, lockedUntil)
+		if err!=nil {
+			return nil, errorHandle(err, "failed to set lockout")
+		}
+
+		l.NewLockout = true
+		if lockedUntil.After(l.LockedUntil) {
+			l.LockedUntil = lockedUntil
+		}
+	}
+
+	return &l, nil
+
+}
+
+// Forgets the failures against an account after a successful login.
+//
+// The ip is left alone; one success shouldn't excuse stuffing
+// attempts against other accounts.
+func ClearLoginFailures(pool *pgx.ConnPool, user string) error {
+
+	_, err:= pool.Exec("clearLoginFailures", attemptName, user)
+
+	return err
+
+}
@@ -0,0 +1,87 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Fail logins against an account until it locks out, ensuring
+// captcha and lockout kick in at the right time.
+func TestLoginThrottle(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(170)
+	ip:= randString(15)
+	now:= time.Now()
+
+	for i := int32(1); i <= NameLockoutThreshold; i++ {
+		l, err:= RecordLoginFailure(pool, user, ip, now)
+		if err!=nil {
+			t.Fatal("failed to record failure", err)
+		}
+
+		if l.CaptchaRequired != (i >= CaptchaThreshold) {
+			t.Fatal("captcha requirement wrong after", i, "failures")
+		}
+		if l.Locked(now) != (i >= NameLockoutThreshold) {
+			t.Fatal("lockout wrong after", i, "failures")
+		}
+	}
+
+	time.Sleep(testSleepTime)
+
+	l, err:= CheckLoginThrottle(pool, user, ip, now)
+	if err!=nil {
+		t.Fatal("failed to check throttle", err)
+	}
+	if !l.CaptchaRequired || !l.Locked(now) {
+		t.Fatal("throttle was not persisted")
+	}
+	if l.Locked(now.Add(baseLockout + time.Second)) {
+		t.Fatal("first lockout outlasted baseLockout")
+	}
+
+	// The ip alone still requires a captcha for other accounts
+	l, err = CheckLoginThrottle(pool, randString(170), ip, now)
+	if err!=nil {
+		t.Fatal("failed to check throttle", err)
+	}
+	if !l.CaptchaRequired || l.Locked(now) {
+		t.Fatal("ip throttle was wrong for another account")
+	}
+
+	err = ClearLoginFailures(pool, user)
+	if err!=nil {
+		t.Fatal("failed to clear failures", err)
+	}
+
+	l, err = CheckLoginThrottle(pool, user, randString(15), now)
+	if err!=nil {
+		t.Fatal("failed to check throttle", err)
+	}
+	if l.CaptchaRequired || l.Locked(now) {
+		t.Fatal("failures survived being cleared")
+	}
+
+}
+
+// Ensure lockouts grow exponentially and are capped.
+func TestLockoutDuration(t *testing.T) {
+
+	if lockoutDuration(NameLockoutThreshold - 1, NameLockoutThreshold) != 0 {
+		t.Fatal("locked out before the threshold")
+	}
+	if lockoutDuration(NameLockoutThreshold, NameLockoutThreshold) != baseLockout {
+		t.Fatal("first lockout was not baseLockout")
+	}
+	if lockoutDuration(NameLockoutThreshold + 2, NameLockoutThreshold) != 4 * baseLockout {
+		t.Fatal("lockout did not double per failure")
+	}
+	if lockoutDuration(NameLockoutThreshold + 100, NameLockoutThreshold) != maxLockout {
+		t.Fatal("lockout was not capped")
+	}
+
+}
@@ -0,0 +1,156 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"time"
+
+)
+
+// How long after submission a trade may still be reverted.
+const TradeRevertWindow = time.Duration(7 * hoursPerDay) * time.Hour
+
+// Returned when the user has no such trade in the collection.
+var ErrNoTrade = fmt.Errorf("no such trade")
+// Returned when a trade has already been reverted.
+var ErrTradeReverted = fmt.Errorf("trade already reverted")
+// Returned when reverting a revert, submit a fresh trade instead.
+var ErrTradeIsRevert = fmt.Errorf("trade is itself a revert")
+// Returned when a trade is older than TradeRevertWindow.
+var ErrTradeExpired = fmt.Errorf("trade too old to revert")
+
+// Records a trade against a collection, returning its id.
+func addTradeRecord(tx *pgx.Tx, user, collection string,
+	revertOf int64) (int64, error) {
+
+	var id int64
+	err:= tx.QueryRow("addTrade", user, collection, time.Now(),
+		revertOf).Scan(&id)
+	if err!=nil {
+		return 0, errorHandle(err, "failed to record trade")
+	}
+
+	return id, nil
+
+}
+
+// Undoes a trade by adding its opposite to the collection, returning
+// the id of the trade that undid it.
+//
+// The opposite is applied to both contents and history in one
+// transaction, the original history is left untouched so every
+// change remains visible.
+func RevertTrade(pool *pgx.ConnPool, sessionKey []byte,
+	user, collection string, tradeID int64) (int64, error) {
+
+	// Start the transaction
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return 0, fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	// Make sure the user's collection exists
+	coll, err:= GetCollectionMeta(pool, sessionKey, user, collection)
+	if err!=nil {
+		return 0, fmt.Errorf("failed to ensure collection exists")
+	}
+	if coll.Name != collection {
+		return 0, fmt.Errorf("no such collection exists")
+	}
+
+	// Locked so two reverts can't both apply
+	var tradeCollection string
+	var submitted time.Time
+	var revertOf, revertedBy int64
+	err = tx.QueryRow("getTrade", tradeID, user).Scan(&tradeCollection,
+		&submitted, &revertOf, &revertedBy)
+	if err==pgx.ErrNoRows {
+		return 0, ErrNoTrade
+	}
+	if err!=nil {
+		return 0, errorHandle(err, ScanError)
+	}
+
+	if tradeCollection != collection {
+		return 0, ErrNoTrade
+	}
+	if revertOf != 0 {
+		return 0, ErrTradeIsRevert
+	}
+	if revertedBy != 0 {
+		return 0, ErrTradeReverted
+	}
+	if time.Since(submitted) > TradeRevertWindow {
+		return 0, ErrTradeExpired
+	}
+
+	cards, err:= getTradeCards(tx, user, tradeID)
+	if err!=nil {
+		return 0, err
+	}
+
+	revertID, err:= addTradeRecord(tx, user, collection, tradeID)
+	if err!=nil {
+		return 0, err
+	}
+
+	now:= time.Now()
+	for _, aCard:= range cards{
+
+		aCard.Quantity = -aCard.Quantity
+		aCard.LastUpdate = now
+		err:= insertCard(tx, user, collection, aCard, revertID)
+
+		if err!=nil {
+			return 0, fmt.Errorf("failed to insert card: %v", err)
+		}
+	}
+
+	_, err = tx.Exec("setTradeReverted", tradeID, revertID)
+	if err!=nil {
+		return 0, errorHandle(err, "failed to mark trade reverted")
+	}
+
+	err = tx.Commit()
+	if err!=nil {
+		return 0, fmt.Errorf("failed to commit revert: %v", err)
+	}
+
+	return revertID, nil
+
+}
+
+// Acquires the net change a trade made to each printing, so a printing
+// listed twice is only reverted once.
+//
+// The rows are read in full before returning as the transaction
+// can't be used for anything else until they are.
+func getTradeCards(tx *pgx.Tx, user string, tradeID int64) ([]Card, error) {
+
+	rows, err:= tx.Query("getTradeCards", user, tradeID)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to fetch trade")
+	}
+	defer rows.Close()
+
+	var cards []Card
+	for rows.Next(){
+		c:= Card{}
+		err = rows.Scan(&c.Name, &c.Set,
+			&c.Quality, &c.Quantity,
+			&c.Comment, &c.Lang, &c.LastUpdate,
+			&c.Foil, &c.Grader, &c.Grade, &c.Location)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		cards = append(cards, c)
+	}
+
+	return cards, rows.Err()
+
+}
@@ -0,0 +1,120 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Revert a trade atop an existing collection, ensuring the collection
+// returns to how it was while history keeps both.
+func TestRevertTrade(t *testing.T) {
+	t.Parallel()
+
+	user, key, collection:= addPagedCollection(t)
+
+	before, err:= GetCollectionContents(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get contents", err)
+	}
+	beforeHistory, err:= GetCollectionHistory(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get history", err)
+	}
+
+	trade:= randomCards(CardsPerCollection)
+	tradeID, err:= AddTrade(pool, key, user, collection, trade)
+	if err!=nil {
+		t.Fatal("failed to add trade", err)
+	}
+
+	time.Sleep(stepSleepTime)
+
+	revertID, err:= RevertTrade(pool, key, user, collection, tradeID)
+	if err!=nil {
+		t.Fatal("failed to revert trade", err)
+	}
+	if revertID == tradeID {
+		t.Fatal("revert shares the trade's id")
+	}
+
+	time.Sleep(testSleepTime)
+
+	quantities:= make(map[Card]int32)
+	for _, c:= range before{
+		quantities[printingOf(c)] = c.Quantity
+	}
+	after, err:= GetCollectionContents(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get contents", err)
+	}
+	for _, c:= range after{
+		if quantities[printingOf(c)] != c.Quantity {
+			t.Fatal("revert did not restore quantity", c)
+		}
+	}
+
+	history, err:= GetCollectionHistory(pool, key, user, collection)
+	if err!=nil {
+		t.Fatal("failed to get history", err)
+	}
+	// A revert changes each printing the trade touched once
+	printings:= make(map[Card]bool)
+	for _, c:= range trade{
+		printings[printingOf(c)] = true
+	}
+	expected:= len(beforeHistory) + len(trade) + len(printings)
+	if len(history) != expected {
+		t.Fatal("history missing trade or revert", len(history), expected)
+	}
+
+	_, err = RevertTrade(pool, key, user, collection, tradeID)
+	if err!=ErrTradeReverted {
+		t.Fatal("reverted a trade twice", err)
+	}
+	_, err = RevertTrade(pool, key, user, collection, revertID)
+	if err!=ErrTradeIsRevert {
+		t.Fatal("reverted a revert", err)
+	}
+
+}
+
+// Ensure trades can only be reverted by their owner, within the
+// collection they were made to.
+func TestRevertTradeOwnership(t *testing.T) {
+	t.Parallel()
+
+	user, key, collection:= addPagedCollection(t)
+
+	tradeID, err:= AddTrade(pool, key, user, collection, randomCards(1))
+	if err!=nil {
+		t.Fatal("failed to add trade", err)
+	}
+
+	other:= randString(30)
+	err = AddCollection(pool, key, user, other)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(stepSleepTime)
+
+	_, err = RevertTrade(pool, key, user, other, tradeID)
+	if err!=ErrNoTrade {
+		t.Fatal("reverted a trade in the wrong collection", err)
+	}
+
+	otherUser, otherKey, otherCollection:= addPagedCollection(t)
+	_, err = RevertTrade(pool, otherKey, otherUser, otherCollection, tradeID)
+	if err!=ErrNoTrade {
+		t.Fatal("reverted another user's trade", err)
+	}
+
+	_, err = RevertTrade(pool, key, user, collection, tradeID)
+	if err!=nil {
+		t.Fatal("failed to revert trade", err)
+	}
+
+}
@@ -0,0 +1,369 @@
+package userDB
+
+import(
+
+	"fmt"
+	"time"
+
+	"crypto/subtle"
+	"crypto/sha256"
+	"crypto/rand"
+
+	"github.com/jackc/pgx"
+
+	"./../totp"
+
+)
+
+// Returned when a correct password is insufficient to log in.
+var ErrSecondFactor = fmt.Errorf("second factor required")
+
+// How many recovery codes a user receives on enabling a second factor
+const RecoveryCodeCount int = 10
+const recoveryCodeLength int = 10
+
+// Recovery codes are typed by hand so avoid lookalike characters
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// How many characters a login challenge should be.
+const ChallengeLength int = 32
+
+type TwoFactor struct{
+	Name, Secret string
+	Enabled bool
+	LastCounter int64
+}
+
+type Challenge struct{
+	Name string
+	ChallengeKey []byte
+	StartValid, EndValid time.Time
+}
+
+// Acquires the second factor of a user with no authentication.
+func GetTwoFactor(pool *pgx.ConnPool, user string) (*TwoFactor, error) {
+
+	f:= TwoFactor{}
+
+	err:= pool.QueryRow("getTOTP", user).Scan(&f.Name, &f.Secret,
+		&f.Enabled, &f.LastCounter)
+	if err!=nil {
+		return nil, errorHandle(err, ScanError)
+	}
+
+	return &f, nil
+
+}
+
+// Returns whether a user must provide a second factor to log in.
+func TwoFactorEnabled(pool *pgx.ConnPool, user string) (bool, error) {
+	f, err:= GetTwoFactor(pool, user)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err!=nil {
+		return false, err
+	}
+
+	return f.Enabled, nil
+}
+
+// Generates a fresh secret for a user, replacing any pending enrolment.
+//
+// The secret has no effect on logging in until EnableTwoFactor is
+// called with a code derived from it.
+func EnrollTwoFactor(pool *pgx.ConnPool, user string) (string, error) {
+
+	enabled, err:= TwoFactorEnabled(pool, user)
+	if err!=nil {
+		return "", err
+	}
+	if enabled {
+		return "", fmt.Errorf("second factor already enabled")
+	}
+
+	secret, err:= totp.GenerateSecret()
+	if err!=nil {
+		return "", err
+	}
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return "", fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("removeTOTP", user)
+	if err!=nil {
+		return "", fmt.Errorf("failed to remove pending enrolment: %v", err)
+	}
+
+	_, err = tx.Exec("addTOTP", user, secret)
+	if err!=nil {
+		return "", fmt.Errorf("failed to send secret: %v", err)
+	}
+
+	err = tx.Commit()
+	if err!=nil {
+		return "", fmt.Errorf("failed to commit enrolment: %v", err)
+	}
+
+	return secret, nil
+
+}
+
+// Enables a pending second factor given a code valid at the provided
+// time.
+//
+// Returns freshly generated recovery codes, these are never
+// retrievable again.
+func EnableTwoFactor(pool *pgx.ConnPool,
+	user, code string, at time.Time) ([]string, error) {
+
+	f, err:= GetTwoFactor(pool, user)
+	if err!=nil {
+		return nil, errorHandle(err, "failed to fetch second factor")
+	}
+	if f.Enabled {
+		return nil, fmt.Errorf("second factor already enabled")
+	}
+
+	counter, valid:= totp.Validate(f.Secret, code, at)
+	if !valid {
+		return nil, fmt.Errorf("invalid second factor")
+	}
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return nil, fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("enableTOTP", user, int64(counter))
+	if err!=nil {
+		return nil, fmt.Errorf("failed to enable second factor: %v", err)
+	}
+
+	codes, err:= replaceRecoveryCodes(tx, user)
+	if err!=nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err!=nil {
+		return nil, fmt.Errorf("failed to commit second factor: %v", err)
+	}
+
+	return codes, nil
+
+}
+
+// Removes a user's second factor after checking a code or recovery code.
+func DisableTwoFactor(pool *pgx.ConnPool,
+	user, code string, at time.Time) error {
+
+	err:= CheckSecondFactor(pool, user, code, at)
+	if err!=nil {
+		return err
+	}
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("removeRecoveryCodes", user)
+	if err!=nil {
+		return fmt.Errorf("failed to remove recovery codes: %v", err)
+	}
+
+	_, err = tx.Exec("removeTOTP", user)
+	if err!=nil {
+		return fmt.Errorf("failed to remove second factor: %v", err)
+	}
+
+	return tx.Commit()
+
+}
+
+// Checks a code against an enabled second factor, falling back to
+// consuming a recovery code.
+//
+// Each code is accepted only once.
+func CheckSecondFactor(pool *pgx.ConnPool,
+	user, code string, at time.Time) error {
+
+	f, err:= GetTwoFactor(pool, user)
+	if err!=nil {
+		return errorHandle(err, "failed to fetch second factor")
+	}
+	if !f.Enabled {
+		return fmt.Errorf("second factor not enabled")
+	}
+
+	counter, valid:= totp.Validate(f.Secret, code, at)
+	if valid {
+		// Only succeeds if no code at or after this period was used
+		tag, err:= pool.Exec("setTOTPCounter", user, int64(counter))
+		if err!=nil {
+			return fmt.Errorf("failed to record code use: %v", err)
+		}
+		if tag.RowsAffected() != 1 {
+			return fmt.Errorf("second factor already used")
+		}
+
+		return nil
+	}
+
+	hashed:= sha256.Sum256([]byte(code))
+	tag, err:= pool.Exec("removeRecoveryCode", user, hashed[:])
+	if err!=nil {
+		return fmt.Errorf("failed to consume recovery code: %v", err)
+	}
+	if tag.RowsAffected() != 1 {
+		return fmt.Errorf("invalid second factor")
+	}
+
+	return nil
+
+}
+
+// Authenticates a password and either returns a fresh session key or,
+// for users with a second factor, a challenge to complete the login
+// with CompleteLogin.
+func BeginLogin(pool *pgx.ConnPool,
+	user, password string) (sessionKey []byte, challenge string, err error) {
+
+	valid, err:= PasswordAuthUser(pool, user, password)
+	if err==ErrDisabled {
+		return
+	}
+	if err!=nil || !valid {
+		err = errorHandle(err, "failed to authenticate user")
+		return
+	}
+
+	enabled, err:= TwoFactorEnabled(pool, user)
+	if err!=nil {
+		return
+	}
+	if !enabled {
+		sessionKey, err = AddSession(pool, user)
+		return
+	}
+
+	challenge = randString(ChallengeLength)
+	hashed:= sha256.Sum256([]byte(challenge))
+
+	now:= time.Now()
+	_, err = pool.Exec("addChallenge", user, hashed[:],
+		now, now.Add(challengeValidTime))
+	if err!=nil {
+		challenge = ""
+		err = errorHandle(err, "failed to send challenge off to db")
+	}
+
+	return
+
+}
+
+// Exchanges a challenge from BeginLogin and a second factor code valid
+// at the provided time for a fresh session key.
+func CompleteLogin(pool *pgx.ConnPool,
+	user, challenge, code string, at time.Time) ([]byte, error) {
+
+	hashed:= sha256.Sum256([]byte(challenge))
+
+	err:= validateChallenge(pool, user, hashed[:])
+	if err!=nil {
+		return nil, err
+	}
+
+	// Challenges are single use regardless of the code being correct,
+	// this bounds guesses to one per password check.
+	//
+	// Only the request which actually removes it may go on to check
+	// the code, concurrent requests sharing a challenge lose the race.
+	tag, err:= pool.Exec("removeChallenge", user, hashed[:])
+	if err!=nil {
+		return nil, errorHandle(err, "failed to remove challenge")
+	}
+	if tag.RowsAffected() != 1 {
+		return nil, fmt.Errorf("invalid Authentication")
+	}
+
+	err = CheckSecondFactor(pool, user, code, at)
+	if err!=nil {
+		return nil, err
+	}
+
+	return AddSession(pool, user)
+
+}
+
+// Ensures a hashed challenge exists and is valid for the user
+func validateChallenge(pool *pgx.ConnPool, user string, hashed []byte) error {
+
+	rows, err:= pool.Query("getChallenge", user, hashed)
+	if err!=nil {
+		return err
+	}
+	defer rows.Close()
+
+	now:= time.Now()
+	for rows.Next(){
+		c:= Challenge{}
+		err = rows.Scan(&c.Name, &c.ChallengeKey,
+			&c.StartValid, &c.EndValid)
+		if err!=nil {
+			return errorHandle(err, ScanError)
+		}
+
+		if c.Name == user &&
+		subtle.ConstantTimeCompare(hashed, c.ChallengeKey) == 1 &&
+		now.Before(c.EndValid) && now.After(c.StartValid) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid Authentication")
+
+}
+
+// Discards a user's recovery codes and generates a new set, storing
+// only their hashes.
+func replaceRecoveryCodes(tx *pgx.Tx, user string) ([]string, error) {
+
+	_, err:= tx.Exec("removeRecoveryCodes", user)
+	if err!=nil {
+		return nil, fmt.Errorf("failed to remove recovery codes: %v", err)
+	}
+
+	codes:= make([]string, RecoveryCodeCount)
+	for i := range codes{
+		codes[i] = randRecoveryCode()
+
+		hashed:= sha256.Sum256([]byte(codes[i]))
+		_, err = tx.Exec("addRecoveryCode", user, hashed[:])
+		if err!=nil {
+			return nil, fmt.Errorf("failed to send recovery code: %v", err)
+		}
+	}
+
+	return codes, nil
+
+}
+
+// Returns a random recovery code drawn from recoveryAlphabet
+func randRecoveryCode() string {
+	var bytes = make([]byte, recoveryCodeLength)
+	rand.Read(bytes)
+	for i, b := range bytes {
+		bytes[i] = recoveryAlphabet[int(b)%len(recoveryAlphabet)]
+	}
+	return string(bytes)
+}
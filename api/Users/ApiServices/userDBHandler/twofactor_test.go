@@ -0,0 +1,215 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+	"./../totp"
+
+)
+
+// A fixed clock so codes are deterministic relative to enrolment
+var twoFactorClock = time.Unix(1500000000, 0)
+
+// Enrol a user, enable their second factor, and ensure logging in
+// requires a fresh code.
+func TestTwoFactorLogin(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(190)
+	password:= "foobarbazqux"
+
+	_, err:= AddUser(pool, user, "bar", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	secret, err:= EnrollTwoFactor(pool, user)
+	if err!=nil {
+		t.Fatal("failed to enroll", err)
+	}
+
+	// Enrolment alone has no effect on logging in
+	_, err = Login(pool, user, password)
+	if err!=nil {
+		t.Fatal("pending enrolment blocked login", err)
+	}
+
+	code, err:= totp.Code(secret, twoFactorClock)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	recovery, err:= EnableTwoFactor(pool, user, code, twoFactorClock)
+	if err!=nil {
+		t.Fatal("failed to enable second factor", err)
+	}
+	if len(recovery) != RecoveryCodeCount {
+		t.Fatal("wrong number of recovery codes")
+	}
+
+	time.Sleep(testSleepTime)
+
+	// A password is no longer sufficient
+	_, err = Login(pool, user, password)
+	if err != ErrSecondFactor {
+		t.Fatal("logged in without a second factor", err)
+	}
+
+	session, challenge, err:= BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("failed to begin login", err)
+	}
+	if session != nil || challenge == "" {
+		t.Fatal("received a session before providing a second factor")
+	}
+
+	// The enabling code was consumed so it can't be replayed
+	_, err = CompleteLogin(pool, user, challenge, code, twoFactorClock)
+	if err==nil {
+		t.Fatal("was able to replay a code")
+	}
+
+	// The challenge was consumed by that failure
+	later:= twoFactorClock.Add(totp.Period)
+	code, err = totp.Code(secret, later)
+	if err!=nil {
+		t.Fatal(err)
+	}
+	_, err = CompleteLogin(pool, user, challenge, code, later)
+	if err==nil {
+		t.Fatal("was able to reuse a challenge")
+	}
+
+	_, challenge, err = BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("failed to begin login", err)
+	}
+	session, err = CompleteLogin(pool, user, challenge, code, later)
+	if err!=nil {
+		t.Fatal("failed to complete login", err)
+	}
+
+	err = SessionAuth(pool, user, session)
+	if err!=nil {
+		t.Fatal("two factor session failed to authenticate", err)
+	}
+
+}
+
+// Ensure recovery codes work exactly once and can disable
+// a second factor.
+func TestTwoFactorRecovery(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(180)
+	password:= "foobarbazqux"
+
+	_, err:= AddUser(pool, user, "bar", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	secret, err:= EnrollTwoFactor(pool, user)
+	if err!=nil {
+		t.Fatal("failed to enroll", err)
+	}
+
+	code, err:= totp.Code(secret, twoFactorClock)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	recovery, err:= EnableTwoFactor(pool, user, code, twoFactorClock)
+	if err!=nil {
+		t.Fatal("failed to enable second factor", err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	_, challenge, err:= BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("failed to begin login", err)
+	}
+	_, err = CompleteLogin(pool, user, challenge, recovery[0], twoFactorClock)
+	if err!=nil {
+		t.Fatal("failed to log in with recovery code", err)
+	}
+
+	_, challenge, err = BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("failed to begin login", err)
+	}
+	_, err = CompleteLogin(pool, user, challenge, recovery[0], twoFactorClock)
+	if err==nil {
+		t.Fatal("was able to reuse a recovery code")
+	}
+
+	err = DisableTwoFactor(pool, user, recovery[1], twoFactorClock)
+	if err!=nil {
+		t.Fatal("failed to disable second factor", err)
+	}
+
+	_, err = Login(pool, user, password)
+	if err!=nil {
+		t.Fatal("second factor still required after disabling", err)
+	}
+
+}
+
+// Ensure concurrent logins sharing a challenge can't both succeed,
+// even when each presents a different valid code.
+func TestTwoFactorChallengeRace(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(170)
+	password:= "foobarbazqux"
+
+	_, err:= AddUser(pool, user, "bar", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	secret, err:= EnrollTwoFactor(pool, user)
+	if err!=nil {
+		t.Fatal("failed to enroll", err)
+	}
+
+	code, err:= totp.Code(secret, twoFactorClock)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	recovery, err:= EnableTwoFactor(pool, user, code, twoFactorClock)
+	if err!=nil {
+		t.Fatal("failed to enable second factor", err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	_, challenge, err:= BeginLogin(pool, user, password)
+	if err!=nil {
+		t.Fatal("failed to begin login", err)
+	}
+
+	results:= make(chan error, 2)
+	for _, c:= range recovery[:2]{
+		go func(c string) {
+			_, err:= CompleteLogin(pool, user, challenge, c, twoFactorClock)
+			results <- err
+		}(c)
+	}
+
+	succeeded:= 0
+	for i:= 0; i < 2; i++ {
+		if <-results == nil {
+			succeeded++
+		}
+	}
+	if succeeded != 1 {
+		t.Fatal("a challenge was not used exactly once", succeeded)
+	}
+
+}
@@ -3,6 +3,7 @@ package userDB
 import(
 	
 	"fmt"
+	"log"
 
 	"time"
 
@@ -15,8 +16,16 @@ import(
 type User struct{
 	Name, Email string
 	PassHash, Nonce []byte	
+	// How PassHash was derived, see hashParams
+	HashParams string
 	MaxCollections int32
 	Longestview time.Duration
+	// Whether the user has proven they control Email
+	Verified bool
+	// Which language mail to the user is written in, eg. en or pt-br
+	Locale string
+	// Whether support has barred them from logging in
+	Disabled bool
 }
 
 // Acquires the provided user from the database with no authentication.
@@ -29,8 +38,9 @@ func GetUser(pool *pgx.ConnPool, user string) (*User, error) {
 	var LongestviewAsInt int64
 	err := pool.QueryRow("getUser",
 		user).Scan(&u.Name, &u.Email,
-			&u.PassHash, &u.Nonce,
-			&u.MaxCollections, &LongestviewAsInt)
+			&u.PassHash, &u.Nonce, &u.HashParams,
+			&u.MaxCollections, &LongestviewAsInt,
+			&u.Verified, &u.Locale, &u.Disabled)
 	if err!=nil {
 		return nil, errorHandle(err, ScanError)
 	}
@@ -41,6 +51,15 @@ func GetUser(pool *pgx.ConnPool, user string) (*User, error) {
 
 }
 
+// Sets the locale mail to the user is written in.
+//
+// The locale is expected to already be normalized.
+func SetLocale(pool *pgx.ConnPool, user, locale string) error {
+	_, err:= pool.Exec("setLocale", user, locale)
+
+	return err
+}
+
 // Adds a new user and returns a fresh session key.
 //
 // Can fail to add session key *after* adding the user, this
@@ -57,13 +76,13 @@ func AddUser(pool *pgx.ConnPool, user,
 	defer tx.Rollback()
 
 	// Hash their password and get a complementary nonce.
-	passHash, nonce, err:= derivePassword([]byte(password))
+	passHash, nonce, params, err:= derivePassword([]byte(password))
 	if err!=nil {
 		return nil, errorHandle(err, "failed to derive password")
 	}
 
 	// Send the user away to the db
-	_, err = tx.Exec("addUser", user, email, passHash, nonce)
+	_, err = tx.Exec("addUser", user, email, passHash, nonce, params)
 	if err!=nil {
 		return nil, fmt.Errorf("failed to send user", err)
 	}
@@ -88,13 +107,13 @@ func AddUser(pool *pgx.ConnPool, user,
 func SetPassword(tx *pgx.Tx, user, password string) error {
 
 	// Hash their password and get a complementary nonce.
-	passHash, nonce, err:= derivePassword([]byte(password))
+	passHash, nonce, params, err:= derivePassword([]byte(password))
 	if err!=nil {
 		return errorHandle(err, "failed to derive password")
 	}
 
 	// Send the user away to the db
-	_, err = tx.Exec("setPassword", user, passHash, nonce)
+	_, err = tx.Exec("setPassword", user, passHash, nonce, params)
 	if err!=nil {
 		return fmt.Errorf("failed to send fresh password", err)
 	}
@@ -116,6 +135,11 @@ func SetMaxCollections(pool *pgx.ConnPool, user string, maxCollections int32) er
 }
 
 // Authenticates a user based on a password basis
+//
+// A valid password derived under an outdated policy is transparently
+// rehashed with the current one.
+//
+// Disabled users receive ErrDisabled, even with a valid password.
 func PasswordAuthUser(pool *pgx.ConnPool,
 	user, password string) (bool, error) {
 	
@@ -125,25 +149,84 @@ func PasswordAuthUser(pool *pgx.ConnPool,
 		return false, err
 	}
 
-	// Hash the user's provided password using scrypt
-	providedHash, err:= derivePasswordWithNonce([]byte(password), u.Nonce)
+	params, err:= parseHashParams(u.HashParams)
+	if err!=nil {
+		return false, err
+	}
+
+	// Hash the user's provided password the same way theirs was
+	providedHash, err:= derivePasswordWithParams([]byte(password),
+		u.Nonce, params)
 	if err!=nil {
 		return false, err
 	}
 
-	return subtle.ConstantTimeCompare(u.PassHash, providedHash) == 1, nil
+	if subtle.ConstantTimeCompare(u.PassHash, providedHash) != 1 {
+		return false, nil
+	}
+
+	if u.HashParams != currentHashParams.String() {
+		// They're authenticated regardless, a failed upgrade
+		// will simply be retried next time. It's logged so one
+		// failing every time doesn't go unnoticed.
+		err = rehashPassword(pool, u, password)
+		if err!=nil {
+			log.Println("failed to rehash password for", u.Name, err)
+		}
+	}
+
+	if u.Disabled {
+		return false, ErrDisabled
+	}
+
+	return true, nil
+}
+
+// Replaces a user's hash with one derived under the current policy.
+//
+// Only succeeds if the hash hasn't changed underneath us so a
+// concurrent password change always wins.
+func rehashPassword(pool *pgx.ConnPool, u *User, password string) error {
+
+	passHash, nonce, params, err:= derivePassword([]byte(password))
+	if err!=nil {
+		return errorHandle(err, "failed to derive password")
+	}
+
+	_, err = pool.Exec("rehashPassword", u.Name,
+		passHash, nonce, params, u.PassHash)
+	if err!=nil {
+		return fmt.Errorf("failed to send rehashed password: %v", err)
+	}
+
+	return nil
+
 }
 
 // Authenticates a user and returns a fresh session key
+//
+// Users with a second factor enabled receive ErrSecondFactor rather
+// than a session and must log in through BeginLogin.
 func Login(pool *pgx.ConnPool,
 	user, password string) ([]byte, error) {
 
 	// Make sure they are who they say they are
 	valid, err:= PasswordAuthUser(pool, user, password)
+	if err==ErrDisabled {
+		return nil, err
+	}
 	if err!=nil || !valid {
 		return nil, errorHandle(err, "failed to authenticate user")
 	}
 
+	enabled, err:= TwoFactorEnabled(pool, user)
+	if err!=nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, ErrSecondFactor
+	}
+
 	return AddSession(pool, user)
 
 }
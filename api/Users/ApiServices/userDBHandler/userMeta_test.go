@@ -90,4 +90,80 @@ func TestInvalidUsers(t *testing.T) {
 	}
 	
 
-}
\ No newline at end of file
+}
+
+// Ensure users hashed under the legacy scrypt policy can still login
+// and are transparently moved to the current policy.
+func TestPasswordRehash(t *testing.T) {
+	t.Parallel()
+
+	name:= randString(int(randByte()))
+	password:= randString(int(randByte()) + 10)
+
+	_, err:= AddUser(pool, name, randString(int(randByte())), password)
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	// Force the user back onto the legacy hash
+	nonce, err:= getArrayOfRandBytes(32)
+	if err!=nil {
+		t.Fatal(err)
+	}
+	legacyHash, err:= derivePasswordWithParams([]byte(password),
+		nonce, legacyHashParams)
+	if err!=nil {
+		t.Fatal("failed to derive legacy hash", err)
+	}
+	_, err = pool.Exec("setPassword", name,
+		legacyHash, nonce, legacyHashParams.String())
+	if err!=nil {
+		t.Fatal("failed to set legacy hash", err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	valid, err:= PasswordAuthUser(pool, name, password)
+	if err!=nil || !valid {
+		t.Fatal("legacy user failed to authenticate", err)
+	}
+
+	u, err:= GetUser(pool, name)
+	if err!=nil {
+		t.Fatal("failed to reacquire the user", err)
+	}
+	if u.HashParams != currentHashParams.String() {
+		t.Fatal("legacy hash was not upgraded", u.HashParams)
+	}
+
+	valid, err = PasswordAuthUser(pool, name, password)
+	if err!=nil || !valid {
+		t.Fatal("upgraded user failed to authenticate", err)
+	}
+
+	valid, err = PasswordAuthUser(pool, name, password + "nope")
+	if err!=nil || valid {
+		t.Fatal("upgraded user authenticated with a bad password", err)
+	}
+
+}
+
+// Ensure hash parameters survive being stored.
+func TestHashParams(t *testing.T) {
+
+	for _, h:= range []hashParams{legacyHashParams, currentHashParams}{
+		parsed, err:= parseHashParams(h.String())
+		if err!=nil {
+			t.Fatal("failed to parse", h.String(), err)
+		}
+		if parsed != h {
+			t.Fatal("parameters changed in storage", h.String())
+		}
+	}
+
+	_, err:= parseHashParams("md5$")
+	if err == nil {
+		t.Fatal("parsed an unknown algorithm")
+	}
+
+}
@@ -0,0 +1,170 @@
+package userDB
+
+import(
+
+	"fmt"
+	"time"
+
+	"crypto/subtle"
+	"crypto/sha256"
+
+	"github.com/jackc/pgx"
+
+)
+
+// How many characters a verification key should be.
+//
+// Verification keys live longer than resets so are a bit longer.
+const VerifyLength int = 32
+
+type Verification struct{
+	Name, Email string
+	VerifyKey []byte
+	StartValid, EndValid time.Time
+}
+
+// Commits a provided verification off to the postgres backend
+func SendVerification(pool *pgx.ConnPool, v Verification) error {
+
+	_, err:= pool.Exec("addVerification",
+					v.Name, v.Email, v.VerifyKey,
+					v.StartValid, v.EndValid)
+
+	return err
+
+}
+
+// Generates a verification key for the given address and user.
+//
+// Any outstanding verifications for the user are discarded so only
+// the most recently requested address can be confirmed.
+func RequestVerification(pool *pgx.ConnPool,
+	user, email string) (string, error) {
+	return RequestVerificationMail(pool, user, email, nil)
+}
+
+// Generates a verification as RequestVerification and queues the
+// email carrying it in the same transaction.
+func RequestVerificationMail(pool *pgx.ConnPool,
+	user, email string, compose MailComposer) (string, error) {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return "", fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("removeVerifications", user)
+	if err!=nil {
+		return "", errorHandle(err, "failed to clear old verifications")
+	}
+
+	key:= randString(VerifyLength)
+	// Store the hash of the key and use that for comparisons
+	hashed:= sha256.Sum256([]byte(key))
+
+	now:= time.Now()
+	freshVerification:= Verification{
+		Name: user,
+		Email: email,
+		VerifyKey: hashed[:],
+		StartValid: now,
+		EndValid: now.Add(verifyValidTime),
+	}
+
+	_, err = tx.Exec("addVerification",
+		freshVerification.Name, freshVerification.Email,
+		freshVerification.VerifyKey,
+		freshVerification.StartValid, freshVerification.EndValid)
+	if err!=nil {
+		return "", errorHandle(err, "failed to send fresh verification off to db")
+	}
+
+	if compose!=nil {
+		m, err:= compose(key)
+		if err!=nil {
+			return "", err
+		}
+		err = enqueueMail(tx, m, now)
+		if err!=nil {
+			return "", err
+		}
+	}
+
+	return key, tx.Commit()
+
+}
+
+// Acquires the verification matching the provided key if it is valid.
+func ValidateVerification(pool *pgx.ConnPool,
+	user, verifyKey string) (*Verification, error) {
+
+	// Request a hash matching the key's hash.
+	hashed:= sha256.Sum256([]byte(verifyKey))
+
+	rows, err := pool.Query("getVerification", user, hashed[:])
+	if err!=nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now:= time.Now()
+	for rows.Next(){
+		v:= Verification{}
+		err = rows.Scan(&v.Name, &v.Email, &v.VerifyKey,
+			&v.StartValid, &v.EndValid)
+		if err!=nil {
+			return nil, errorHandle(err, ScanError)
+		}
+
+		// Perform validation
+		if v.Name == user &&
+		subtle.ConstantTimeCompare(hashed[:], v.VerifyKey) == 1 &&
+		now.Before(v.EndValid) && now.After(v.StartValid) {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid Authentication")
+
+}
+
+// Authenticates a verification key and sets the user's email to the
+// address it was sent to, marking it verified.
+//
+// Returns the address which is now in effect.
+func ConfirmVerification(pool *pgx.ConnPool,
+	user, verifyKey string) (string, error) {
+
+	v, err:= ValidateVerification(pool, user, verifyKey)
+	if err!=nil {
+		return "", fmt.Errorf("failed to validate verification: %v", err)
+	}
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return "", fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	_, err = tx.Exec("setEmail", user, v.Email)
+	if err!=nil {
+		return "", fmt.Errorf("failed to set verified email: %v", err)
+	}
+
+	// A used key, and any others, should never be valid again
+	_, err = tx.Exec("removeVerifications", user)
+	if err!=nil {
+		return "", fmt.Errorf("failed to remove verifications: %v", err)
+	}
+
+	err = tx.Commit()
+	if err!=nil {
+		return "", fmt.Errorf("failed to commit verification: %v", err)
+	}
+
+	return v.Email, nil
+
+}
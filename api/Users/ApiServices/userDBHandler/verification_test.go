@@ -0,0 +1,130 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Add some users, verify their signup email, then ensure
+// it is marked as verified.
+func TestVerifications(t *testing.T) {
+	t.Parallel()
+
+	var users []string
+	var keys []string
+
+	var user string
+	var key string
+	var err error
+	for i := 0; i < testCount; i++ {
+		user = randString(int(randByte()))
+		users = append(users, user)
+
+		_, err = AddUser(pool, user, "bar", "foo")
+		if err!=nil {
+			t.Fatal("failed to add user ", err)
+		}
+
+		key, err = RequestVerification(pool, user, "bar")
+		if err!=nil {
+			t.Fatal(err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	time.Sleep(testSleepTime)
+
+	for i := 0; i < testCount; i++ {
+
+		user = users[i]
+		key = keys[i]
+
+		u, err:= GetUser(pool, user)
+		if err!=nil {
+			t.Fatal("failed to get user", err)
+		}
+		if u.Verified {
+			t.Fatal("user was verified before confirming")
+		}
+
+		_, err = ConfirmVerification(pool, user, key)
+		if err!=nil {
+			t.Fatal(err)
+		}
+
+		u, err = GetUser(pool, user)
+		if err!=nil {
+			t.Fatal("failed to get user", err)
+		}
+		if !u.Verified || u.Email != "bar" {
+			t.Fatal("user was not verified after confirming")
+		}
+
+		// Keys are single use
+		_, err = ConfirmVerification(pool, user, key)
+		if err==nil {
+			t.Fatal("was able to reuse a verification key")
+		}
+	}
+
+}
+
+// Request an email change and ensure the address only changes
+// once the new address has been confirmed.
+func TestEmailChange(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(200)
+
+	_, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	// An older request is superseded by a newer one
+	stale, err:= RequestVerification(pool, user, "stale")
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	key, err:= RequestVerification(pool, user, "fresh")
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	u, err:= GetUser(pool, user)
+	if err!=nil {
+		t.Fatal("failed to get user", err)
+	}
+	if u.Email != "bar" {
+		t.Fatal("email changed before being confirmed")
+	}
+
+	_, err = ConfirmVerification(pool, user, stale)
+	if err==nil {
+		t.Fatal("was able to confirm a superseded verification")
+	}
+
+	email, err:= ConfirmVerification(pool, user, key)
+	if err!=nil {
+		t.Fatal(err)
+	}
+	if email != "fresh" {
+		t.Fatal("confirmed the wrong address")
+	}
+
+	u, err = GetUser(pool, user)
+	if err!=nil {
+		t.Fatal("failed to get user", err)
+	}
+	if u.Email != "fresh" || !u.Verified {
+		t.Fatal("email change did not take effect")
+	}
+
+}
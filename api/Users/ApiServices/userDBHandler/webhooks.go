@@ -0,0 +1,124 @@
+package userDB
+
+import(
+
+	"github.com/jackc/pgx"
+
+	"time"
+
+	"fmt"
+)
+
+// Returned once an event has been recorded but can never apply, for a
+// plan we don't offer or a customer we don't know. Redelivering it
+// won't help so it should be acknowledged.
+var ErrEventIgnored = fmt.Errorf("event ignored")
+
+// Brings a user's subscription in line with what stripe reports.
+//
+// eventID is recorded so redelivered events are no-ops. Events about
+// a subscription other than the user's current one, or older than the
+// user's last change, are considered stale and ignored.
+//
+// Returns true if the user's plan actually changed.
+func SyncSub(pool *pgx.ConnPool, eventID, customerID, subID, plan string,
+	at time.Time) (bool, error) {
+
+	return syncSub(pool, eventID, customerID, subID, plan, subID, at)
+
+}
+
+// Returns a user to the free plan after stripe ended their subscription.
+//
+// Semantics match SyncSub, the customerID is retained as in an unsub.
+func EndSub(pool *pgx.ConnPool, eventID, customerID, subID string,
+	at time.Time) (bool, error) {
+
+	return syncSub(pool, eventID, customerID, subID,
+		DefaultSubLevel, DefaultID, at)
+
+}
+
+func syncSub(pool *pgx.ConnPool, eventID, customerID, subID, plan,
+	newSubID string, at time.Time) (bool, error) {
+
+	tx, err:= pool.Begin()
+	if err!=nil {
+		return false, fmt.Errorf("failed to grab a transaction: %v", err)
+	}
+	// Make sure we can safely exit at any time
+	defer tx.Rollback()
+
+	tag, err:= tx.Exec("addWebhookEvent", eventID, time.Now())
+	if err!=nil {
+		return false, errorHandle(err, "failed to record event")
+	}
+	if tag.RowsAffected() == 0 {
+		// Already handled
+		return false, nil
+	}
+
+	_, err = scanPlan(tx.QueryRow("getPlan", plan))
+	if err == pgx.ErrNoRows {
+		err = tx.Commit()
+		if err!=nil {
+			return false, err
+		}
+		return false, ErrEventIgnored
+	}
+	if err!=nil {
+		return false, err
+	}
+
+	s:= Subscription{}
+	err = tx.QueryRow("getSubByCustomer", customerID).Scan(
+		&s.Name , &s.Plan ,
+		&s.CustomerID, &s.SubID,
+		&s.StartTime, &s.Trialed)
+	if err == pgx.ErrNoRows {
+		// A customer whose account has since been deleted
+		err = tx.Commit()
+		if err!=nil {
+			return false, err
+		}
+		return false, ErrEventIgnored
+	}
+	if err!=nil {
+		return false, errorHandle(err, ScanError)
+	}
+
+	stale:= s.SubID != subID || at.Before(s.StartTime)
+	if stale || s.Plan == plan {
+		// Nothing to change but remember we've seen it
+		return false, tx.Commit()
+	}
+
+	_, err = tx.Exec("modSub", s.Name, plan, at,
+		s.CustomerID, newSubID)
+	if err!=nil {
+		return false, err
+	}
+
+	err = setSubEffects(tx, s.Name, plan)
+	if err!=nil {
+		return false, err
+	}
+
+	err = recordAudit(tx, AuditEvent{
+		Name: s.Name,
+		Actor: ActorStripe,
+		Event: AuditSubChanged,
+		Metadata: map[string]string{
+			"plan": plan,
+			"previous": s.Plan,
+			"stripeEvent": eventID,
+		},
+		At: at,
+	})
+	if err!=nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+
+}
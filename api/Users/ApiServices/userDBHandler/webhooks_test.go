@@ -0,0 +1,141 @@
+package userDB
+
+import(
+
+	"testing"
+
+	"time"
+
+)
+
+// Replay a cancellation twice and an out of date change, ensuring
+// only the first delivery takes effect.
+func TestSyncSub(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(int(randByte()))
+	session, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	custID:= randString(int(randByte()))
+	subID:= randString(int(randByte()))
+	err = ModSub(pool, user, "Preordain", custID, subID, session)
+	if err!=nil {
+		t.Fatal("failed to add sub", err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	// Stripe moves them to a different plan
+	later:= time.Now().Add(time.Minute)
+	changed, err:= SyncSub(pool, randString(30), custID, subID,
+		"Sensei's Top", later)
+	if err!=nil || !changed {
+		t.Fatal("failed to sync plan change", err)
+	}
+
+	// A change for some other subscription is ignored
+	changed, err = SyncSub(pool, randString(30), custID,
+		randString(20), "Preordain", later.Add(time.Minute))
+	if err!=nil || changed {
+		t.Fatal("applied a change for a stale subscription", err)
+	}
+
+	// So is anything older than what we have
+	changed, err = SyncSub(pool, randString(30), custID, subID,
+		"Preordain", later.Add(-time.Hour))
+	if err!=nil || changed {
+		t.Fatal("applied an out of date change", err)
+	}
+
+	cancelled:= randString(30)
+	for i := 0; i < 2; i++ {
+		changed, err = EndSub(pool, cancelled, custID, subID,
+			later.Add(time.Minute))
+		if err!=nil {
+			t.Fatal("failed to sync cancellation", err)
+		}
+		if changed != (i == 0) {
+			t.Fatal("cancellation was not idempotent")
+		}
+	}
+
+	s, err:= GetSub(pool, user, session)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+	if s.Plan != DefaultSubLevel || s.SubID != DefaultID ||
+		s.CustomerID != custID {
+		t.Fatal("cancellation did not return user to default", s)
+	}
+
+	u, err:= GetUser(pool, user)
+	if err!=nil {
+		t.Fatal("failed to get user", err)
+	}
+	p, err:= GetPlan(pool, DefaultSubLevel)
+	if err!=nil {
+		t.Fatal("failed to get plan", err)
+	}
+	if u.MaxCollections != p.MaxCollections {
+		t.Fatal("cancellation did not reset sub effects")
+	}
+
+}
+
+// Events for a plan we don't offer or a customer we don't know are
+// recorded and ignored rather than failing on every redelivery.
+func TestSyncSubIgnored(t *testing.T) {
+	t.Parallel()
+
+	user:= randString(int(randByte()))
+	session, err:= AddUser(pool, user, "bar", "foo")
+	if err!=nil {
+		t.Fatal("failed to add user ", err)
+	}
+
+	custID:= randString(int(randByte()))
+	subID:= randString(int(randByte()))
+	err = ModSub(pool, user, "Preordain", custID, subID, session)
+	if err!=nil {
+		t.Fatal("failed to add sub", err)
+	}
+
+	time.Sleep(testSleepTime)
+
+	later:= time.Now().Add(time.Minute)
+	cases:= []struct{
+		customerID, plan string
+	}{
+		{custID, randString(40)},
+		{randString(40), "Sensei's Top"},
+	}
+
+	for _, c:= range cases{
+		eventID:= randString(30)
+
+		changed, err:= SyncSub(pool, eventID, c.customerID, subID,
+			c.plan, later)
+		if err!=ErrEventIgnored || changed {
+			t.Fatal("failed to ignore event", c, err)
+		}
+
+		// Recorded so a redelivery is a plain no-op
+		changed, err = SyncSub(pool, eventID, c.customerID, subID,
+			c.plan, later)
+		if err!=nil || changed {
+			t.Fatal("ignored event was not recorded", c, err)
+		}
+	}
+
+	s, err:= GetSub(pool, user, session)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+	if s.Plan != "Preordain" {
+		t.Fatal("ignored event changed the sub", s)
+	}
+
+}
@@ -0,0 +1,152 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+
+	"./mailer"
+
+	"github.com/emicklei/go-restful"
+
+	"net/http"
+	"time"
+
+)
+
+// How often the outbox is checked for mail that's due.
+const outboxInterval = time.Duration(15) * time.Second
+
+// The most messages claimed in a single round trip.
+const outboxBatch int = 20
+
+// Renders a prepared template in the recipient's locale into mail
+// ready to be queued.
+func (aService *UserService) composeMail(templateId, locale string,
+	content interface{}, to, subject string) (*userDB.Mail, error) {
+
+	text, html, err:= aService.mailer.Render(templateId, locale, content)
+	if err!=nil {
+		return nil, err
+	}
+
+	return &userDB.Mail{
+		To: to,
+		Subject: subject,
+		Body: text,
+		HTML: html,
+	}, nil
+
+}
+
+// Delivers queued mail forever, meant to be run as its own goroutine.
+func (aService *UserService) runOutbox() {
+
+	ticker:= time.NewTicker(outboxInterval)
+	for _ = range ticker.C{
+		err:= aService.deliverMail()
+		if err!=nil {
+			aService.logger.Println("failed to deliver mail", err)
+		}
+	}
+
+}
+
+// Attempts delivery of everything in the outbox that's currently due.
+//
+// Only one pass runs at a time so, once this returns, nothing due
+// beforehand is still in flight from this node.
+func (aService *UserService) deliverMail() error {
+
+	aService.outboxLock.Lock()
+	defer aService.outboxLock.Unlock()
+
+	for {
+		now:= time.Now()
+		claimed, err:= userDB.ClaimMail(aService.pool, outboxBatch, now)
+		if err!=nil {
+			return err
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		for _, m:= range claimed{
+			aService.deliverOne(m, now)
+		}
+	}
+
+}
+
+// Sends a single claimed message and records how it went.
+func (aService *UserService) deliverOne(m userDB.Mail, at time.Time) {
+
+	sendErr:= aService.mailer.SendMultipart(m.Body, m.HTML, m.To, m.Subject)
+	if sendErr==nil {
+		err:= userDB.MarkMailSent(aService.pool, m.ID, time.Now())
+		if err!=nil {
+			aService.logger.Println("failed to mark mail sent", m.ID, err)
+		}
+		return
+	}
+
+	dead, err:= userDB.MarkMailFailed(aService.pool, m, sendErr, at)
+	if err!=nil {
+		aService.logger.Println("failed to mark mail failed", m.ID, err)
+		return
+	}
+	if dead {
+		aService.logger.Println("mail dead-lettered", m.ID, m.To, sendErr)
+	}
+
+}
+
+// Changes a user's subscription and queues the email telling them.
+//
+// The email goes out with the change. If the change can't be recorded
+// the email is queued on its own; stripe has already been told so the
+// user needs a way to reach us if we're out of step.
+func (aService *UserService) modSubWithMail(u *userDB.User,
+	plan, customerID, subID string, sessionKey []byte,
+	templateId, subject string) error {
+
+	contents:= subEmailContents{
+		Name: u.Name,
+		Plan: plan,
+	}
+	targetAddress:= mailer.FormatAddress(u.Name, u.Email)
+	m, err:= aService.composeMail(templateId, u.Locale, contents,
+		targetAddress, subject)
+	if err!=nil {
+		aService.logger.Println("failed to compose email", err)
+		return userDB.ModSub(aService.pool, u.Name, plan,
+			customerID, subID, sessionKey)
+	}
+
+	err = userDB.ModSubMail(aService.pool, u.Name, plan,
+		customerID, subID, sessionKey, m)
+	if err!=nil {
+		queueErr:= userDB.EnqueueMail(aService.pool, m)
+		if queueErr!=nil {
+			aService.logger.Println("failed to queue email", queueErr)
+		}
+	}
+
+	return err
+
+}
+
+// Reports how much mail is waiting, sent, or dead-lettered.
+//
+// Only counts are exposed, never addresses or contents.
+func (aService *UserService) getOutboxStatus(req *restful.Request,
+	resp *restful.Response) {
+
+	stats, err:= userDB.GetOutboxStats(aService.pool)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	resp.WriteEntity(stats)
+
+}
@@ -15,6 +15,7 @@ import(
 
 	"net/http"
 	"log"
+	"sync"
 )
 
 const BadUserName string = "User lookup failed"
@@ -23,6 +24,9 @@ const BadSessionKey string = "Invalid Session Key"
 const BadCredentials string = "Invalid Credentials"
 const BadCaptcha string = "Invalid Re-Captcha"
 const BadTradeContents string = "Invalid trade contents"
+const BadGrading string = "Invalid grading, expected a known grader with a grade from 1 to 10 in half steps"
+const NoSuchTrade string = "No such trade in this collection"
+const TradeNotRevertable string = "Trade already reverted, is itself a revert, or is too old to revert"
 
 const SignupFailure string = "Failed to create user"
 const BodyReadFailure string = "Failed to parse body parameter"
@@ -31,11 +35,38 @@ const DBfailure string = "Database read failed"
 const DBWriteFailure string = "Database read failed"
 
 const BadPlanChoice string = "Invalid plan choice!"
+const BadCoupon string = "Invalid or expired coupon"
+const QuotaExceeded string = "Daily request quota for your plan exceeded"
+const ExportNotIncluded string = "Exports aren't included in your plan"
+
+const UnverifiedEmail string = "Email address has not been verified"
+const AlreadyVerified string = "Email address is already verified"
+const MailFailure string = "Failed to send email"
+
+const TwoFactorFailure string = "Failed to enroll second factor"
+const BadSecondFactor string = "Invalid second factor code"
+
+const BadLocale string = "Invalid locale, expected a form like en or pt-BR"
+
+const BadLimit string = "Invalid limit, must be positive and within the route's maximum"
+const BadSort string = "Invalid sort, expected name, quantity, updated, or value and asc or desc"
+const BadCursor string = "Invalid cursor, it must come from the same query"
+
+const NotPermitted string = "Your role does not permit this action"
+const BadRole string = "Invalid role, expected support, billing, or superuser"
+const BadQuery string = "A search query is required"
+const BadMaxCollections string = "Invalid maximum, must not be negative"
+
+const LockedOut string = "Too many failed logins, try again later"
+const AccountDisabled string = "Account disabled, contact support"
 
 const StripeCustFailure string = "Stripe did not allow customer change"
 const StripeSubFailure string = "Stripe did not allow subscription change"
+const BadWebhook string = "Invalid webhook signature or event"
+const WebhookIgnored string = "Event ignored, it can never apply"
 
-const mailGunMetaLoc string = "mailgunMeta.json"
+// Named for when mailgun was the only backend, see mailer.MailerMeta
+const mailerMetaLoc string = "mailgunMeta.json"
 const recaptchaMetaLoc string = "recaptchaMeta.json"
 const merchantMetaLoc string  = "merchMeta.json"
 
@@ -43,11 +74,16 @@ type UserService struct{
 
 	pool *pgx.ConnPool
 	Service *restful.WebService
+	// Staff only, see registerAdmin
+	Admin *restful.WebService
 	logger *log.Logger
 
 	mailer *mailer.Mailer
-	validator *recaptcha.Validator
-	merch *getPaid.Merch
+	validator recaptcha.Validator
+	merch getPaid.Merchant
+
+	// Held for each pass over the outbox
+	outboxLock sync.Mutex
 
 }
 
@@ -69,19 +105,39 @@ func NewUserService() *UserService {
 	}
 
 	// Acquire and set up all requisites for sending mail
-	aService.setupMailing(mailGunMetaLoc)
+	aService.setupMailing(mailerMetaLoc)
 
 	// Make sure we can rate limit expensive operations
 	aService.setupRecaptcha(recaptchaMetaLoc)
 
 	aService.setupMerchant(merchantMetaLoc)
 
+	// Ensures we have a valid filter for card names/sets
+	//
+	// Other services may do this but better to take an extra .1s at
+	// startup than to risk nuking every attempt at adding a trade.
+	err = populateCardMaps()
+	if err!=nil {
+		userLogger.Fatalln("Failed to acquire ", err)
+	}
+
 	// Finally, register the service
 	err = aService.register()
 	if err!=nil {
 		userLogger.Fatalln("Failed to register UserService, ", err)
 	}
 
+	err = aService.registerAdmin()
+	if err!=nil {
+		userLogger.Fatalln("Failed to register admin service, ", err)
+	}
+
+	// Mail is queued by handlers and delivered in the background
+	go aService.runOutbox()
+
+	// As are the values collections are sorted by
+	go aService.runCardValues()
+
 	return &aService
 
 }
@@ -90,9 +146,12 @@ func NewUserService() *UserService {
 //
 // Mailer templates can be used from the mailer by referencing the template
 // and providing a struct suitable for filling it.
+//
+// Whether mail goes out through mailgun, smtp, or to a directory on disk
+// is decided by the metadata's Backend.
 func (aService *UserService) setupMailing(metaLoc string) {
 
-	mailer, err:= mailer.GetMailerFromFile(mailGunMetaLoc)
+	mailer, err:= mailer.GetMailerFromFile(metaLoc)
 	if err!=nil {
 		aService.logger.Fatalln("Failed to get mailer", err)
 	}
@@ -101,11 +160,14 @@ func (aService *UserService) setupMailing(metaLoc string) {
 
 }
 
-// Readies our ability to accept recaptcha 2.0 responses.
+// Readies our ability to accept captcha responses.
+//
+// Whether those are checked by recaptcha, hcaptcha, or a stub
+// is decided by the metadata's Provider.
 func (aService *UserService) setupRecaptcha(loc string) {
 	validator, err:=  recaptcha.GetValidatorFromFile(loc)
 	if err!=nil {
-		aService.logger.Fatalln("Failed to get recaptcha validator", err)
+		aService.logger.Fatalln("Failed to get captcha validator", err)
 	}
 
 	aService.validator = validator
@@ -115,22 +177,45 @@ func (aService *UserService) setupRecaptcha(loc string) {
 func (aService *UserService) setupMerchant(loc string) {
 	merch, err:=  getPaid.GetMerchantFromFile(loc)
 	if err!=nil {
-		aService.logger.Fatalln("Failed to get recaptcha validator", err)
+		aService.logger.Fatalln("Failed to get merchant", err)
 	}
 
 	aService.merch = merch
 }
 
+// Documents the filters, sort, and page a collection route accepts.
+func collectionQueryParams(ws *restful.WebService,
+	route *restful.RouteBuilder) *restful.RouteBuilder {
+
+	return route.
+		Param(ws.QueryParameter("set",
+			"Only cards from this set").DataType("string")).
+		Param(ws.QueryParameter("name",
+			"Only cards whose name contains this, ignoring case").DataType("string")).
+		Param(ws.QueryParameter("quality",
+			"Only cards of this quality, eg. NM").DataType("string")).
+		Param(ws.QueryParameter("lang",
+			"Only cards in this language, eg. EN").DataType("string")).
+		Param(ws.QueryParameter("comment",
+			"Only cards whose comment contains this, ignoring case").DataType("string")).
+		Param(ws.QueryParameter("location",
+			"Only cards kept here, eg. a binder").DataType("string")).
+		Param(ws.QueryParameter("sort",
+			"Current contents by name, quantity, updated, or value. History is always newest first").DataType("string")).
+		Param(ws.QueryParameter("order",
+			"asc, the default, or desc").DataType("string")).
+		Param(ws.QueryParameter("limit",
+			"The most cards per page, up to 500. Everything by default").DataType("integer")).
+		Param(ws.QueryParameter("cursor",
+			"NextCurrent from the previous page").DataType("string")).
+		Param(ws.QueryParameter("historyCursor",
+			"NextHistorical from the previous page").DataType("string")).
+		Param(ws.QueryParameter("history",
+			"false to leave history out entirely").DataType("boolean"))
+
+}
+
 func (aService *UserService) register() error {
-	
-	// Ensures we have a valid filter for card names/sets
-	//
-	// Other services may do this but better to take an extra .1s at
-	// startup than to risk nuking every attempt at adding a trade.
-	err:= populateCardMaps()
-	if err!=nil {
-		aService.logger.Fatalln("Failed to acquire ", err)
-	}
 
 	userService:= new(restful.WebService)
 	userService.
@@ -165,20 +250,222 @@ func (aService *UserService) register() error {
 		Reads(PasswordBody{}).
 		Returns(http.StatusBadRequest, SignupFailure, nil).
 		Returns(http.StatusBadRequest, BadCaptcha, nil).
+		Returns(http.StatusTooManyRequests, LockedOut, nil).
+		Returns(http.StatusForbidden, AccountDisabled, nil).
+		Returns(http.StatusAccepted, "A LoginChallenge requiring a second factor", LoginChallenge{}).
 		Returns(http.StatusOK, "A valid session code for the user", nil))
 
 	userService.Route(userService.
-		POST("/{userName}/Email").To(aService.getUserEmail).
+		POST("/{userName}/Login/SecondFactor").To(aService.loginSecondFactor).
+		// Docs
+		Doc("Completes a login by exchanging a challenge and second factor for a session").
+		Operation("loginSecondFactor").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Reads(SecondFactorBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadCredentials, nil).
+		Returns(http.StatusTooManyRequests, LockedOut, nil).
+		Returns(http.StatusOK, "A valid session code for the user", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/TwoFactor").To(aService.enrollTwoFactor).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Generates a TOTP secret which takes effect once enabled").
+		Operation("enrollTwoFactor").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Writes(TwoFactorEnrollment{}).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, TwoFactorFailure, nil).
+		Returns(http.StatusOK, "Secret and provisioning uri", TwoFactorEnrollment{}))
+
+	userService.Route(userService.
+		POST("/{userName}/TwoFactor/Enable").To(aService.enableTwoFactor).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Enables a pending second factor given a valid code").
+		Operation("enableTwoFactor").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Reads(TwoFactorCodeBody{}).
+		Writes([]string{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, BadSecondFactor, nil).
+		Returns(http.StatusOK, "Single use recovery codes", []string{}))
+
+	userService.Route(userService.
+		DELETE("/{userName}/TwoFactor").To(aService.disableTwoFactor).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Removes a second factor given a valid code or recovery code").
+		Operation("disableTwoFactor").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Reads(TwoFactorCodeBody{}).
+		Writes(true).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, BadSecondFactor, nil).
+		Returns(http.StatusTooManyRequests, LockedOut, nil).
+		Returns(http.StatusOK, "Second factor removed", nil))
+
+	userService.Route(userService.
+		GET("/{userName}/Email").To(aService.getUserEmail).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempts to get a user's email address").
 		Operation("getUserEmail").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusBadRequest, SignupFailure, nil).
+		Returns(http.StatusBadRequest, BadCaptcha, nil).
+		Returns(http.StatusOK, "john@doe.me", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/Email").To(aService.getUserEmail).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Deprecated, use GET with a bearer token. Attempts to get a user's email address").
+		Operation("getUserEmailDeprecated").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Returns(http.StatusBadRequest, SignupFailure, nil).
 		Returns(http.StatusBadRequest, BadCaptcha, nil).
 		Returns(http.StatusOK, "john@doe.me", nil))
 
+	userService.Route(userService.
+		PATCH("/{userName}/Email").To(aService.changeEmail).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Sends a verification to a new address, which replaces the current one once verified").
+		Operation("changeEmail").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Reads(EmailChangeBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusTooManyRequests, LockedOut, nil).
+		Returns(http.StatusInternalServerError, MailFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Verification sent to the new address", nil))
+
+	userService.Route(userService.
+		PATCH("/{userName}/Locale").To(aService.setLocale).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Sets the language emails to the user are written in").
+		Operation("setLocale").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Reads(LocaleBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadLocale, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes("string").
+		Returns(http.StatusOK, "The normalized locale now in use", nil))
+
+	userService.Route(userService.
+		GET("/{userName}/Export").To(aService.exportUser).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Downloads everything held about the user as a JSON archive").
+		Operation("exportUser").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusForbidden, ExportNotIncluded, nil).
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes(userDB.Export{}).
+		Returns(http.StatusOK, "The user's data", nil))
+
+	userService.Route(userService.
+		GET("/{userName}/SecurityEvents").To(aService.getSecurityEvents).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Lists the user's recent security events, newest first").
+		Operation("getSecurityEvents").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Param(userService.QueryParameter("limit",
+			"How many events to return, 50 by default").DataType("integer")).
+		Returns(http.StatusBadRequest, BadLimit, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes([]userDB.AuditEvent{}).
+		Returns(http.StatusOK, "The user's security events", nil))
+
+	userService.Route(userService.
+		DELETE("/{userName}").To(aService.deleteUser).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Cancels billing then deletes the user and everything tied to them").
+		Operation("deleteUser").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Reads(PasswordBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, StripeSubFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusTooManyRequests, LockedOut, nil).
+		Returns(http.StatusInternalServerError, MailFailure, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Deleted, a confirmation is on its way", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/Verify").To(aService.verifyEmail).
+		// Docs
+		Doc("Confirms an email address using the code sent to it").
+		Operation("verifyEmail").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Reads(VerifyBody{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadCredentials, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Email verified", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/Verify/Resend").To(aService.resendVerification).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Sends another verification code to the user's current address").
+		Operation("resendVerification").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, AlreadyVerified, nil).
+		Returns(http.StatusInternalServerError, MailFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "Verification sent", nil))
+
 	userService.Route(userService.
 		GET("/{userName}/Collections/GetPublic").To(aService.getUserPublicCollections).
 		// Docs
@@ -191,12 +478,30 @@ func (aService *UserService) register() error {
 		Returns(http.StatusOK, "Public collections for a specified user", nil))
 
 	userService.Route(userService.
-		POST("/{userName}/Collections/Get").To(aService.getUserCollections).
+		GET("/{userName}/Collections/Get").To(aService.getUserCollections).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Returns a list of collections an authenticated user").
 		Operation("getUserCollections").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Writes([]string{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusOK, "Collections for a specified user", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/Collections/Get").To(aService.getUserCollections).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Deprecated, use GET with a bearer token. Returns a list of collections an authenticated user").
+		Operation("getUserCollectionsDeprecated").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Writes([]string{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
@@ -206,6 +511,7 @@ func (aService *UserService) register() error {
 	userService.Route(userService.
 		POST("/{userName}/Collections/{collectionName}/Create").
 		To(aService.newCollection).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Adds a new collection with the given name to the user").
 		Operation("getUserCollections").
@@ -213,15 +519,18 @@ func (aService *UserService) register() error {
 			"The name that identifies a user to our service").DataType("string")).
 		Param(userService.PathParameter("collectionName",
 			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Writes(true).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
 		Returns(http.StatusOK, "Collection is added", nil))
 
-	userService.Route(userService.
-		POST("/{userName}/Collections/{collectionName}/Get").
+	userService.Route(collectionQueryParams(userService, userService.
+		GET("/{userName}/Collections/{collectionName}/Get").
 		To(aService.getCollection).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempts to retrieve a collection from an authenticated user").
 		Operation("getUserCollections").
@@ -229,13 +538,39 @@ func (aService *UserService) register() error {
 			"The name that identifies a user to our service").DataType("string")).
 		Param(userService.PathParameter("collectionName",
 			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Writes(CollectionContents{}).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadSort, nil).
+		Returns(http.StatusBadRequest, BadLimit, nil).
+		Returns(http.StatusBadRequest, BadCursor, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusOK, "Collection is returned", nil)))
+
+	userService.Route(collectionQueryParams(userService, userService.
+		POST("/{userName}/Collections/{collectionName}/Get").
+		To(aService.getCollection).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Deprecated, use GET with a bearer token. Attempts to retrieve a collection from an authenticated user").
+		Operation("getUserCollectionsDeprecated").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.PathParameter("collectionName",
+			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Writes(CollectionContents{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadSort, nil).
+		Returns(http.StatusBadRequest, BadLimit, nil).
+		Returns(http.StatusBadRequest, BadCursor, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
-		Returns(http.StatusOK, "Collection is returned", nil))
+		Returns(http.StatusOK, "Collection is returned", nil)))
 
-	userService.Route(userService.
+	userService.Route(collectionQueryParams(userService, userService.
 		GET("/{userName}/Collections/{collectionName}/GetPublic").
 		To(aService.getCollectionPublic).
 		// Docs
@@ -247,12 +582,16 @@ func (aService *UserService) register() error {
 			"The name of a collection for that user").DataType("string")).
 		Writes(CollectionContents{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusBadRequest, BadSort, nil).
+		Returns(http.StatusBadRequest, BadLimit, nil).
+		Returns(http.StatusBadRequest, BadCursor, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
-		Returns(http.StatusOK, "Collection is returned", nil))
+		Returns(http.StatusOK, "Collection is returned", nil)))
 
 	userService.Route(userService.
 		PATCH("/{userName}/Collections/{collectionName}/Permissions").
 		To(aService.setCollectionPermissions).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempt to change public viewing permissions for a collection").
 		Operation("setCollectionPermissions").
@@ -260,6 +599,8 @@ func (aService *UserService) register() error {
 			"The name that identifies a user to our service").DataType("string")).
 		Param(userService.PathParameter("collectionName",
 			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(PermissionChangeBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Writes(true).
@@ -267,8 +608,9 @@ func (aService *UserService) register() error {
 		Returns(http.StatusOK, "Permissions changed", nil))
 
 	userService.Route(userService.
-		POST("/{userName}/Collections/{collectionName}/Permissions").
+		GET("/{userName}/Collections/{collectionName}/Permissions").
 		To(aService.getCollectionPermissions).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempt to acquire public viewing permissions for a collection").
 		Operation("getCollectionPermissions").
@@ -276,6 +618,26 @@ func (aService *UserService) register() error {
 			"The name that identifies a user to our service").DataType("string")).
 		Param(userService.PathParameter("collectionName",
 			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Writes("").
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusOK, "Permissions changed", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/Collections/{collectionName}/Permissions").
+		To(aService.getCollectionPermissions).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Deprecated, use GET with a bearer token. Attempt to acquire public viewing permissions for a collection").
+		Operation("getCollectionPermissionsDeprecated").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.PathParameter("collectionName",
+			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Writes("").
@@ -285,6 +647,7 @@ func (aService *UserService) register() error {
 	userService.Route(userService.
 		POST("/{userName}/Collections/{collectionName}/Trades").
 		To(aService.addTrade).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempt to add a provided trade to a collection").
 		Operation("addTrade").
@@ -292,12 +655,37 @@ func (aService *UserService) register() error {
 			"The name that identifies a user to our service").DataType("string")).
 		Param(userService.PathParameter("collectionName",
 			"The name of a collection for that user").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(TradeAddBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
-		Writes(true).
+		Returns(http.StatusBadRequest, BadTradeContents, nil).
+		Returns(http.StatusBadRequest, BadGrading, nil).
+		Writes(TradeReceipt{}).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
 		Returns(http.StatusOK, "Trade Added", nil))
 
+	userService.Route(userService.
+		POST("/{userName}/Collections/{collectionName}/Trades/{tradeID}/Revert").
+		To(aService.revertTrade).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Undo a recent trade by applying its opposite to the collection").
+		Operation("revertTrade").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.PathParameter("collectionName",
+			"The name of a collection for that user").DataType("string")).
+		Param(userService.PathParameter("tradeID",
+			"The id returned when the trade was added").DataType("integer")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusNotFound, NoSuchTrade, nil).
+		Returns(http.StatusConflict, TradeNotRevertable, nil).
+		Writes(TradeReceipt{}).
+		Returns(http.StatusOK, "Trade Reverted", nil))
+
 	userService.Route(userService.
 		POST("/{userName}/PasswordResetRequest").
 		To(aService.requestPasswordReset).
@@ -309,6 +697,7 @@ func (aService *UserService) register() error {
 		Reads(PasswordResetRequestBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusBadRequest, BadCaptcha, nil).
+		Returns(http.StatusForbidden, UnverifiedEmail, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
 		Writes(true).
 		Returns(http.StatusOK, "Reset Code Sent", nil))
@@ -331,11 +720,14 @@ func (aService *UserService) register() error {
 	userService.Route(userService.
 		POST("/{userName}/Sub").
 		To(aService.addSubUser).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempts to subscribe a user with the provided plan").
 		Operation("subscribe").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SubBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
@@ -343,6 +735,7 @@ func (aService *UserService) register() error {
 		Returns(http.StatusBadRequest, BadPlanChoice, nil).
 		Returns(http.StatusBadRequest, StripeCustFailure, nil).
 		Returns(http.StatusBadRequest, StripeSubFailure, nil).
+		Returns(http.StatusBadRequest, BadCoupon, nil).
 		Writes(true).
 		Returns(http.StatusOK, "Successfully subbed", nil))
 
@@ -350,11 +743,14 @@ func (aService *UserService) register() error {
 	userService.Route(userService.
 		PATCH("/{userName}/Sub").
 		To(aService.modSubUser).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempts to move a user to the provided plan. The user must already be subscribed to change.").
 		Operation("subscribe").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SubBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
@@ -368,11 +764,14 @@ func (aService *UserService) register() error {
 	userService.Route(userService.
 		DELETE("/{userName}/Sub").
 		To(aService.unSubUser).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Attempts to unsubscribe a user with the provided plan").
 		Operation("unSubscribe").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SubBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
@@ -382,13 +781,56 @@ func (aService *UserService) register() error {
 		Returns(http.StatusOK, "Successfully unsubbed", nil))
 
 	userService.Route(userService.
-		POST("/{userName}/SubStatus").
+		GET("/{userName}/Sub/Preview").
+		To(aService.previewSubUser).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Previews the prorated amount moving a subscribed user to the provided plan would charge immediately").
+		Operation("previewSubscription").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Param(userService.QueryParameter("plan",
+			"The plan they would move to").DataType("string")).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, BadPlanChoice, nil).
+		Returns(http.StatusBadRequest, StripeSubFailure, nil).
+		Writes(getPaid.Proration{}).
+		Returns(http.StatusOK, "The immediate cost, negative for a credit", nil))
+
+	userService.Route(userService.
+		GET("/{userName}/SubStatus").
 		To(aService.getSubUser).
+		Filter(aService.sessionFilter).
 		// Docs
 		Doc("Acquires the plan a given user is subscribed to").
 		Operation("subscribe").
 		Param(userService.PathParameter("userName",
 			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusBadRequest, DBfailure, nil).
+		Returns(http.StatusBadRequest, BadPlanChoice, nil).
+		Returns(http.StatusBadRequest, StripeCustFailure, nil).
+		Returns(http.StatusBadRequest, StripeSubFailure, nil).
+		Writes(userDB.DefaultSubLevel).
+		Returns(http.StatusOK, "userDB.DefaultSubLevel", nil))
+
+	userService.Route(userService.
+		POST("/{userName}/SubStatus").
+		To(aService.getSubUser).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Deprecated, use GET with a bearer token. Acquires the plan a given user is subscribed to").
+		Operation("subscribeDeprecated").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
 		Reads(SessionKeyBody{}).
 		Returns(http.StatusBadRequest, BodyReadFailure, nil).
 		Returns(http.StatusUnauthorized, BadCredentials, nil).
@@ -399,6 +841,47 @@ func (aService *UserService) register() error {
 		Writes(userDB.DefaultSubLevel).
 		Returns(http.StatusOK, "userDB.DefaultSubLevel", nil))
 
+	userService.Route(userService.
+		GET("/Plans").
+		To(aService.getPlans).
+		// Docs
+		Doc("Acquires every plan and what each entitles").
+		Operation("getPlans").
+		Returns(http.StatusInternalServerError, DBfailure, nil).
+		Writes([]userDB.Plan{}).
+		Returns(http.StatusOK, "Every plan, cheapest first", nil))
+
+	userService.Route(userService.
+		GET("/{userName}/Plan").
+		To(aService.getPlanUser).
+		Filter(aService.sessionFilter).
+		// Docs
+		Doc("Acquires what the plan a given user is subscribed to entitles").
+		Operation("getPlanUser").
+		Param(userService.PathParameter("userName",
+			"The name that identifies a user to our service").DataType("string")).
+		Param(userService.HeaderParameter(authHeader,
+			authHeaderDoc).DataType("string")).
+		Returns(http.StatusBadRequest, BodyReadFailure, nil).
+		Returns(http.StatusUnauthorized, BadCredentials, nil).
+		Returns(http.StatusTooManyRequests, QuotaExceeded, nil).
+		Returns(http.StatusBadRequest, DBfailure, nil).
+		Writes(userDB.Plan{}).
+		Returns(http.StatusOK, "The user's entitlements", nil))
+
+	userService.Route(userService.
+		POST("/Webhooks/Stripe").
+		To(aService.stripeWebhook).
+		// Docs
+		Doc("Receives signed subscription and invoice events from stripe").
+		Operation("stripeWebhook").
+		Param(userService.HeaderParameter(getPaid.SignatureHeader,
+			"Signature stripe computed over the body").DataType("string")).
+		Returns(http.StatusBadRequest, BadWebhook, nil).
+		Returns(http.StatusInternalServerError, DBWriteFailure, nil).
+		Writes(true).
+		Returns(http.StatusOK, "true once handled, or WebhookIgnored", nil))
+
 
 	aService.Service = userService
 
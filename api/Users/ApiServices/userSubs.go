@@ -4,11 +4,10 @@ import(
 
 	"./userDBHandler"
 
-	"./mailer"
-
 	"github.com/emicklei/go-restful"
 
 	"net/http"
+	"time"
 
 )
 
@@ -31,6 +30,9 @@ func (aService *UserService) modSubUser(req *restful.Request,
 		return
 	}
 
+	subContainer.SessionKey = preferFilteredSessionKey(req,
+		subContainer.SessionKey)
+
 	// Check to ensure we actually got a valid session
 	if subContainer.SessionKey == nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
@@ -50,6 +52,13 @@ func (aService *UserService) modSubUser(req *restful.Request,
 		return
 	}
 
+	// Make sure the plan actually exists
+	_, err = userDB.GetPlan(aService.pool, subContainer.Plan)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
 	// Make sure we aren't double charging them.
 	validChoice, err:= userDB.DifferentPlan(aService.pool,
 		userName, subContainer.Plan)
@@ -62,6 +71,13 @@ func (aService *UserService) modSubUser(req *restful.Request,
 		return
 	}
 
+	// Grab their email so we can let them know
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
 	// Change the customer's payment method to the one they just provided
 	err = aService.merch.UpdateCustomer(sub.CustomerID,
 		subContainer.PaymentMethod)
@@ -82,31 +98,14 @@ func (aService *UserService) modSubUser(req *restful.Request,
 	// and its various effects.
 	//
 	// Notice that we IGNORE the error as we want to send the user
-	// the following email to ensure they can contact us if we fail
+	// the email regardless to ensure they can contact us if we fail
 	// to change the DB but already have stripe charging them.
-	_ = userDB.ModSub(aService.pool,
-		userName, subContainer.Plan,
-		sub.CustomerID, sub.SubID, nil)
-
-	// Grab their email so we can let them know
-	u, err:= userDB.GetUser(aService.pool, userName)
-	if err!=nil {
-		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
-		return
-	}
-
-	// Email them that we were successful!
-	contents:= subEmailContents{
-		Name: userName,
-		Plan: subContainer.Plan,
-	}
-	targetAddress:= mailer.FormatAddress(userName, u.Email)
-	err = aService.mailer.SendPrepared("subSuccess", contents,
-		targetAddress, "Subscribed! - Preorda.in")
-	if err!=nil {
-		aService.logger.Println("failed to send email", err)
-	}
+	_ = aService.modSubWithMail(u, subContainer.Plan,
+		sub.CustomerID, sub.SubID, nil,
+		"subSuccess", "Subscribed! - Preorda.in")
 
+	aService.audit(req, userName, userDB.AuditSubChanged,
+		map[string]string{"plan": subContainer.Plan})
 
 	resp.WriteEntity(true)
 
@@ -126,6 +125,9 @@ func (aService *UserService) addSubUser(req *restful.Request,
 		return
 	}
 
+	subContainer.SessionKey = preferFilteredSessionKey(req,
+		subContainer.SessionKey)
+
 	// Check to ensure we actually got a valid session
 	if subContainer.SessionKey == nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
@@ -139,6 +141,13 @@ func (aService *UserService) addSubUser(req *restful.Request,
 		return
 	}
 
+	// Make sure the plan actually exists
+	plan, err:= userDB.GetPlan(aService.pool, subContainer.Plan)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
 	// Make sure we aren't double charging them.
 	validChoice, err:= userDB.DifferentPlan(aService.pool,
 		userName, subContainer.Plan)
@@ -158,21 +167,76 @@ func (aService *UserService) addSubUser(req *restful.Request,
 		return
 	}
 
+	now:= time.Now()
+
+	// Promo codes must be one we're still honouring for this plan.
+	//
+	// Claim it up front so limits hold, giving it back if stripe
+	// refuses them.
+	coupon:= subContainer.Coupon
+	if coupon != "" {
+		err = userDB.RedeemCoupon(aService.pool, coupon, plan.Name, now)
+		if err!=nil {
+			resp.WriteErrorString(http.StatusBadRequest, BadCoupon)
+			return
+		}
+	}
+	releaseCoupon:= func() {
+		if coupon == "" {
+			return
+		}
+		err:= userDB.ReleaseCoupon(aService.pool, coupon)
+		if err!=nil {
+			aService.logger.Println("failed to release coupon", coupon, err)
+		}
+	}
+
+	// Everyone gets one trial, claimed up front as coupons are so two
+	// subscriptions at once can't both be given it.
+	var trialEnd time.Time
+	if !sub.Trialed && plan.TrialDays > 0 {
+		claimed, err:= userDB.ClaimTrial(aService.pool, userName)
+		if err!=nil {
+			releaseCoupon()
+			resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+			return
+		}
+		if claimed {
+			trialEnd = plan.TrialEnd(now)
+		}
+	}
+
+	// Gives back whatever was claimed when stripe refuses them
+	release:= func() {
+		releaseCoupon()
+		if trialEnd.IsZero() {
+			return
+		}
+		err:= userDB.ReleaseTrial(aService.pool, userName)
+		if err!=nil {
+			aService.logger.Println("failed to release trial", userName, err)
+		}
+	}
+
 	// Check if we need to add them as a customer
 	custID:= sub.CustomerID
 	if custID == userDB.DefaultID {
-		// Add them as a customer as needed
+		// Add them as a customer as needed, coupons are applied
+		// to the subscription so they work for returning customers.
 		custID, err = aService.merch.AddCustomer(subContainer.PaymentMethod,
-			u.Email, subContainer.Coupon)
+			u.Email, "")
 		if err!=nil {
+			release()
 			resp.WriteErrorString(http.StatusBadRequest, StripeCustFailure)
 			return
 		}	
 	}
 
 	// Add them as a subscriber.
-	subID, err:= aService.merch.SubCustomer(custID, subContainer.Plan)
+	subID, err:= aService.merch.SubCustomer(custID, plan.Name,
+		coupon, trialEnd)
 	if err!=nil {
+		release()
 		resp.WriteErrorString(http.StatusBadRequest, StripeSubFailure)
 		return
 	}
@@ -180,24 +244,14 @@ func (aService *UserService) addSubUser(req *restful.Request,
 	// Now update their entries in the database.
 	//
 	// Notice that we IGNORE the error as we want to send the user
-	// the following email to ensure they can contact us if we fail
+	// the email regardless to ensure they can contact us if we fail
 	// to change the DB but already have stripe charging them.
-	userDB.ModSub(aService.pool, userName, subContainer.Plan,
-		custID, subID, subContainer.SessionKey)
-
-
-	// Email them that we were successful!
-	contents:= subEmailContents{
-		Name: userName,
-		Plan: subContainer.Plan,
-	}
-	targetAddress:= mailer.FormatAddress(userName, u.Email)
-	err = aService.mailer.SendPrepared("subSuccess", contents,
-		targetAddress, "Subscribed! - Preorda.in")
-	if err!=nil {
-		aService.logger.Println("failed to send email", err)
-	}
+	_ = aService.modSubWithMail(u, subContainer.Plan,
+		custID, subID, subContainer.SessionKey,
+		"subSuccess", "Subscribed! - Preorda.in")
 
+	aService.audit(req, userName, userDB.AuditSubChanged,
+		map[string]string{"plan": subContainer.Plan})
 
 	resp.WriteEntity(true)
 
@@ -217,6 +271,9 @@ func (aService *UserService) unSubUser(req *restful.Request,
 		return
 	}
 
+	subContainer.SessionKey = preferFilteredSessionKey(req,
+		subContainer.SessionKey)
+
 	// Check to ensure we actually got a valid session
 	if subContainer.SessionKey == nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
@@ -230,6 +287,13 @@ func (aService *UserService) unSubUser(req *restful.Request,
 		return
 	}
 
+	// Grab their email so we can let them know
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
 	// Remove their subscription but retain their customerID
 	err = aService.merch.UnSubCustomer(sub.SubID, sub.CustomerID)
 	if err!=nil {
@@ -240,40 +304,66 @@ func (aService *UserService) unSubUser(req *restful.Request,
 	// Mod the sub to be the default free version.
 	//
 	// Set dummy sub ID but hold onto that customerID
-	err = userDB.ModSub(aService.pool, userName, userDB.DefaultSubLevel,
-		sub.CustomerID, userDB.DefaultID, subContainer.SessionKey)
+	err = aService.modSubWithMail(u, userDB.DefaultSubLevel,
+		sub.CustomerID, userDB.DefaultID, subContainer.SessionKey,
+		"unSubSuccess", "unSubscribed! - Preorda.in")
 	if err!=nil {
 		resp.WriteErrorString(http.StatusBadRequest, DBWriteFailure)
 		return
 	}
 
-	// Grab their email so we can let them know
-	u, err:= userDB.GetUser(aService.pool, userName)
+	aService.audit(req, userName, userDB.AuditSubChanged,
+		map[string]string{"plan": userDB.DefaultSubLevel})
+
+	resp.WriteEntity(true)
+
+}
+
+// Returns the user's subscription status as a string
+func (aService *UserService) getSubUser(req *restful.Request,
+	resp *restful.Response) {
+	
+	userName, sessionKey, err:= getUserNameAndSessionKey(req)
 	if err!=nil {
-		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
 		return
 	}
 
-	// Email them that we were successful!
-	contents:= subEmailContents{
-		Name: userName,
-		Plan: subContainer.Plan,
+	if sessionKey == nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
 	}
-	targetAddress:= mailer.FormatAddress(userName, u.Email)
-	err = aService.mailer.SendPrepared("unSubSuccess", contents,
-		targetAddress, "unSubscribed! - Preorda.in")
+
+	s, err:= userDB.GetSub(aService.pool, userName, sessionKey)
 	if err!=nil {
-		aService.logger.Println("failed to send email", err)
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
 	}
 
-	resp.WriteEntity(true)
+	resp.WriteEntity(s.Plan)
 
 }
 
-// Returns the user's subscription status as a string
-func (aService *UserService) getSubUser(req *restful.Request,
+// Returns every plan and what each entitles
+func (aService *UserService) getPlans(req *restful.Request,
 	resp *restful.Response) {
-	
+
+	plans, err:= userDB.GetPlans(aService.pool)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+
+	setCacheHeader(resp)
+
+	resp.WriteEntity(plans)
+
+}
+
+// Returns what the user's current plan entitles them to
+func (aService *UserService) getPlanUser(req *restful.Request,
+	resp *restful.Response) {
+
 	userName, sessionKey, err:= getUserNameAndSessionKey(req)
 	if err!=nil {
 		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
@@ -285,12 +375,64 @@ func (aService *UserService) getSubUser(req *restful.Request,
 		return
 	}
 
-	s, err:= userDB.GetSub(aService.pool, userName, sessionKey)
+	err = userDB.SessionAuth(aService.pool, userName, sessionKey)
 	if err!=nil {
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
 	}
 
-	resp.WriteEntity(s.Plan)
+	p, err:= userDB.GetUserPlan(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
+	resp.WriteEntity(p)
+
+}
+
+// Previews what moving to a plan through modSubUser would cost
+// immediately, prorated over the remainder of their billing period.
+func (aService *UserService) previewSubUser(req *restful.Request,
+	resp *restful.Response) {
+
+	userName, sessionKey, err:= getUserNameAndSessionKey(req)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
 
-}
\ No newline at end of file
+	if sessionKey == nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
+
+	plan:= req.QueryParameter("plan")
+	_, err = userDB.GetPlan(aService.pool, plan)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
+	sub, err:= userDB.GetSub(aService.pool, userName, sessionKey)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
+	// Only a paid subscription can be changed
+	if sub.CustomerID == userDB.DefaultID ||
+	sub.SubID == userDB.DefaultID || sub.Plan == plan {
+		resp.WriteErrorString(http.StatusBadRequest, BadPlanChoice)
+		return
+	}
+
+	proration, err:= aService.merch.PreviewSubChange(sub.CustomerID,
+		sub.SubID, plan, time.Now())
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, StripeSubFailure)
+		return
+	}
+
+	resp.WriteEntity(proration)
+
+}
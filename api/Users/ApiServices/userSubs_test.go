@@ -0,0 +1,347 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+	"./goGetPaid"
+
+	"testing"
+
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Subscribes a fresh user to a plan, returning their name, session,
+// and resulting subscription.
+func subscribeTestUser(t *testing.T,
+	plan string) (string, []byte, *userDB.Subscription) {
+
+	name, sessionKey:= addTestUser(t)
+
+	resp:= doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: plan,
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to subscribe", resp.Code, resp.Body.String())
+	}
+
+	s, err:= userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+
+	return name, sessionKey, s
+
+}
+
+func TestAddSub(t *testing.T) {
+	t.Parallel()
+
+	subbed, _, s:= subscribeTestUser(t, "Preordain")
+	if s.Plan != "Preordain" {
+		t.Fatal("plan was not recorded", s.Plan)
+	}
+
+	sent:= sentTo(t, subbed)
+	if len(sent) != 1 || sent[0].Subject != "Subscribed! - Preorda.in" ||
+		!strings.Contains(sent[0].Body, "Preordain") {
+		t.Fatal("subscription email was not sent", sent)
+	}
+
+	c, ok:= testMerch.Customer(s.CustomerID)
+	if !ok || c.Token != "tok_visa" {
+		t.Fatal("customer was not added to merchant", s.CustomerID)
+	}
+	fakeSub, ok:= testMerch.Sub(s.SubID)
+	if !ok || fakeSub.Plan != "Preordain" ||
+		fakeSub.Customer != s.CustomerID {
+		t.Fatal("subscription was not added to merchant", s.SubID)
+	}
+
+	// A declined card changes nothing
+	name, sessionKey:= addTestUser(t)
+	resp:= doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: getPaid.DeclinedToken,
+	})
+	if resp.Code != http.StatusBadRequest ||
+		resp.Body.String() != StripeCustFailure {
+		t.Fatal("declined card was not refused", resp.Code)
+	}
+	s, err:= userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil || s.Plan != userDB.DefaultSubLevel {
+		t.Fatal("declined card changed the plan", err)
+	}
+	if s.Trialed {
+		t.Fatal("declined card used up the trial")
+	}
+
+	// As does someone else's session
+	_, otherSession:= addTestUser(t)
+	resp = doRequest(t, "POST", "/" + name + "/Sub", otherSession, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatal("subscribed with another user's session", resp.Code)
+	}
+
+}
+
+func TestModSub(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey, s:= subscribeTestUser(t, "Preordain")
+
+	resp:= doRequest(t, "PATCH", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Sensei's Top",
+		PaymentMethod: "tok_mastercard",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to change plan", resp.Code, resp.Body.String())
+	}
+
+	modded, err:= userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+	if modded.Plan != "Sensei's Top" || modded.SubID != s.SubID ||
+		modded.CustomerID != s.CustomerID {
+		t.Fatal("plan change was not recorded", modded)
+	}
+
+	fakeSub, _:= testMerch.Sub(s.SubID)
+	if fakeSub.Plan != "Sensei's Top" {
+		t.Fatal("plan change did not reach merchant", fakeSub.Plan)
+	}
+	c, _:= testMerch.Customer(s.CustomerID)
+	if c.Token != "tok_mastercard" {
+		t.Fatal("payment method change did not reach merchant")
+	}
+
+	// Moving to the plan they're already on is refused
+	resp = doRequest(t, "PATCH", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Sensei's Top",
+		PaymentMethod: "tok_mastercard",
+	})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("allowed a duplicate plan", resp.Code)
+	}
+
+	// As is modifying without ever subscribing
+	name, sessionKey = addTestUser(t)
+	resp = doRequest(t, "PATCH", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("modified a subscription that doesn't exist", resp.Code)
+	}
+
+}
+
+func TestUnSub(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey, s:= subscribeTestUser(t, "Sensei's Top")
+
+	resp:= doRequest(t, "DELETE", "/" + name + "/Sub", sessionKey, SubBody{})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to unsubscribe", resp.Code, resp.Body.String())
+	}
+
+	unsubbed, err:= userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+	if unsubbed.Plan != userDB.DefaultSubLevel ||
+		unsubbed.SubID != userDB.DefaultID ||
+		unsubbed.CustomerID != s.CustomerID {
+		t.Fatal("unsubscribe was not recorded", unsubbed)
+	}
+
+	fakeSub, _:= testMerch.Sub(s.SubID)
+	if !fakeSub.Cancelled {
+		t.Fatal("unsubscribe did not reach merchant")
+	}
+
+	sent:= sentTo(t, name)
+	if len(sent) != 2 || sent[1].Subject != "unSubscribed! - Preorda.in" {
+		t.Fatal("unsubscribe email was not sent", sent)
+	}
+
+	u, err:= userDB.GetUser(testService.pool, name)
+	if err!=nil {
+		t.Fatal("failed to get user", err)
+	}
+	p, err:= userDB.GetPlan(testService.pool, userDB.DefaultSubLevel)
+	if err!=nil {
+		t.Fatal("failed to get plan", err)
+	}
+	if u.MaxCollections != p.MaxCollections {
+		t.Fatal("unsubscribe did not reset sub effects")
+	}
+
+}
+
+// Plans come from the database and unknown ones are refused before
+// the merchant is ever involved.
+func TestPlans(t *testing.T) {
+	t.Parallel()
+
+	resp:= doRequest(t, "GET", "/Plans", nil, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get plans", resp.Code)
+	}
+	var plans []userDB.Plan
+	err:= json.Unmarshal(resp.Body.Bytes(), &plans)
+	if err!=nil || len(plans) == 0 {
+		t.Fatal("failed to parse plans", err)
+	}
+
+	name, sessionKey:= addTestUser(t)
+	resp = doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Not A Plan",
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusBadRequest ||
+		resp.Body.String() != BadPlanChoice {
+		t.Fatal("subscribed to a plan that doesn't exist", resp.Code)
+	}
+
+	resp = doRequest(t, "GET", "/" + name + "/Plan", sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get user plan", resp.Code)
+	}
+	var p userDB.Plan
+	err = json.Unmarshal(resp.Body.Bytes(), &p)
+	if err!=nil || p.Name != userDB.DefaultSubLevel {
+		t.Fatal("user was not on the default plan", err)
+	}
+
+}
+
+// A first subscription gets the plan's trial, later ones don't.
+func TestSubTrial(t *testing.T) {
+	t.Parallel()
+
+	plan, err:= userDB.GetPlan(testService.pool, "Preordain")
+	if err!=nil {
+		t.Fatal("failed to get plan", err)
+	}
+
+	name, sessionKey, s:= subscribeTestUser(t, plan.Name)
+	fakeSub, _:= testMerch.Sub(s.SubID)
+	if fakeSub.TrialEnd.IsZero() != (plan.TrialDays == 0) {
+		t.Fatal("first subscription trial did not match plan")
+	}
+	if plan.TrialDays > 0 && !s.Trialed {
+		t.Fatal("trial was not recorded")
+	}
+
+	resp:= doRequest(t, "DELETE", "/" + name + "/Sub", sessionKey, SubBody{})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to unsubscribe", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: plan.Name,
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to resubscribe", resp.Code, resp.Body.String())
+	}
+	s, err = userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil {
+		t.Fatal("failed to get sub", err)
+	}
+	fakeSub, _ = testMerch.Sub(s.SubID)
+	if !fakeSub.TrialEnd.IsZero() {
+		t.Fatal("second subscription was given a trial")
+	}
+
+	// Which can then preview an upgrade
+	resp = doRequest(t, "GET",
+		"/" + name + "/Sub/Preview?plan=Sensei%27s+Top", sessionKey, nil)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to preview", resp.Code, resp.Body.String())
+	}
+	var proration getPaid.Proration
+	err = json.Unmarshal(resp.Body.Bytes(), &proration)
+	if err!=nil {
+		t.Fatal("failed to parse proration", err)
+	}
+	if proration.Amount <= 0 || proration.Amount > 500 {
+		t.Fatal("upgrade was not prorated", proration.Amount)
+	}
+
+	// But not a move to where they already are
+	resp = doRequest(t, "GET",
+		"/" + name + "/Sub/Preview?plan=Preordain", sessionKey, nil)
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("previewed a change to the same plan", resp.Code)
+	}
+
+}
+
+// Codes not in users.coupons are refused before stripe is involved
+func TestSubBadCoupon(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+	resp:= doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: "tok_visa",
+		Coupon: randName(),
+	})
+	if resp.Code != http.StatusBadRequest ||
+		resp.Body.String() != BadCoupon {
+		t.Fatal("accepted an unknown coupon", resp.Code)
+	}
+
+	s, err:= userDB.GetSub(testService.pool, name, sessionKey)
+	if err!=nil || s.Plan != userDB.DefaultSubLevel {
+		t.Fatal("unknown coupon changed the plan", err)
+	}
+
+}
+
+// Sessions provided through the deprecated body field count against
+// the plan's quota just as bearer sessions do.
+func TestBodySessionKeyMetered(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+
+	p, err:= userDB.GetUserPlan(testService.pool, name)
+	if err!=nil {
+		t.Fatal("failed to get plan", err)
+	}
+	if !p.Metered() {
+		t.Fatal("default plan is unmetered")
+	}
+
+	// Leave exactly one request in their quota
+	now:= time.Now()
+	for i:= int32(1); i < p.DailyQuota; i++ {
+		_, err = userDB.UseAPIQuota(testService.pool, name, now)
+		if err!=nil {
+			t.Fatal("failed to use quota", err)
+		}
+	}
+
+	body:= SessionKeyBody{SessionKey: sessionKey}
+	resp:= doRequest(t, "POST", "/" + name + "/Collections/Get", nil, body)
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to get collections", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Collections/Get", nil, body)
+	if resp.Code != http.StatusTooManyRequests {
+		t.Fatal("body session key was not metered", resp.Code)
+	}
+
+}
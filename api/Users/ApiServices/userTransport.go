@@ -4,6 +4,8 @@ import(
 
 	"./userDBHandler"
 
+	"time"
+
 )
 
 // While gross, having a struct for each request lets me keep this as a json
@@ -17,13 +19,17 @@ type NewUserData struct{
 
 type PasswordBody struct{
 	Password string
+	// Only required after repeated failed logins
+	RecaptchaResponseField string
 }
 
+// Deprecated: send 'Authorization: Bearer <key>' instead.
 type SessionKeyBody struct{
 	SessionKey []byte
 }
 
 type PermissionChangeBody struct{
+	// Deprecated: prefer the Authorization header
 	SessionKey []byte
 	Privacy string
 }
@@ -31,10 +37,18 @@ type PermissionChangeBody struct{
 type TradeAddBody struct{
 
 	Trade []userDB.Card
+	// Deprecated: prefer the Authorization header
 	SessionKey []byte
 
 }
 
+// Identifies a trade, whether just added or the revert undoing one.
+type TradeReceipt struct{
+
+	TradeID int64
+
+}
+
 type PasswordResetRequestBody struct{
 
 	RecaptchaResponseField string
@@ -48,12 +62,97 @@ type PasswordResetBody struct{
 
 }
 
+type VerifyBody struct{
+
+	VerificationToken string
+
+}
+
+type EmailChangeBody struct{
+
+	Email, Password string
+
+}
+
+type LocaleBody struct{
+
+	// Such as en, es, or pt-BR
+	Locale string
+
+}
+
+type TwoFactorCodeBody struct{
+
+	// Either a current TOTP code or an unused recovery code
+	Code string
+
+}
+
+type SecondFactorBody struct{
+
+	Challenge, Code string
+
+}
+
+type TwoFactorEnrollment struct{
+	Secret, ProvisioningURI string
+}
+
+type LoginChallenge struct{
+	Challenge string
+	SecondFactorRequired bool
+}
+
 type CollectionContents struct{
 	Current []userDB.Card
 	Historical []userDB.Card
+
+	// Pass back as cursor and historyCursor for the following page,
+	// empty once everything has been seen.
+	NextCurrent, NextHistorical string
 }
 
 type SubBody struct{
 	Plan, PaymentMethod, Coupon string
+	// Deprecated: prefer the Authorization header
 	SessionKey []byte
-}
\ No newline at end of file
+}
+
+type AdminPlanBody struct{
+
+	Plan string
+
+}
+
+type MaxCollectionsBody struct{
+
+	MaxCollections int32
+
+}
+
+type DisabledBody struct{
+
+	Disabled bool
+
+}
+
+type AdminRoleBody struct{
+
+	// One of support, billing, or superuser
+	Role string
+
+}
+
+// Everything support needs to see about a user.
+type AdminUserView struct{
+	Name, Email string
+	Verified, Disabled bool
+	Locale string
+	MaxCollections int32
+	Longestview time.Duration
+
+	TwoFactorEnabled bool
+
+	Sub *userDB.Subscription
+	Collections []userDB.Collection
+}
@@ -0,0 +1,150 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+	"./totp"
+
+	"github.com/emicklei/go-restful"
+
+	"net/http"
+	"time"
+
+)
+
+// The issuer authenticator apps will display alongside the account
+const totpIssuer string = "Preorda.in"
+
+// Begins enrolment of a TOTP second factor for an authenticated user.
+//
+// The secret has no effect until it is enabled with a valid code.
+func (aService *UserService) enrollTwoFactor(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	secret, err:= userDB.EnrollTwoFactor(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, TwoFactorFailure)
+		return
+	}
+
+	resp.WriteEntity(TwoFactorEnrollment{
+		Secret: secret,
+		ProvisioningURI: totp.ProvisioningURI(secret, totpIssuer, userName),
+	})
+
+}
+
+// Enables a pending second factor, returning the user's recovery codes.
+func (aService *UserService) enableTwoFactor(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	var codeContainer TwoFactorCodeBody
+	err:= req.ReadEntity(&codeContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	codes, err:= userDB.EnableTwoFactor(aService.pool,
+		userName, codeContainer.Code, time.Now())
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadSecondFactor)
+		return
+	}
+
+	aService.audit(req, userName, userDB.AuditTwoFactorEnabled, nil)
+
+	resp.WriteEntity(codes)
+
+}
+
+// Removes a user's second factor given a valid code or recovery code.
+func (aService *UserService) disableTwoFactor(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	var codeContainer TwoFactorCodeBody
+	err:= req.ReadEntity(&codeContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	// A session alone mustn't allow unlimited guesses at the code
+	ip:= getIP(req)
+	now:= time.Now()
+	if !aService.loginAllowed(resp, userName, ip, now) {
+		return
+	}
+
+	err = userDB.DisableTwoFactor(aService.pool,
+		userName, codeContainer.Code, now)
+	if err!=nil {
+		aService.audit(req, userName, userDB.AuditSecondFactorFailed, nil)
+		aService.recordLoginFailure(userName, ip, now)
+		resp.WriteErrorString(http.StatusBadRequest, BadSecondFactor)
+		return
+	}
+
+	aService.audit(req, userName, userDB.AuditTwoFactorDisabled, nil)
+
+	resp.WriteEntity(true)
+
+}
+
+// Completes a login started with a password by providing a
+// second factor. Returns a valid session key
+func (aService *UserService) loginSecondFactor(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+
+	var secondContainer SecondFactorBody
+	err:= req.ReadEntity(&secondContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	// Second factor guesses share the password's throttle, otherwise
+	// anyone holding the password could guess codes without limit
+	ip:= getIP(req)
+	now:= time.Now()
+	if !aService.loginAllowed(resp, userName, ip, now) {
+		return
+	}
+
+	sessionKey, err:= userDB.CompleteLogin(aService.pool, userName,
+		secondContainer.Challenge, secondContainer.Code, now)
+	if err!=nil {
+		aService.auditAs(userDB.ActorAnonymous, ip, userName,
+			userDB.AuditSecondFactorFailed, nil)
+		aService.recordLoginFailure(userName, ip, now)
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
+
+	aService.loginSucceeded(userName)
+	aService.audit(req, userName, userDB.AuditLogin,
+		map[string]string{"secondFactor": "true"})
+
+	resp.WriteEntity(sessionKey)
+
+}
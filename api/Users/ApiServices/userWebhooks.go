@@ -0,0 +1,110 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+	"./goGetPaid"
+
+	"github.com/emicklei/go-restful"
+
+	"io/ioutil"
+	"io"
+	"net/http"
+	"time"
+
+)
+
+// Stripe events are small, anything larger isn't from them.
+const maxWebhookSize int64 = 64 * 1024
+
+// Receives signed subscription and invoice events from stripe so
+// changes made on their side, declines and cancellations, reach
+// users.subs.
+//
+// Responding with anything but a 2xx has stripe redeliver later so
+// we only do so when a retry could help.
+func (aService *UserService) stripeWebhook(req *restful.Request,
+	resp *restful.Response) {
+
+	payload, err:= ioutil.ReadAll(io.LimitReader(req.Request.Body,
+		maxWebhookSize))
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	e, err:= aService.merch.ParseWebhook(payload,
+		req.HeaderParameter(getPaid.SignatureHeader), time.Now())
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadWebhook)
+		return
+	}
+
+	changed, err:= aService.applyStripeEvent(e)
+	if err==userDB.ErrEventIgnored {
+		aService.logger.Println("ignored stripe event", e.ID, e.Type)
+		resp.WriteEntity(WebhookIgnored)
+		return
+	}
+	if err!=nil {
+		aService.logger.Println("failed to apply stripe event",
+			e.ID, e.Type, err)
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+	if changed {
+		aService.logger.Println("stripe event", e.ID, e.Type,
+			"changed a subscription")
+	}
+
+	resp.WriteEntity(true)
+
+}
+
+// Maps a stripe event onto the subscription change it implies.
+func (aService *UserService) applyStripeEvent(e *getPaid.Event) (bool, error) {
+
+	switch e.Type {
+	case getPaid.SubUpdated:
+		s, err:= e.Sub()
+		if err!=nil {
+			return false, err
+		}
+
+		switch s.Status {
+		case getPaid.StatusActive, getPaid.StatusTrialing:
+			return userDB.SyncSub(aService.pool, e.ID,
+				s.Customer, s.ID, s.Plan.ID, e.CreatedAt())
+		case getPaid.StatusCanceled, getPaid.StatusUnpaid:
+			return userDB.EndSub(aService.pool, e.ID,
+				s.Customer, s.ID, e.CreatedAt())
+		}
+		// past_due leaves them be while stripe retries
+
+	case getPaid.SubDeleted:
+		s, err:= e.Sub()
+		if err!=nil {
+			return false, err
+		}
+
+		return userDB.EndSub(aService.pool, e.ID,
+			s.Customer, s.ID, e.CreatedAt())
+
+	case getPaid.InvoicePaymentFailed:
+		i, err:= e.Invoice()
+		if err!=nil {
+			return false, err
+		}
+
+		// Only once stripe has stopped retrying is the card
+		// considered declined for good.
+		if i.Subscription != "" && i.NextPaymentAttempt == nil {
+			return userDB.EndSub(aService.pool, e.ID,
+				i.Customer, i.Subscription, e.CreatedAt())
+		}
+
+	}
+
+	return false, nil
+
+}
@@ -8,6 +8,7 @@ import(
 	"github.com/emicklei/go-restful"
 
 	"net/http"
+	"time"
 
 )
 
@@ -16,6 +17,11 @@ type resetEmailContents struct{
 	Name, ResetCode string
 }
 
+// The contents of a verification email formatted to match the template.
+type verifyEmailContents struct{
+	Name, VerificationCode string
+}
+
 // Creates a user after validating the password. The remote database
 // should prevent duplicates
 func (aService *UserService) createUser(req *restful.Request,
@@ -48,11 +54,22 @@ func (aService *UserService) createUser(req *restful.Request,
 		return
 	}
 
+	// The user exists regardless of whether this succeeds, they
+	// can always request another verification email.
+	err = aService.sendVerification(userName, someUserData.Email,
+		mailer.DefaultLocale)
+	if err!=nil {
+		aService.logger.Println("failed to send verification", err)
+	}
+
 	resp.WriteEntity(sessionKey)
 
 }
 
 // Attempts to log the user in. Returns a valid session key
+//
+// Users with a second factor receive a LoginChallenge to complete
+// through loginSecondFactor instead.
 func (aService *UserService) loginUser(req *restful.Request,
 	resp *restful.Response) {
 	
@@ -66,16 +83,125 @@ func (aService *UserService) loginUser(req *restful.Request,
 
 	password:= passwordContainer.Password
 
-	sessionKey, err:= userDB.Login(aService.pool, userName, password)
+	// Check for throttling before spending any time on the password
+	ip:= getIP(req)
+	now:= time.Now()
+	throttle, err:= userDB.CheckLoginThrottle(aService.pool, userName, ip, now)
 	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return
+	}
+	if throttle.Locked(now) {
+		setRetryAfter(resp, throttle.LockedUntil.Sub(now))
+		resp.WriteErrorString(http.StatusTooManyRequests, LockedOut)
+		return
+	}
+	if throttle.CaptchaRequired {
+		valid, err:= aService.validator.Validate(passwordContainer.RecaptchaResponseField)
+		if err!=nil || !valid {
+			resp.WriteErrorString(http.StatusBadRequest, BadCaptcha)
+			return
+		}
+	}
+
+	sessionKey, challenge, err:= userDB.BeginLogin(aService.pool,
+		userName, password)
+	if err==userDB.ErrDisabled {
+		resp.WriteErrorString(http.StatusForbidden, AccountDisabled)
+		return
+	}
+	if err!=nil {
+		aService.loginFailed(userName, ip, now)
 		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
 		return
 	}
 
+	// Users with a second factor only get a session after providing it,
+	// their failures stand until they do.
+	if challenge != "" {
+		resp.WriteHeaderAndEntity(http.StatusAccepted, LoginChallenge{
+			Challenge: challenge,
+			SecondFactorRequired: true,
+		})
+		return
+	}
+
+	aService.loginSucceeded(userName)
+	aService.audit(req, userName, userDB.AuditLogin, nil)
+
 	resp.WriteEntity(sessionKey)
 
 }
 
+// Checks the login throttle ahead of checking a password or second
+// factor, writing a refusal and returning false when locked out.
+//
+// Anything verifying a credential goes through this, otherwise a
+// session alone would allow unlimited guesses.
+func (aService *UserService) loginAllowed(resp *restful.Response,
+	userName, ip string, at time.Time) bool {
+
+	throttle, err:= userDB.CheckLoginThrottle(aService.pool, userName, ip, at)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBfailure)
+		return false
+	}
+	if throttle.Locked(at) {
+		setRetryAfter(resp, throttle.LockedUntil.Sub(at))
+		resp.WriteErrorString(http.StatusTooManyRequests, LockedOut)
+		return false
+	}
+
+	return true
+
+}
+
+// Forgets failures against an account once a login fully succeeds.
+func (aService *UserService) loginSucceeded(userName string) {
+
+	err:= userDB.ClearLoginFailures(aService.pool, userName)
+	if err!=nil {
+		aService.logger.Println("Failed to clear login failures for",
+			userName, err)
+	}
+
+}
+
+// Audits a failed login and counts it towards the throttle.
+func (aService *UserService) loginFailed(userName, ip string,
+	at time.Time) {
+
+	aService.auditAs(userDB.ActorAnonymous, ip, userName,
+		userDB.AuditLoginFailed, nil)
+
+	aService.recordLoginFailure(userName, ip, at)
+
+}
+
+// Counts a failure towards the account and ip's throttle, noting any
+// lockout it causes.
+func (aService *UserService) recordLoginFailure(userName, ip string,
+	at time.Time) {
+
+	throttle, err:= userDB.RecordLoginFailure(aService.pool,
+		userName, ip, at)
+	if err!=nil {
+		aService.logger.Println("Failed to record login failure for",
+			userName, err)
+		return
+	}
+
+	if throttle.NewLockout {
+		aService.logger.Println("Login lockout for", userName, "from", ip,
+			"until", throttle.LockedUntil)
+		aService.auditAs(userDB.ActorAnonymous, ip, userName,
+			userDB.AuditLockout, map[string]string{
+				"until": throttle.LockedUntil.Format(time.RFC3339),
+			})
+	}
+
+}
+
 // Requests that a valid reset token be created, recorded, and sent to the user's email.
 //
 // Sends mail to the user via the service embedded mailer
@@ -98,12 +224,6 @@ func (aService *UserService) requestPasswordReset(req *restful.Request,
 		return
 	}
 
-	code, err:= userDB.RequestReset(aService.pool, userName) 
-	if err!=nil {
-		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
-		return
-	}
-
 	// Fetch the user so we know their email
 	u, err:= userDB.GetUser(aService.pool, userName)
 	if err!=nil {
@@ -111,14 +231,31 @@ func (aService *UserService) requestPasswordReset(req *restful.Request,
 		return
 	}
 
-	contents:= resetEmailContents{
-		Name: userName,
-		ResetCode: code,
+	// We only hand out resets to addresses we know the user controls
+	if !u.Verified {
+		resp.WriteErrorString(http.StatusForbidden, UnverifiedEmail)
+		return
 	}
+
+	// The email is queued alongside the reset it carries
 	targetAddress:= mailer.FormatAddress(userName, u.Email)
-	aService.mailer.SendPrepared("reset", contents,
-		targetAddress, "Password Reset - Preorda.in")
+	compose:= func(code string) (*userDB.Mail, error) {
+		contents:= resetEmailContents{
+			Name: userName,
+			ResetCode: code,
+		}
+		return aService.composeMail("reset", u.Locale, contents,
+			targetAddress, "Password Reset - Preorda.in")
+	}
+
+	_, err = userDB.RequestResetMail(aService.pool, userName, compose)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
 
+	aService.auditAs(userDB.ActorAnonymous, getIP(req), userName,
+		userDB.AuditResetRequested, nil)
 
 	resp.WriteEntity(true)
 
@@ -144,6 +281,8 @@ func (aService *UserService) resetPassword(req *restful.Request,
 		return
 	}
 
+	aService.audit(req, userName, userDB.AuditPasswordReset, nil)
+
 	resp.WriteEntity(true)
 
 }
@@ -175,4 +314,177 @@ func (aService *UserService) getUserEmail(req *restful.Request,
 
 	resp.WriteEntity(u.Email)
 
-}
\ No newline at end of file
+}
+
+// Generates a verification code for the address and queues it to be
+// sent there in the provided locale.
+func (aService *UserService) sendVerification(userName, email,
+	locale string) error {
+
+	targetAddress:= mailer.FormatAddress(userName, email)
+	compose:= func(code string) (*userDB.Mail, error) {
+		contents:= verifyEmailContents{
+			Name: userName,
+			VerificationCode: code,
+		}
+		return aService.composeMail("verify", locale, contents,
+			targetAddress, "Verify your email - Preorda.in")
+	}
+
+	_, err:= userDB.RequestVerificationMail(aService.pool,
+		userName, email, compose)
+
+	return err
+
+}
+
+// Confirms an address using the code that was sent to it.
+//
+// For an email change, this is where the new address takes effect.
+func (aService *UserService) verifyEmail(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+
+	var verifyContainer VerifyBody
+	err:= req.ReadEntity(&verifyContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	email, err:= userDB.ConfirmVerification(aService.pool,
+		userName, verifyContainer.VerificationToken)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadCredentials)
+		return
+	}
+
+	aService.audit(req, userName, userDB.AuditEmailVerified,
+		map[string]string{"email": email})
+
+	resp.WriteEntity(true)
+
+}
+
+// Sends another verification email to the user's current address.
+func (aService *UserService) resendVerification(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+	if u.Verified {
+		resp.WriteErrorString(http.StatusBadRequest, AlreadyVerified)
+		return
+	}
+
+	err = aService.sendVerification(userName, u.Email, u.Locale)
+	if err!=nil {
+		aService.logger.Println("failed to send verification", err)
+		resp.WriteErrorString(http.StatusInternalServerError, MailFailure)
+		return
+	}
+
+	resp.WriteEntity(true)
+
+}
+
+// Starts moving a user to a new email address.
+//
+// Their current address remains in effect until the new
+// one has been verified.
+func (aService *UserService) changeEmail(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	var changeContainer EmailChangeBody
+	err:= req.ReadEntity(&changeContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	// A stolen session alone shouldn't be able to take over the account,
+	// nor guess at the password any faster than logging in allows
+	ip:= getIP(req)
+	now:= time.Now()
+	if !aService.loginAllowed(resp, userName, ip, now) {
+		return
+	}
+	valid, err:= userDB.PasswordAuthUser(aService.pool,
+		userName, changeContainer.Password)
+	if err!=nil || !valid {
+		aService.recordLoginFailure(userName, ip, now)
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	u, err:= userDB.GetUser(aService.pool, userName)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, DBfailure)
+		return
+	}
+
+	err = aService.sendVerification(userName, changeContainer.Email,
+		u.Locale)
+	if err!=nil {
+		aService.logger.Println("failed to send verification", err)
+		resp.WriteErrorString(http.StatusInternalServerError, MailFailure)
+		return
+	}
+
+	aService.audit(req, userName, userDB.AuditEmailChangeRequested,
+		map[string]string{"email": changeContainer.Email})
+
+	resp.WriteEntity(true)
+
+}
+
+// Sets the language mail to the user is written in.
+//
+// Mail falls back to english for anything not yet translated.
+func (aService *UserService) setLocale(req *restful.Request,
+	resp *restful.Response) {
+
+	userName:= req.PathParameter("userName")
+	if getFilteredSessionKey(req) == nil {
+		resp.WriteErrorString(http.StatusUnauthorized, BadCredentials)
+		return
+	}
+
+	var localeContainer LocaleBody
+	err:= req.ReadEntity(&localeContainer)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BodyReadFailure)
+		return
+	}
+
+	locale, err:= mailer.ParseLocale(localeContainer.Locale)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusBadRequest, BadLocale)
+		return
+	}
+
+	err = userDB.SetLocale(aService.pool, userName, locale)
+	if err!=nil {
+		resp.WriteErrorString(http.StatusInternalServerError, DBWriteFailure)
+		return
+	}
+
+	resp.WriteEntity(locale)
+
+}
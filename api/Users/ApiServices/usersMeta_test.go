@@ -0,0 +1,373 @@
+package ApiServices
+
+import(
+
+	"./userDBHandler"
+	"./recaptcha"
+	"./totp"
+
+	"github.com/emicklei/go-restful"
+
+	"testing"
+
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// A user's locale picks which translation of their mail they get
+func TestSetLocale(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+
+	resp:= doRequest(t, "PATCH", "/" + name + "/Locale", sessionKey,
+		LocaleBody{Locale: "../en"})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatal("accepted a malformed locale", resp.Code)
+	}
+
+	resp = doRequest(t, "PATCH", "/" + name + "/Locale", nil,
+		LocaleBody{Locale: "es"})
+	if resp.Code == http.StatusOK {
+		t.Fatal("set locale without a session")
+	}
+
+	resp = doRequest(t, "PATCH", "/" + name + "/Locale", sessionKey,
+		LocaleBody{Locale: "es_MX"})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to set locale", resp.Code, resp.Body.String())
+	}
+
+	u, err:= userDB.GetUser(testService.pool, name)
+	if err!=nil {
+		t.Fatal("failed to get user", err)
+	}
+	if u.Locale != "es-mx" {
+		t.Fatal("locale was not normalized and stored", u.Locale)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Sub", sessionKey, SubBody{
+		Plan: "Preordain",
+		PaymentMethod: "tok_visa",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to subscribe", resp.Code, resp.Body.String())
+	}
+
+	sent:= sentTo(t, name)
+	if len(sent) != 1 ||
+		!strings.Contains(sent[0].Body, "gracias por suscribirte") ||
+		!strings.Contains(sent[0].HTML, "gracias por suscribirte") {
+		t.Fatal("subscription email was not localized", sent)
+	}
+
+}
+
+// Pulls the code templates place on the third line of a message
+func codeFrom(t *testing.T, body string) string {
+	lines:= strings.Split(body, "\n")
+	if len(lines) < 3 || lines[2] == "" {
+		t.Fatal("message carried no code", body)
+	}
+
+	return strings.TrimSpace(lines[2])
+}
+
+// Sign up, verify the address, then reset the password via email.
+func TestSignupAndReset(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	resp:= doRequest(t, "POST", "/" + name, nil, NewUserData{
+		Email: name + "@example.invalid",
+		Password: randName(),
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to sign up", resp.Code, resp.Body.String())
+	}
+
+	sent:= sentTo(t, name)
+	if len(sent) != 1 {
+		t.Fatal("verification email was not sent", sent)
+	}
+	verifyCode:= codeFrom(t, sent[0].Body)
+
+	// Resets only go to verified addresses
+	resp = doRequest(t, "POST", "/" + name + "/PasswordResetRequest", nil,
+		PasswordResetRequestBody{})
+	if resp.Code != http.StatusForbidden {
+		t.Fatal("sent a reset to an unverified address", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Verify", nil,
+		VerifyBody{VerificationToken: verifyCode})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to verify", resp.Code, resp.Body.String())
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/PasswordResetRequest", nil,
+		PasswordResetRequestBody{})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to request reset", resp.Code, resp.Body.String())
+	}
+
+	sent = sentTo(t, name)
+	if len(sent) != 2 || sent[1].Subject != "Password Reset - Preorda.in" {
+		t.Fatal("reset email was not sent", sent)
+	}
+	resetCode:= codeFrom(t, sent[1].Body)
+
+	password:= randName()
+	resp = doRequest(t, "POST", "/" + name + "/PasswordReset", nil,
+		PasswordResetBody{Password: password, ResetRequestToken: resetCode})
+	if resp.Code != http.StatusOK {
+		t.Fatal("failed to reset", resp.Code, resp.Body.String())
+	}
+
+	valid, err:= userDB.PasswordAuthUser(testService.pool, name, password)
+	if err!=nil || !valid {
+		t.Fatal("reset password does not work", err)
+	}
+
+}
+
+// Failing the captcha stops signup and reset requests before they
+// touch the database.
+func TestCaptchaRequired(t *testing.T) {
+	t.Parallel()
+
+	failing:= &UserService{
+		pool: testService.pool,
+		logger: testService.logger,
+		mailer: testService.mailer,
+		validator: recaptcha.Stub{Pass: false},
+		merch: testMerch,
+	}
+	err:= failing.register()
+	if err!=nil {
+		t.Fatal("failed to register", err)
+	}
+	container:= restful.NewContainer()
+	container.Add(failing.Service)
+
+	name:= randName()
+	resp:= doRequestOn(t, container, "POST", "/" + name, nil, NewUserData{
+		Email: name + "@example.invalid",
+		Password: randName(),
+	})
+	if resp.Code != http.StatusBadRequest ||
+		!strings.Contains(resp.Body.String(), BadCaptcha) {
+		t.Fatal("signed up without a captcha", resp.Code)
+	}
+
+	_, err = userDB.GetUser(testService.pool, name)
+	if err==nil {
+		t.Fatal("user created despite failed captcha")
+	}
+
+	resp = doRequestOn(t, container, "POST",
+		"/" + name + "/PasswordResetRequest", nil,
+		PasswordResetRequestBody{})
+	if resp.Code != http.StatusBadRequest ||
+		!strings.Contains(resp.Body.String(), BadCaptcha) {
+		t.Fatal("requested reset without a captcha", resp.Code)
+	}
+
+}
+
+// Gives a user a second factor, returning its secret
+func enableTestTwoFactor(t *testing.T, name string) string {
+	secret, err:= userDB.EnrollTwoFactor(testService.pool, name)
+	if err!=nil {
+		t.Fatal("failed to enroll", err)
+	}
+	code, err:= totp.Code(secret, time.Now())
+	if err!=nil {
+		t.Fatal(err)
+	}
+	_, err = userDB.EnableTwoFactor(testService.pool, name, code, time.Now())
+	if err!=nil {
+		t.Fatal("failed to enable second factor", err)
+	}
+
+	return secret
+}
+
+// Wrong second factor codes count towards the login throttle so the
+// password alone doesn't allow unlimited guesses.
+func TestSecondFactorThrottled(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	_, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	enableTestTwoFactor(t, name)
+
+	for i:= int32(0); i < userDB.NameLockoutThreshold; i++ {
+		resp:= doRequest(t, "POST", "/" + name + "/Login", nil,
+			PasswordBody{Password: password})
+		if resp.Code != http.StatusAccepted {
+			t.Fatal("failed to begin login", i, resp.Code, resp.Body.String())
+		}
+
+		var challenge LoginChallenge
+		err = json.Unmarshal(resp.Body.Bytes(), &challenge)
+		if err!=nil {
+			t.Fatal("failed to read challenge", err)
+		}
+
+		resp = doRequest(t, "POST", "/" + name + "/Login/SecondFactor", nil,
+			SecondFactorBody{Challenge: challenge.Challenge, Code: "notacode"})
+		if resp.Code != http.StatusBadRequest {
+			t.Fatal("accepted a bad second factor", resp.Code)
+		}
+	}
+
+	// A correct password no longer excuses the failures
+	resp:= doRequest(t, "POST", "/" + name + "/Login", nil,
+		PasswordBody{Password: password})
+	if resp.Code != http.StatusTooManyRequests {
+		t.Fatal("second factor guesses were not throttled", resp.Code)
+	}
+
+	resp = doRequest(t, "POST", "/" + name + "/Login/SecondFactor", nil,
+		SecondFactorBody{Challenge: "anything", Code: "notacode"})
+	if resp.Code != http.StatusTooManyRequests {
+		t.Fatal("second factor was checked while locked out", resp.Code)
+	}
+
+}
+
+// A stolen session mustn't allow unlimited guesses at the code which
+// removes a second factor.
+func TestDisableTwoFactorThrottled(t *testing.T) {
+	t.Parallel()
+
+	name, sessionKey:= addTestUser(t)
+	secret:= enableTestTwoFactor(t, name)
+
+	for i:= int32(0); i < userDB.NameLockoutThreshold; i++ {
+		resp:= doRequest(t, "DELETE", "/" + name + "/TwoFactor", sessionKey,
+			TwoFactorCodeBody{Code: "notacode"})
+		if resp.Code != http.StatusBadRequest {
+			t.Fatal("accepted a bad second factor", i, resp.Code)
+		}
+	}
+
+	code, err:= totp.Code(secret, time.Now())
+	if err!=nil {
+		t.Fatal(err)
+	}
+	resp:= doRequest(t, "DELETE", "/" + name + "/TwoFactor", sessionKey,
+		TwoFactorCodeBody{Code: code})
+	if resp.Code != http.StatusTooManyRequests ||
+		resp.Header().Get("Retry-After") == "" {
+		t.Fatal("second factor guesses were not throttled", resp.Code)
+	}
+
+	enabled, err:= userDB.TwoFactorEnabled(testService.pool, name)
+	if err!=nil || !enabled {
+		t.Fatal("second factor removed while locked out", err, enabled)
+	}
+
+}
+
+// Changing email checks the password so it shares the login throttle,
+// otherwise a session would allow unlimited guesses at it.
+func TestChangeEmailThrottled(t *testing.T) {
+	t.Parallel()
+
+	name:= randName()
+	password:= randName()
+	sessionKey, err:= userDB.AddUser(testService.pool, name,
+		name + "@example.invalid", password)
+	if err!=nil {
+		t.Fatal("failed to add user", err)
+	}
+
+	for i:= int32(0); i < userDB.NameLockoutThreshold; i++ {
+		resp:= doRequest(t, "PATCH", "/" + name + "/Email", sessionKey,
+			EmailChangeBody{Email: "new@example.invalid", Password: "wrong"})
+		if resp.Code != http.StatusUnauthorized {
+			t.Fatal("accepted a wrong password", i, resp.Code)
+		}
+	}
+
+	resp:= doRequest(t, "PATCH", "/" + name + "/Email", sessionKey,
+		EmailChangeBody{Email: "new@example.invalid", Password: password})
+	if resp.Code != http.StatusTooManyRequests {
+		t.Fatal("password guesses were not throttled", resp.Code)
+	}
+
+}
+
+// Throttles are keyed on the client's address so IPv6 clients must not
+// share one by having their address cut at its first colon.
+func TestGetIP(t *testing.T) {
+
+	cases:= map[string]string{
+		"192.0.2.1:1234": "192.0.2.1",
+		"[2001:db8::1]:1234": "2001:db8::1",
+		"[2001:db8::2]:1234": "2001:db8::2",
+		"[::1]:80": "::1",
+	}
+
+	for remote, want:= range cases{
+		raw, err:= http.NewRequest("POST", "/api/Users/foo/Login", nil)
+		if err!=nil {
+			t.Fatal(err)
+		}
+		raw.RemoteAddr = remote
+
+		got:= getIP(restful.NewRequest(raw))
+		if got != want {
+			t.Fatal("wrong address", remote, got, want)
+		}
+	}
+
+}
+
+// Body keys are only looked for in small bodies and reading them never
+// costs the handler any of the body
+func TestGetBodySessionKey(t *testing.T) {
+
+	small:= `{"SessionKey":"a2V5"}`
+	large:= `{"SessionKey":"a2V5","Pad":"` +
+		strings.Repeat("x", int(maxSessionBodySize)) + `"}`
+
+	cases:= map[string]string{
+		small: "key",
+		large: "",
+	}
+
+	for body, want:= range cases{
+		raw, err:= http.NewRequest("POST", "/api/Users/foo/Logout",
+			strings.NewReader(body))
+		if err!=nil {
+			t.Fatal(err)
+		}
+		req:= restful.NewRequest(raw)
+
+		got:= getBodySessionKey(req)
+		if string(got) != want {
+			t.Fatal("wrong session key", len(body), string(got), want)
+		}
+
+		left, err:= ioutil.ReadAll(req.Request.Body)
+		if err!=nil {
+			t.Fatal(err)
+		}
+		if string(left) != body {
+			t.Fatal("body not left intact", len(body), len(left))
+		}
+	}
+
+}
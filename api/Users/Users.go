@@ -15,6 +15,7 @@ func main() {
 	userService:= ApiServices.NewUserService()
 
 	restful.Add(userService.Service)
+	restful.Add(userService.Admin)
 
 	// Add container filter to enable CORS
 	/*
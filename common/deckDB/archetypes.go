@@ -87,4 +87,40 @@ func GetArchetypeLatest(pool *pgx.ConnPool,
 		Happened: deckData.Timestamp(t),
 		Deck: &d}, nil
 
+}
+
+// Given an archetype name standardized by nameNorm, returns how well
+// it finished at major events happening from 'from' until 'to'.
+//
+// Decks without a known finish count towards Decks alone.
+func GetArchetypePerformance(pool *pgx.ConnPool, name string,
+	from, to time.Time) (*deckData.ArchetypePerformance, error) {
+
+	// Translate our clean names into mtgtop8 names
+	// with associated metadata
+	archetypes, presentCards, excludedCards, err:= nameNorm.Invert(name)
+	if err!=nil {
+		return nil, err
+	}
+
+	p:= deckData.ArchetypePerformance{
+		Archetype: name,
+		From: deckData.Timestamp(from),
+		To: deckData.Timestamp(to),
+	}
+
+	err = pool.QueryRow(archetypePerformance, archetypes,
+		excludedCards, presentCards, from, to).Scan(
+			&p.Decks, &p.Ranked,
+			&p.Top8, &p.Wins, &p.AverageFinish)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Ranked > 0 {
+		p.Top8Conversion = float64(p.Top8) / float64(p.Ranked)
+	}
+
+	return &p, nil
+
 }
\ No newline at end of file
@@ -3,12 +3,22 @@
 // sql/addCard.sql
 // sql/addDeck.sql
 // sql/addEvent.sql
+// sql/cardArchetypeStats.sql
 // sql/cardArchetypes.sql
+// sql/consensusArchetype.sql
 // sql/contentsArchetype.sql
 // sql/contentsDeck.sql
+// sql/eventCards.sql
+// sql/eventDecks.sql
 // sql/existsEvent.sql
 // sql/latestArchetype.sql
+// sql/listEvents.sql
 // sql/metaDeck.sql
+// sql/metaEvent.sql
+// sql/metagameShare.sql
+// sql/performanceArchetype.sql
+// sql/searchCards.sql
+// sql/searchDecks.sql
 // DO NOT EDIT!
 
 package deckDB
@@ -96,7 +106,7 @@ func sqlAddcardSql() (*asset, error) {
 	return a, nil
 }
 
-var _sqlAdddeckSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x8d\xbd\xae\xc2\x30\x0c\x85\xf7\x48\x79\x87\x33\x74\xb8\xad\xaa\x5b\xf1\x33\xb0\x32\x30\x74\x29\x12\xf4\x05\xac\xc6\x40\x45\x9a\x54\x89\xa1\xea\xdb\x93\x30\x32\x7c\x96\x2c\x7f\x3e\xa7\xa9\xb4\xd2\xea\x68\x4c\x04\xc1\xf1\x02\xc3\xc3\x13\xcb\x28\x0f\x90\xb5\xf0\x37\x8c\x12\xd3\x61\xe0\x18\x29\xac\x98\x58\xc8\x90\x10\xc4\x6b\x35\xc9\x5d\xfc\x7c\xf8\xcf\x3f\x31\xe7\x54\x4d\x9e\x6d\x77\x3d\x5d\x7a\xb4\x5d\x7f\xc6\x8f\xf2\xe7\x68\xe2\x1a\xb3\xa5\x95\x43\xfd\x2d\x1b\x4d\xda\x29\xb0\x93\x52\xab\x37\xd9\x17\x67\xaf\xd8\xd4\x28\xb6\x89\x5d\x62\x5f\x7e\x02\x00\x00\xff\xff\x0e\x08\x8f\x4b\xa7\x00\x00\x00")
+var _sqlAdddeckSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8e\xcd\x6a\xc3\x30\x10\x84\xef\x06\xbf\xc3\x1c\x7c\x48\x82\x69\xe8\x6f\x7a\xed\xa1\xd0\x80\x71\x43\x9b\x3e\xc0\x62\x6d\x2d\x61\x59\x0a\xd2\x3a\x22\x6f\x1f\x29\xbd\xf5\xf0\x2d\xb3\x2c\x33\x3b\xdb\x4d\x5d\xd5\xd5\x9b\x52\x11\x04\xc7\x09\x8a\x87\x09\xc9\x88\x06\x59\x0b\xff\x0b\x23\x31\x1f\x06\x8e\x91\xc2\x05\x33\x0b\x29\x12\x82\xf8\xba\x9a\x65\x14\x7f\x7a\xbd\x2b\x9e\x58\x72\x0e\x96\x06\x9e\xd9\x49\x8b\xe6\x19\xa2\x83\x5f\x46\x8d\x66\xd7\xc2\x44\xf4\x3f\x5d\x87\xa4\xd9\x61\x71\x93\xf3\xc9\x15\xc7\x66\x5b\xe6\xbe\xff\x7e\xff\x3a\x62\xdf\x1f\x3f\xf1\x2f\x74\xe5\x68\xe6\x16\x27\x4b\x17\x0e\xed\xad\x9e\x51\x79\xa7\x70\x7b\x13\xc8\x4d\x9d\x4f\x7f\xe2\xc3\x8c\x3a\x2b\x1e\x7c\x50\xeb\xba\x3a\x93\x5d\xb8\x44\x34\xf7\xb9\xcf\x43\xe6\x31\xf3\x54\xba\x65\x5e\x32\xbb\xf5\x15\x51\xf4\xae\x19\x00\x01\x00\x00")
 
 func sqlAdddeckSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +121,7 @@ func sqlAdddeckSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sql/addDeck.sql", size: 167, mode: os.FileMode(438), modTime: time.Unix(1451357693, 0)}
+	info := bindataFileInfo{name: "sql/addDeck.sql", size: 256, mode: os.FileMode(438), modTime: time.Unix(1792417157, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -136,6 +146,26 @@ func sqlAddeventSql() (*asset, error) {
 	return a, nil
 }
 
+var _sqlCardarchetypestatsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x85\x92\x4d\x6e\xdc\x30\x0c\x85\xd7\x23\x40\x77\xe0\x22\x0b\x7b\x60\x4c\x26\xbb\x22\x41\x7b\x88\xa2\xfb\x82\x23\xd3\x63\x25\xb6\xe4\x4a\x72\x06\xee\xe9\x4b\xca\x7f\x99\xa0\x40\x36\x82\x2d\x3d\x3e\xf2\x7b\xd2\xe3\x51\x2b\xad\x7e\x52\x1a\x83\x8b\x15\x34\x3e\x00\xa1\x69\xa1\x4f\xd7\xe4\x87\x6f\x80\xc1\xb4\x94\xa6\x81\x00\xc1\x60\xa8\xa1\xc5\x08\x38\x0c\x84\x81\x6a\xb0\x0e\x30\x41\x8f\xaf\x3e\x68\x45\xef\xe4\x12\x7b\xb4\xfe\xc6\x5b\x6e\x82\x9a\xcc\x5b\x04\xdf\x40\x6a\x59\xb5\x3b\xa5\x96\x02\xc1\x8d\x97\x0f\xe2\x2c\xa3\x5e\xab\xa1\xc3\x89\xad\xf9\x27\x37\xac\x00\x5d\xbd\xcb\x8c\x1f\x2c\x45\x39\x9d\x60\x51\xf2\x10\x22\xee\xd1\x3a\xe9\x28\x7a\xad\xa2\xad\xe9\xe2\xb9\xfe\x24\x7c\xbf\xf0\x8d\xa2\x56\x87\x87\xa7\x2c\x6d\xc6\xae\xab\xc0\xf9\xd0\x63\x67\xff\xb2\x85\xc3\x9e\x96\x09\x72\x53\xa9\x39\x3e\xca\x7a\xb3\xa9\x9d\x01\x19\x3b\x42\xc1\x26\x91\x3a\x32\x09\x8e\xd0\x04\xdf\xaf\x41\x9d\xb2\xe6\xf7\x9c\x41\x51\x6a\x55\x56\x33\x8a\x75\xd7\x4f\x85\x03\x47\xe7\x12\x1f\x1f\x0e\x71\xec\x0b\x83\x91\xc3\x68\xc9\xc1\x36\xb3\xcc\xe1\xe0\x0c\xd4\xf1\xd1\x9f\x11\x5d\xb2\x69\x02\x72\x75\x29\x56\x2b\xe8\xd7\x0e\x7b\xa9\x18\x9d\x37\x87\x4d\xc6\x0e\x77\x10\xc2\x2e\x39\xdd\xe4\x86\xc4\x3e\x07\xf3\x1d\x1e\x9e\xf8\xe7\x1a\xfc\x38\xc0\x65\x5a\x00\x18\x51\x02\x5a\xa0\xf2\x5d\x9f\x44\x5e\xf1\x1d\x8d\x2e\x15\xc7\xf2\xf9\xf9\x62\xaf\x96\x51\x97\x9d\x25\x8e\xd3\x5c\xbf\x1f\xb3\xb7\xf1\xd8\x51\x34\x54\x08\xcf\xaa\x5b\x41\xcb\x0a\xce\x5f\xab\x37\xa8\x3b\xb9\x56\x77\x80\xf3\x93\xcc\x2b\xfb\x74\xd4\x24\x78\xf5\xfc\x80\xd6\x9b\xf2\xdb\xe7\x32\x25\xc3\xcf\x68\xb2\xda\xfa\x63\x36\xf3\xfe\x22\x63\x8f\xe2\xd3\xc3\xc8\x8f\x86\x33\xda\x72\xdb\x33\xd2\xaa\xc5\x77\xe9\xf7\xdf\x60\xe0\x07\x9c\x5f\xfe\x01\x33\xcc\x3b\xa2\x99\x03\x00\x00")
+
+func sqlCardarchetypestatsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlCardarchetypestatsSql,
+		"sql/cardArchetypeStats.sql",
+	)
+}
+
+func sqlCardarchetypestatsSql() (*asset, error) {
+	bytes, err := sqlCardarchetypestatsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/cardArchetypeStats.sql", size: 921, mode: os.FileMode(438), modTime: time.Unix(1792416872, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _sqlCardarchetypesSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x8f\x4d\x4e\xc4\x30\x0c\x85\xd7\x8d\x94\x3b\xbc\x05\x8b\x4e\x85\x66\xc4\x0e\x09\x71\x09\x2e\x80\x4c\xe2\x99\x16\xda\xb4\x72\x52\xaa\xb9\x3d\x4e\x1b\x21\x05\x36\xee\x8f\x3e\x7f\x7e\xef\xd2\x59\x63\xcd\x1b\xa7\x55\x42\x04\x8d\x23\x3c\xbb\x2f\x90\xb8\x9e\xd3\x7d\x61\xfd\x07\x47\xe2\xb1\x51\xc4\x10\xdc\xb8\x7a\xf6\xfa\x62\xcd\x75\x16\x4c\xf4\xa9\x93\xbf\x39\xa4\x78\xce\xa2\xee\x92\xe7\x36\xa4\x7e\xf7\x0c\x5e\xf7\x23\x5a\x6b\x9a\xc8\x23\xbb\x84\x85\x44\x61\x5c\x65\x9e\x30\xa5\x5b\x9a\x97\xe7\x73\xf6\x47\x45\xb6\x9e\x85\xf5\xd9\x04\x9a\x18\xaf\x78\x78\xd2\x8f\x9b\xcc\xeb\x82\x8f\x7b\xd9\xb4\xe6\xf4\x68\xcd\x7e\xf7\xaf\xba\xab\xad\x3b\xf3\x7e\x64\x6b\x4f\xba\x97\x93\x15\x74\x3f\x50\xd1\x39\x6d\x95\xe1\x88\xaf\x4d\xd1\xd6\xfe\xd2\x4b\x75\x0d\x05\x8f\xcc\x96\x52\xff\xd9\x23\xa7\xa2\xbf\x2d\xf2\xe5\x97\x9f\x00\x00\x00\xff\xff\x18\x7e\xcd\x6e\x75\x01\x00\x00")
 
 func sqlCardarchetypesSqlBytes() ([]byte, error) {
@@ -156,6 +186,26 @@ func sqlCardarchetypesSql() (*asset, error) {
 	return a, nil
 }
 
+var _sqlConsensusarchetypeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x52\xcb\x6e\xe3\x30\x0c\x3c\xc7\x80\xff\x81\x87\x00\x6d\x83\x20\x45\x5f\xc0\x22\xdb\xf6\xb4\x5f\xb0\xd8\xfb\x82\xb1\xe8\x98\xad\x2d\x39\x12\xdd\xd4\xfb\xf5\x4b\xc9\x4a\x9a\xe4\xd6\x83\x6d\x52\xe4\x0c\x67\x68\xdd\x2e\xca\xa2\x2c\x7e\x93\x0c\xde\x06\xa0\x0f\xf2\x23\x54\xe8\x0d\xb0\xcd\x99\xa1\xea\x1d\x5c\x0d\x68\x01\x7d\xd5\x90\x8c\x3d\x41\xdf\x62\xc5\x76\x0b\x28\xd0\xe1\x9b\xf3\x65\xa1\xcd\x56\x02\xec\x1b\xae\x1a\x68\xb0\xef\xc9\x92\x81\x3d\x4b\xa3\x4c\xa8\x81\x35\x6e\xbf\x8a\xc3\xfe\xe0\x3b\x85\xb2\x98\xcd\xef\x94\xd0\xe3\x18\xc9\xad\xf3\x1d\xb6\xfc\x4f\x21\x9d\x6c\xc5\xf5\x3f\x4e\x86\x59\xec\x26\xc0\xfd\x17\x20\x69\x4c\x05\x10\x07\xf4\xd9\xb7\x5c\xb1\xb4\x23\x78\xda\x0d\xec\x15\xe4\x04\x36\x2a\xd4\x53\x50\x61\xd1\x4e\x34\x92\x68\x1e\xbe\x41\x73\xc0\x2d\x81\x6b\xb8\xfa\x45\x35\x0e\xad\x5c\x29\x0b\x00\x70\x38\xa1\x0f\x6c\x08\xa4\xa1\xcc\xad\x91\x4d\x69\xcd\xad\x90\x8f\xbd\xbc\x55\x97\x64\x56\x51\xc2\x63\xaa\x05\x41\x2f\x51\x46\x4c\xa6\x0d\xe9\x1c\x5b\xb5\x43\xe0\x0f\x8a\x7d\x4f\xa9\x44\xd6\x5c\x76\xd1\xe7\xb1\xab\x2c\x16\xb7\xf1\x1d\xa8\xa5\x4a\x92\xa5\xb0\xea\xd1\xab\xae\x65\xce\xa2\xc3\x43\xbc\x1b\xd0\x0a\xcb\xb8\x5e\x6f\x78\xcb\x5f\x3d\xd1\xc0\xc6\x69\x58\x16\xb5\x77\xdd\xe1\x3f\xac\x52\x75\xea\x51\x45\x6f\x4e\x37\x72\x28\xa5\xcd\x4c\xfb\x01\x97\x17\x95\x0e\xd9\xc0\xcb\x99\x90\x4b\x64\xbe\x2d\xf9\xe3\x6c\x8e\xa6\xf3\x84\x9e\xc8\x8e\xe8\x7d\x43\x3e\x6e\x64\x76\xca\xaa\xbb\x8a\x47\xb3\xeb\x6c\x7d\x01\x67\xca\x8f\x37\xe8\x6f\x22\xbb\x9e\xdf\x2d\x61\x7e\xaf\xcf\xc3\xcd\x8d\xe2\xd0\x9a\x88\xce\x93\x8f\x57\xf6\xf5\x05\xf4\xf7\x68\x11\x2e\x2b\xcf\x30\x7f\xfa\xf9\x1f\xca\x6d\xed\x34\x31\x03\x00\x00")
+
+func sqlConsensusarchetypeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlConsensusarchetypeSql,
+		"sql/consensusArchetype.sql",
+	)
+}
+
+func sqlConsensusarchetypeSql() (*asset, error) {
+	bytes, err := sqlConsensusarchetypeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/consensusArchetype.sql", size: 817, mode: os.FileMode(438), modTime: time.Unix(1792417220, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _sqlContentsarchetypeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x91\xcd\xee\xda\x30\x10\xc4\xcf\x44\xca\x3b\xec\x01\x89\x0f\x45\x20\xe0\x52\xa9\xd7\x3e\x41\xc5\xbd\x5a\xec\x4d\xe2\xe2\xd8\xc6\x5e\x43\xd3\xa7\xef\xc6\x81\x22\x8e\xff\x43\x94\xf8\x63\x7e\x33\x3b\xd9\x6f\xeb\xaa\xae\x7e\x12\xe7\xe8\x12\xa0\xb5\xa0\x30\xea\x04\xdc\x23\x43\x8f\x77\x02\x0c\x81\x30\x92\x06\xe3\x00\xa1\x33\x77\x92\x77\x54\x3d\xf1\x18\xa8\xae\x30\x81\xf5\xae\x03\x7c\x6a\x26\x39\x3c\x64\x55\xae\x0f\xf8\xdb\x47\x20\xd1\xf0\x0e\xce\xbd\x99\x2c\x92\x87\x38\xfb\xd5\x55\xef\x1f\x72\xc7\x8d\xc0\x66\xa0\x04\x84\xaa\x9f\x09\x2f\xd7\x46\x20\x39\x51\x9b\xad\x00\xb5\x51\xc8\xc2\xf3\xed\xb4\xb7\x9b\x92\xd7\xd5\x19\xaf\x24\xa4\xc5\xf2\x20\xb1\x22\x8e\xd3\xa9\xf3\x71\x40\x6b\xfe\x4a\xea\x81\x3b\xf6\xe1\xdb\x3b\x32\x38\x1c\x66\xc1\xf1\x2d\x28\x9e\xe5\x00\xd8\x03\xfd\x09\xd6\x28\xc3\x76\x94\xa4\xb7\x6c\xa2\x88\x3c\xc3\x85\x20\x44\x4a\x32\xcb\x34\x9c\x26\x75\x2d\x98\xd3\x17\x30\x2f\x5d\x03\xa6\x85\xd5\x0f\x6a\x31\x5b\x5e\x09\x05\x00\xa4\x9c\x37\x3e\x19\x4d\x52\x28\x3d\xd9\xf2\xe5\xca\xb2\x35\x96\x29\x4e\x77\x4d\x27\x53\x92\x96\x16\xb6\xfb\xb9\x89\x44\x96\x14\x17\xff\x06\x52\x1e\xd6\xb7\x8c\x8e\x0d\x8f\x1b\x68\xa3\x1f\x5e\x55\xec\xca\x1f\x16\xcf\x47\x4f\x12\x29\x48\xcd\xc5\x52\x76\x16\xeb\x27\x63\xfb\xa9\xf8\x5f\xde\xaf\x92\x7e\xbd\x3c\x34\xb0\x3c\xca\x73\xda\x6c\x44\xd6\x45\x9f\x03\x5c\xc6\x62\x0d\x3e\x6a\x49\x28\xab\xcf\x08\x9a\x92\xfa\xfe\x2f\x00\x00\xff\xff\x48\x06\xbe\x5d\x6f\x02\x00\x00")
 
 func sqlContentsarchetypeSqlBytes() ([]byte, error) {
@@ -196,6 +246,46 @@ func sqlContentsdeckSql() (*asset, error) {
 	return a, nil
 }
 
+var _sqlEventcardsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\x41\x0e\x82\x30\x10\x45\xd7\x34\xe9\x1d\x66\xe1\x8a\x18\x88\x3b\x83\xf1\x12\xde\xa0\xb4\x03\x54\xa1\xc5\x76\xd0\x70\x7b\xa7\x54\x62\xdc\xcc\xfc\xfe\xf7\xa7\xf9\x75\x29\x85\x14\x37\xa4\x25\xb8\x08\x34\x20\x68\xef\x08\x1d\x45\xf0\x1d\xe0\x0b\xc3\x0a\x06\xf5\x83\x91\x22\x98\x47\xa5\xd1\x80\x75\xa0\x5c\x82\x8e\xa0\xb7\xbc\xc0\x52\x94\x62\x33\xac\x49\x1f\x96\x75\x9a\x11\x47\xd4\x04\x5a\x05\x13\xab\x59\x05\xc6\xc7\xef\xcb\xa9\x09\x77\xfd\x5c\x14\xdf\xd1\xda\x34\xad\xed\xed\x2f\x13\xad\xc1\xd6\xb3\x94\xa2\x0b\x7e\x82\x89\x7a\xf2\xf3\xb9\xda\x68\xce\x48\x51\xdc\x3d\xd7\xd9\x51\xaa\x1a\x21\x4f\xef\xb2\xd8\x4c\x6b\xe0\xfa\x57\x84\x2f\xdf\x03\x06\xe4\x5d\xe4\x58\xf6\x39\x76\x38\x5d\x3e\xae\xc1\x06\xf7\x17\x01\x00\x00")
+
+func sqlEventcardsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlEventcardsSql,
+		"sql/eventCards.sql",
+	)
+}
+
+func sqlEventcardsSql() (*asset, error) {
+	bytes, err := sqlEventcardsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/eventCards.sql", size: 279, mode: os.FileMode(438), modTime: time.Unix(1792416963, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlEventdecksSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\xb1\x6e\xc3\x30\x0c\x44\xe7\x0a\xd0\x3f\xdc\x50\x20\x4d\x20\x34\xed\x56\xa4\xe8\xde\x21\x53\xfe\x80\x96\x69\x5b\x88\x2c\x19\x22\xd3\xc0\x7f\x5f\x29\xed\xe2\x85\x24\x78\xe4\xbd\x3b\x1e\xac\xb1\xe6\xc2\x7a\x2b\x49\xa0\x13\x63\x66\xa5\x9e\x94\x90\x07\xf0\x0f\x97\x15\x3d\xfb\x6b\x95\x48\xb1\x44\xf2\xdc\x23\x24\x50\x6a\x62\x52\x8c\xa1\x36\x04\x15\x6b\x1e\x8b\xd0\x3b\x74\x2c\x8a\x21\xa4\x20\x13\x4b\x1d\x8a\x68\xa3\x1c\x8e\xad\x0a\x47\xf6\xfa\x30\x6d\xb7\xd5\x72\xe5\xe2\x90\x68\x66\x67\xcd\x93\xcf\x14\x59\x3c\xbf\x14\x4a\xd7\x73\xbe\x3b\xbc\xed\x4f\xa7\x2e\x8c\x21\xa9\xc3\x46\xfd\x0e\xe3\xb4\x91\x37\xef\xec\x73\xa9\xfe\xbb\xdd\xde\x9a\xa1\xe4\x19\xb3\x8e\x9a\x97\x8f\xd7\x46\x16\xdc\x27\x2e\x8c\x85\x4a\xcd\xfc\xf5\xfc\x6e\x4d\xbd\xe6\x82\x6e\xc5\x3f\x18\xe9\x16\xa3\x20\x92\x54\xee\x5f\xda\xcf\x5f\xc5\xe3\x6c\xdc\x2e\x01\x00\x00")
+
+func sqlEventdecksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlEventdecksSql,
+		"sql/eventDecks.sql",
+	)
+}
+
+func sqlEventdecksSql() (*asset, error) {
+	bytes, err := sqlEventdecksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/eventDecks.sql", size: 302, mode: os.FileMode(438), modTime: time.Unix(1792418973, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _sqlExistseventSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x34\xcc\x31\xce\xc2\x30\x14\x03\xe0\xbd\x52\xef\xe0\xa1\xc3\xff\x77\x68\xe9\x86\x84\xe0\x10\x88\x0b\x44\xa9\x43\x23\xc8\x4b\x94\xf7\x0a\xd7\x47\x2d\x62\xf1\xf2\xd9\x1e\xfb\xb6\x69\x9b\x2b\x6d\xad\xa2\xb0\x85\x28\x95\x4a\xf1\x44\x0e\x70\xd0\x42\x1f\x43\xe4\x0c\xbe\x28\x36\x6c\xed\x9b\x7b\x50\xd1\x4d\x70\x0a\x27\x5f\x88\xf3\x4e\xfd\xb8\xa5\xf2\x49\x6f\xf0\x79\x15\xfb\x13\x97\xf8\x8f\x0b\x0e\x08\x35\x27\x24\xbb\x5b\x2e\xc7\x61\x5f\x29\xde\x0b\x2b\x7f\x17\xe7\x6e\x3a\x7d\x02\x00\x00\xff\xff\xe6\xb5\xf0\xf2\x91\x00\x00\x00")
 
 func sqlExistseventSqlBytes() ([]byte, error) {
@@ -236,6 +326,26 @@ func sqlLatestarchetypeSql() (*asset, error) {
 	return a, nil
 }
 
+var _sqlListeventsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x85\x91\xcb\x6e\xc2\x30\x10\x45\xd7\x44\xca\x3f\xcc\x02\xa9\x21\x42\x20\xa0\x48\x15\x7d\xec\xba\x40\x8d\xba\xa8\xda\x75\x65\x92\x09\x71\x89\xed\xc8\x9e\x40\xf9\xfb\x8e\x9d\x88\x92\x45\xd5\x8d\x1f\xf2\x3d\x77\x7c\x67\xe6\x69\x1c\xc5\xd1\x1b\x52\x6b\xb5\x03\x25\xbe\x8c\x05\x3c\xa2\x26\x37\x05\x65\x1c\x81\xc5\x9c\x6f\x50\x4a\xeb\x68\xe6\xb5\xef\xe2\x80\x2e\x8e\x46\xe3\x05\x50\x85\x80\xc2\xd6\x12\x59\x28\x74\x07\xb2\xc9\x19\x2a\x71\x44\x5e\x9a\x06\x35\x16\x53\x78\xfd\xc8\x32\x28\xd9\x5a\x1b\xa8\xa5\x92\xe4\xf9\x65\xe0\x49\x2a\xec\x2b\x82\x6a\xd9\x67\x80\xc2\x0e\x19\xc3\x3f\x1c\x56\xb0\xcd\xb6\x2f\xcf\xd0\x08\x22\xb4\xba\xfb\x8f\xb7\xba\x71\xa0\x05\xfb\x06\x43\x25\x28\xaf\xbc\xfc\x36\x08\x42\xaa\xbe\x20\x19\xce\xe7\xa3\xfb\xe7\x35\x54\xe6\xc4\x6a\x7d\xee\x10\xa9\xf7\x57\x3a\x77\x90\x8d\x8f\x9f\xce\xfd\xea\xb0\xc6\x9c\x42\x91\x69\x27\x92\x1c\xf3\xf2\xeb\xd2\x1a\x05\x8a\xf6\x64\x9a\xbb\x59\xe7\xc1\x15\x4e\x15\x5a\xe4\x7d\xd4\x03\x20\x35\x24\xbd\x53\x3a\x64\xc2\x20\x3e\x3b\x32\x99\x4c\x18\x12\xba\xf0\x68\x32\x5e\x6c\x36\xbe\x67\x8e\x84\x6a\x40\x72\xd0\xb6\xae\x81\x1b\x73\x29\xfe\xf4\x08\x03\xd1\x80\x5e\xfe\x47\x3f\xc0\x40\x73\x05\x87\x8e\xca\x5a\x1e\x10\xc6\x2b\x96\xe0\x37\x0f\xc1\xd8\x02\x2d\xec\xce\xbf\x06\x05\xba\xfc\xd2\x93\x70\x8b\xa3\x30\x31\xe0\x01\x98\xb2\x74\xc8\xa7\xf5\xfd\x0f\x06\x42\x3c\x71\x79\x02\x00\x00")
+
+func sqlListeventsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlListeventsSql,
+		"sql/listEvents.sql",
+	)
+}
+
+func sqlListeventsSql() (*asset, error) {
+	bytes, err := sqlListeventsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/listEvents.sql", size: 633, mode: os.FileMode(438), modTime: time.Unix(1792416963, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _sqlMetadeckSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2c\xcb\x41\x0a\xc2\x30\x14\x84\xe1\x7d\xa1\x77\x98\x85\xab\x22\x16\x77\x82\x78\x09\x6f\xf0\x68\xa6\x6d\xb0\x49\x4a\xde\xa8\x78\x7b\x89\xb8\xf9\xe1\x5f\x7c\xe3\xd0\x77\x7d\x77\xa7\x9e\x35\x3b\xb4\x12\x89\xb2\x60\x32\x94\x19\x86\xc0\xe9\x81\x25\xbe\x98\x11\xe5\xbf\x8d\xa1\x91\x61\x6c\x75\x6e\x9c\x84\x7d\xb3\x0f\xeb\x11\xd9\x12\x31\xd7\x92\x90\xb4\xa8\xec\x97\x53\x03\x8e\xf7\xca\xca\x3f\xbe\x1d\xce\xd7\x6f\x00\x00\x00\xff\xff\x29\x6c\xbd\xd9\x76\x00\x00\x00")
 
 func sqlMetadeckSqlBytes() ([]byte, error) {
@@ -256,6 +366,106 @@ func sqlMetadeckSql() (*asset, error) {
 	return a, nil
 }
 
+var _sqlMetaeventSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x8e\x4d\x0a\xc2\x30\x14\x84\xd7\x0d\xe4\x0e\xb3\x70\xd1\x06\xb1\xb8\x13\xc4\x4b\x78\x01\x79\x98\xd7\x26\x62\x92\x92\x3c\xf5\xfa\xa6\x3f\x0b\xdd\xcc\x30\x03\xf3\x31\xbd\xd1\x4a\xab\x2b\xcb\x2b\xc7\x02\x71\x8c\xc0\x42\x96\x84\x90\x06\x10\x02\x3d\x52\x06\xbf\x39\x0a\x46\x5f\x0d\x5e\xca\x9a\xbd\x9d\xa7\xa6\x9f\xb5\xf0\x93\xef\x82\x48\x81\xf7\x70\x34\x4d\x1c\xd9\x62\xc8\x29\x20\xc8\x28\x69\x3a\x1d\x96\x4d\xd1\xaa\xf9\x38\xce\x5c\xbd\xd9\x28\xb8\x60\x77\xac\x99\xa2\xfd\x6d\x7d\x44\xbb\x61\xcd\x3f\x69\xf9\x74\x5b\x79\x6d\xd7\x9d\xbf\xa5\xe6\x41\x92\xc4\x00\x00\x00")
+
+func sqlMetaeventSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlMetaeventSql,
+		"sql/metaEvent.sql",
+	)
+}
+
+func sqlMetaeventSql() (*asset, error) {
+	bytes, err := sqlMetaeventSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/metaEvent.sql", size: 196, mode: os.FileMode(438), modTime: time.Unix(1792416963, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlMetagameshareSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x51\xcb\x6e\x83\x30\x10\x3c\x07\x89\x7f\xd8\x43\x24\x1e\x42\x44\x69\x2f\x15\x6d\xfa\x11\x55\xef\x91\x63\x6f\xb0\x03\xd8\xc8\x5e\x42\xf2\xf7\xb5\x79\x34\x6d\x2e\x46\x68\x5e\x9e\xf1\x2e\x8f\xa3\x38\xfa\x42\x1a\xac\x76\x20\xcd\x08\x1d\xd3\x77\x10\xc8\x1b\x07\xe6\x0c\xc8\xb8\x84\x8e\x6a\x32\xfd\x1b\x30\xcb\x25\xd2\xbd\x47\xe8\x5b\xc6\x51\x00\x23\x4f\xbf\x18\x0b\x78\x45\x4d\x2e\x8e\x46\x45\x52\xe9\x59\xd5\xa3\x55\x46\x04\x13\x06\x96\xe9\x1a\x0b\x30\xad\x40\x47\x2b\x72\x56\xd6\x51\x19\xf2\xbf\x59\x83\x5e\xbd\xd9\xee\x81\x24\xae\x38\x19\x38\x0d\xbc\x41\x82\xd3\xbd\x80\x64\x44\x6c\x12\xf0\x61\x49\x67\x34\xc9\x24\xf0\x5f\x26\xbe\x23\x66\x29\x04\x85\x9f\x25\x4a\x69\xde\x0e\x4e\x5d\x31\xd0\x5e\x27\x04\xb5\x78\x22\xe1\xed\x97\x14\x47\xf9\x2e\x9c\x0e\x5b\xe4\x04\x82\x11\x1e\xc9\x0e\x9a\xa7\xdb\x7d\x55\x11\xde\xa8\x58\x5a\x96\x92\xf5\x3d\x6a\x14\x19\x30\xb7\xdc\xb5\xf0\x29\xd3\x66\xa5\x66\x9d\x37\xe6\x66\xd0\x94\xe6\x59\x55\x9d\x54\xad\x34\xc5\xd1\xd9\x9a\x6e\x1d\xb2\x9c\xe7\x9d\x4e\x2f\xbc\x18\x3f\xd9\x0a\xcd\x19\x4b\x14\x18\xbd\x86\x4e\x1f\x25\xe0\x30\xcb\xca\x9e\x59\x0c\xbe\x9b\x51\xa2\x0d\x25\x37\x4f\x44\xef\x99\x2e\x65\x72\xf8\x97\x3e\x3d\xd9\x71\xa6\xa7\x59\xe6\xb5\x4c\x8b\x3f\x0e\x6b\x3f\xf8\x3c\x80\x5f\xd8\x83\xcf\xcd\xe1\x03\xb6\xaf\x71\x54\x5b\x33\xf4\xfe\x71\xd6\x11\xe0\x31\x41\x1c\x19\x2b\xd0\x3e\xc0\xf7\x1f\x60\xcb\x17\x78\x6a\x02\x00\x00")
+
+func sqlMetagameshareSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlMetagameshareSql,
+		"sql/metagameShare.sql",
+	)
+}
+
+func sqlMetagameshareSql() (*asset, error) {
+	bytes, err := sqlMetagameshareSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/metagameShare.sql", size: 618, mode: os.FileMode(438), modTime: time.Unix(1792416915, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlPerformancearchetypeSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x53\x3d\x6f\xdb\x30\x10\x9d\x2d\x40\xff\xe1\x06\x03\x91\x5c\x43\xfe\x48\x03\x18\x69\xdc\xa9\x43\x87\x4e\x45\xf7\x82\x21\x4f\xd2\xc5\x14\xa9\x92\x94\x15\xf7\xd7\xf7\x28\xc9\x76\xe2\x2d\x1d\x44\x91\xf7\xee\xbd\x7b\x27\x9e\x56\x8b\x34\x49\x93\x9f\x18\x3a\x67\x3c\xd4\xb6\x87\x1e\xb5\x06\x61\x40\x38\x59\x63\x38\xb5\x08\x25\x19\xf2\x35\x2a\x10\x01\x1a\xf1\x62\x1d\xe0\x11\x4d\xf0\xd0\xd7\x24\x6b\xa8\x45\xdb\xa2\x41\x95\x26\x3d\x85\x9a\x98\x09\x3d\x19\x65\xfb\x22\x4a\xff\x12\x07\xf4\x69\x32\x9b\x6f\x58\xd1\x89\x13\xd8\x12\x8c\x75\x8d\xd0\xf4\x97\x25\x9b\x50\x05\xdb\xee\xde\x54\x33\xa2\x19\x09\xdb\x2b\x41\x0a\xa7\x46\x00\x82\x05\x7c\x6d\x35\x49\x0a\xfa\x04\x0e\xff\x74\xe4\x98\x64\x03\x3c\x23\xb4\x0e\x3d\x3b\x03\x36\xa1\x50\x1e\x06\x99\xfb\x0f\xc8\x9c\x79\x4b\xa0\x12\xee\xbe\x61\x29\x3a\x1d\xee\x58\x05\x00\xc8\xbf\x91\xf7\xa4\x10\x42\x8d\x93\x36\xef\xcc\x70\x2c\x49\x07\x74\x31\x97\x2a\xee\x12\x55\x11\x2d\x7c\x1e\x30\x1f\x84\x0b\xd1\x46\x3c\x8c\x5f\x88\xeb\x18\xa9\x3b\x4f\x47\x8c\x79\x0f\x03\x84\x46\xdd\x66\xe1\xeb\x25\x2b\x4d\x16\xab\xb8\x7a\xd4\x28\x03\x48\xdb\x99\x90\x2d\xf2\xc7\xc7\x67\xaa\xc8\x84\xe5\x14\x19\xda\x28\x9c\x30\x87\x1f\xb6\xbf\xa2\x5c\x45\x5a\xa1\xd1\x4b\xcc\x7c\xd7\x64\x52\x78\xae\x12\xdd\x5f\x09\xdf\xa9\xaa\xe1\x69\x0f\xbb\xb1\xad\x0d\xa0\xe6\xa4\x75\xf4\x95\x2f\x61\xfd\x1f\x62\x7b\x16\xf9\x80\x96\x38\x56\xd9\xfb\x06\xe0\xd3\x8d\x64\x0e\x2b\xd8\x16\xeb\x49\xa3\xd4\x56\x84\x5d\x9a\x94\xce\x36\xe7\x89\x2a\x06\xc2\x65\x0c\x5e\x2c\xdf\xed\x19\x9a\xc6\x77\x7a\x59\x33\xed\xc6\x38\x29\xf6\x3b\x56\x6b\x85\xe3\x00\xb3\xb9\x29\x17\x6f\x68\x36\xc6\xe3\xca\x69\x64\x62\x68\x96\x4d\x57\xb1\x80\x77\xf5\x2f\x13\xfd\x7b\x20\x65\xf3\xcd\x12\xe6\x5b\x7e\xee\xf3\x9c\x79\xc2\xa8\xc8\x9e\x2a\x9f\xff\x21\xf8\xba\x07\x1e\x17\x06\xe1\x16\x79\x82\xf9\xc3\x97\x7f\x87\x3e\x5b\xb2\xaf\x03\x00\x00")
+
+func sqlPerformancearchetypeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlPerformancearchetypeSql,
+		"sql/performanceArchetype.sql",
+	)
+}
+
+func sqlPerformancearchetypeSql() (*asset, error) {
+	bytes, err := sqlPerformancearchetypeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/performanceArchetype.sql", size: 943, mode: os.FileMode(438), modTime: time.Unix(1792417157, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSearchcardsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x8d\xc1\x0a\xc2\x30\x10\x44\xcf\x16\xfa\x0f\x7b\xf0\xa0\xa5\x58\xbc\x49\xc4\x9f\xf0\x2a\x1e\xd2\x64\x9b\x2e\xda\xa4\x6e\xb6\xd5\xfc\xbd\x89\x08\xc3\x1c\x66\x86\x37\x5d\x53\x57\x75\x75\x45\x59\xd8\x47\x90\x11\xc1\x04\x2f\xe8\x25\x42\x18\x00\x57\xe4\x04\x16\xcd\x03\x1c\xad\xe8\x41\x67\x31\xeb\x54\xca\x3c\x26\xfe\x95\x64\x63\xa1\x34\x5d\xf1\x88\x4f\x34\x02\xb3\xe6\x4c\x69\xc1\xeb\x09\x5b\x78\x2d\xda\x0b\x49\x52\xaa\x27\x47\x25\x8f\x64\xb1\x0f\x9a\x2d\x0c\x1c\x26\x98\xc4\x49\x98\x4f\x07\x93\x93\x0c\xdb\xbc\x47\x64\xfc\x43\xe0\x92\x7f\xd3\x6e\x7b\x54\x4a\xf0\x23\xb7\xfb\xfe\xfc\x05\x92\xd6\xf9\xe8\xb7\x00\x00\x00")
+
+func sqlSearchcardsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSearchcardsSql,
+		"sql/searchCards.sql",
+	)
+}
+
+func sqlSearchcardsSql() (*asset, error) {
+	bytes, err := sqlSearchcardsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/searchCards.sql", size: 183, mode: os.FileMode(438), modTime: time.Unix(1792418997, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sqlSearchdecksSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x55\x4b\x8f\xda\x30\x10\x3e\x83\xc4\x7f\x98\x03\x12\x0f\x45\xec\xb2\xec\x93\x3e\x4e\x5d\xa9\x55\xd1\x1e\xaa\xf6\x54\x55\x2b\x93\x4c\xc0\x4b\x62\xa7\xb6\x59\x48\x7f\x7d\x67\xec\x00\x09\x65\xa5\xad\xaa\x5e\x4c\x6c\x7f\xf3\xcd\xcc\xe7\x99\xe1\x6c\xd8\x69\x77\xda\x5f\xd0\xad\x8d\xb2\x90\x60\xbc\xb2\x90\x1a\x9d\x43\x2e\x9e\xb4\x01\x7c\x46\xe5\x2c\x6d\x5c\xbc\x94\x6a\x01\x02\x2c\x0a\x13\x2f\x23\xc8\xb5\x75\x60\x30\xa6\x7b\x48\xa5\xb1\x6e\xc4\x44\x5f\xc5\x0a\x6d\xa7\xdd\xea\x8e\x41\x18\x23\x4a\xd0\x29\xc4\xc2\x24\xa0\x44\x8e\x96\xe9\x4c\xe9\xbd\x40\xbe\x26\xfb\x22\x13\x25\xa3\x2f\x4e\xa2\x95\xae\xa0\x74\xb3\x43\x4e\x60\xb3\x94\xf1\x12\xe6\x9a\x71\xe4\x46\x5a\xc8\xb4\x5e\x61\x02\x29\xc5\x2b\x55\x04\xce\xac\xd1\x6f\xac\x4c\xd0\xe3\x22\xb2\x04\x80\x54\x64\x36\xdc\xe4\x42\x2a\xa6\x8e\xe0\xe1\xdb\x6c\xe6\x8f\x50\xba\x25\x1a\x76\x71\xd9\x74\x71\x71\xc2\x85\xb0\xd0\x9d\x30\xf6\xea\x10\xb8\xd2\x26\x17\x99\xfc\x45\xb0\xdc\x2d\x9c\x2e\x6e\x81\x95\x42\x57\x16\x18\x12\xaa\x79\x13\xca\x67\x73\x7d\x32\x6f\xa7\x01\xb7\x45\x26\x63\xe9\xb2\x92\x34\xfe\xb9\x96\x86\x28\xb4\x83\x39\x42\x61\xd0\xb2\xe6\x52\x85\xa4\x28\xea\x83\x9f\x5e\xf5\x84\xcc\x7d\xf3\x17\xdc\x52\x1d\xf3\x04\x72\x4f\x16\x81\x4c\xa1\xf7\x01\x53\xb1\xce\x5c\x8f\xd5\x38\xc4\xc0\x12\x57\xa6\xec\x8b\xbe\x02\x53\x2a\x33\xe7\xd5\x24\x12\x32\x90\x0b\x52\x07\x93\x11\xc7\x75\x0b\x9f\x66\x9f\x3e\xdf\x43\x21\x1c\x41\x02\x9c\x5f\x17\x0d\x45\xcf\x41\x86\xd2\xf0\x25\xc7\xf8\xbb\x50\x84\x32\xf1\x48\x5f\x10\x04\x8f\x49\x66\x7e\x09\xcc\x0b\x57\xd6\x25\x1d\x9f\x7b\x1c\x55\x69\x26\x91\x78\xfc\x86\x09\x7c\x19\x2d\xc5\x33\xd2\x52\x14\xa8\x30\xa9\xbd\x07\x95\x5a\x26\x73\xe9\x3c\xc3\xd8\x1b\x39\x99\x63\xdd\x9a\x83\x6a\x98\xd3\x6b\x90\x29\xbe\xc4\x72\xe1\x8d\x7d\x9b\x84\xb6\x22\xe9\x8d\xef\x33\x7f\x3d\x81\xa5\xde\x50\x4c\xaa\x3c\x74\xd7\x1e\x67\x57\xb2\xe0\x76\x1a\x9e\xf1\x6a\x31\xc3\xb8\x62\x19\xb1\x42\x51\xf5\xcd\xab\x4c\x76\xbb\x20\x22\xd7\x7a\xac\x45\x86\x36\xc6\x7e\xb8\x30\x42\xad\x66\x7a\x13\xc1\xf9\x60\x3a\x9d\xcb\x85\x54\x2e\x82\x13\x98\x8f\x72\xb1\x6c\x80\x4e\x50\x61\xac\xa9\x9f\xa0\xd7\x1b\xf0\x6d\x18\x0f\xa3\xea\x81\xa2\x6a\x5c\x54\x31\x56\x9b\x9d\x5c\x9d\x76\x98\x2b\xa1\x37\x46\x21\xd7\x5d\xb5\x3e\x69\x2a\xc1\xdd\x55\x35\x74\xaa\x1f\xad\xa0\xe9\x06\xde\xed\x12\x16\x86\x0e\xc8\x7a\x43\x9d\x8b\xf4\x7b\x14\x0f\x97\x75\xbf\x12\x6f\x08\x0d\xef\x7e\xba\x3d\x06\x78\x7f\x30\x20\x5b\xa1\x12\x66\x38\x1b\xc2\xbd\x1f\x52\xbe\x67\x36\x08\x1b\xc1\x85\x7e\xa8\x79\x7e\x92\x56\x8b\x9b\x11\xb7\xd2\x3a\x0e\xbe\xd5\xea\xfb\xb5\x55\xf9\x5a\x2b\x45\x95\xd7\xef\x8e\xa7\x53\x87\x5b\xf7\xfd\xc7\x20\x5c\xe3\x36\xc6\xc2\x35\xa0\xbe\xde\x1b\x91\xb1\x5f\xeb\xbd\x07\xea\x56\xc8\x2e\x9c\x54\x29\xef\x15\x08\x05\x00\x55\xec\x1c\x48\x77\x42\xaf\xa7\x75\x86\x42\x71\xd4\x6a\x9d\x65\x40\x95\x19\xac\xf7\x03\x91\x08\x6a\xc0\x10\x5e\x53\x84\x07\xbd\x57\x20\xd1\xaa\xe7\xfe\x45\x87\x8b\x23\x1d\xa8\xb4\xd0\x58\x42\xfc\x67\x29\x2e\x5f\x2b\xc5\xe5\xcb\x52\xf4\xbb\x57\xbb\xe8\xeb\x24\x0d\x9f\x7e\x14\x13\xf4\x74\xa9\xed\x87\xea\xa3\x37\xaa\x11\x46\x21\xd2\xee\xf5\xfe\x00\xba\x37\x7b\xad\xea\x55\x59\x6f\x70\x90\x99\x5c\x21\x74\x6f\x03\xb2\x1e\xea\x5d\x38\xa2\x94\x7a\x3d\xd8\xff\x7b\xd7\xfa\x66\x87\x68\x24\x38\x3e\xa7\x43\x1a\x76\xd6\x89\xbc\xa8\x27\x79\xd4\xc0\xf0\x9e\x08\x1a\xe0\x26\xcd\xf8\x95\x34\x6f\xa1\x89\x25\x16\x9a\x29\x94\xd8\xbc\xfc\x03\x9b\xd0\xec\x69\x4e\x3b\x7f\xd4\x69\xfb\x39\x0b\x3c\x65\x75\x9a\x5a\xe4\xcf\xc9\x9b\xdf\xc5\xff\x02\x0c\xcd\x08\x00\x00")
+
+func sqlSearchdecksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlSearchdecksSql,
+		"sql/searchDecks.sql",
+	)
+}
+
+func sqlSearchdecksSql() (*asset, error) {
+	bytes, err := sqlSearchdecksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sql/searchDecks.sql", size: 2253, mode: os.FileMode(438), modTime: time.Unix(1792418377, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -311,12 +521,22 @@ var _bindata = map[string]func() (*asset, error){
 	"sql/addCard.sql": sqlAddcardSql,
 	"sql/addDeck.sql": sqlAdddeckSql,
 	"sql/addEvent.sql": sqlAddeventSql,
+	"sql/cardArchetypeStats.sql": sqlCardarchetypestatsSql,
 	"sql/cardArchetypes.sql": sqlCardarchetypesSql,
+	"sql/consensusArchetype.sql": sqlConsensusarchetypeSql,
 	"sql/contentsArchetype.sql": sqlContentsarchetypeSql,
 	"sql/contentsDeck.sql": sqlContentsdeckSql,
+	"sql/eventCards.sql": sqlEventcardsSql,
+	"sql/eventDecks.sql": sqlEventdecksSql,
 	"sql/existsEvent.sql": sqlExistseventSql,
 	"sql/latestArchetype.sql": sqlLatestarchetypeSql,
+	"sql/listEvents.sql": sqlListeventsSql,
 	"sql/metaDeck.sql": sqlMetadeckSql,
+	"sql/metaEvent.sql": sqlMetaeventSql,
+	"sql/metagameShare.sql": sqlMetagameshareSql,
+	"sql/performanceArchetype.sql": sqlPerformancearchetypeSql,
+	"sql/searchCards.sql": sqlSearchcardsSql,
+	"sql/searchDecks.sql": sqlSearchdecksSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -366,18 +586,38 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 		"addEvent.sql": &bintree{sqlAddeventSql, map[string]*bintree{
 		}},
+		"cardArchetypeStats.sql": &bintree{sqlCardarchetypestatsSql, map[string]*bintree{
+		}},
 		"cardArchetypes.sql": &bintree{sqlCardarchetypesSql, map[string]*bintree{
 		}},
+		"consensusArchetype.sql": &bintree{sqlConsensusarchetypeSql, map[string]*bintree{
+		}},
 		"contentsArchetype.sql": &bintree{sqlContentsarchetypeSql, map[string]*bintree{
 		}},
 		"contentsDeck.sql": &bintree{sqlContentsdeckSql, map[string]*bintree{
 		}},
+		"eventCards.sql": &bintree{sqlEventcardsSql, map[string]*bintree{
+		}},
+		"eventDecks.sql": &bintree{sqlEventdecksSql, map[string]*bintree{
+		}},
 		"existsEvent.sql": &bintree{sqlExistseventSql, map[string]*bintree{
 		}},
 		"latestArchetype.sql": &bintree{sqlLatestarchetypeSql, map[string]*bintree{
 		}},
+		"listEvents.sql": &bintree{sqlListeventsSql, map[string]*bintree{
+		}},
 		"metaDeck.sql": &bintree{sqlMetadeckSql, map[string]*bintree{
 		}},
+		"metaEvent.sql": &bintree{sqlMetaeventSql, map[string]*bintree{
+		}},
+		"metagameShare.sql": &bintree{sqlMetagameshareSql, map[string]*bintree{
+		}},
+		"performanceArchetype.sql": &bintree{sqlPerformancearchetypeSql, map[string]*bintree{
+		}},
+		"searchCards.sql": &bintree{sqlSearchcardsSql, map[string]*bintree{
+		}},
+		"searchDecks.sql": &bintree{sqlSearchdecksSql, map[string]*bintree{
+		}},
 	}},
 }}
 
@@ -2,10 +2,12 @@ package deckDB
 
 import(
 
+	"./deckData"
 	"./nameNorm"
 	"github.com/jackc/pgx"
 
 	"fmt"
+	"sort"
 
 )
 
@@ -60,3 +62,76 @@ func GetCardArchetypes(pool *pgx.ConnPool,
 	return archetypes, err
 
 }
+
+// Given a card name, returns how it is played in each archetype it
+// has appeared within, most widely played first.
+//
+// mtgtop8 archetypes which clean to the same name are combined.
+func GetCardArchetypeStats(pool *pgx.ConnPool,
+	card string) ([]*deckData.CardArchetype, error) {
+
+	rows, err := pool.Query(cardArchetypeStats, card)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byName:= make(map[string]*deckData.CardArchetype)
+	// Copies played across every deck playing the card
+	maindeck:= make(map[string]int64)
+	sideboard:= make(map[string]int64)
+	for rows.Next() {
+		var dirty string
+		var decks, playing, main, side int64
+
+		err = rows.Scan(&dirty, &decks, &playing, &main, &side)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		n, err:= nameNorm.CleanEst(dirty)
+		if err !=nil {
+			continue
+		}
+
+		a, ok:= byName[n]
+		if !ok {
+			a = &deckData.CardArchetype{Archetype: n}
+			byName[n] = a
+		}
+		a.Decks+= decks
+		a.Playing+= playing
+		maindeck[n]+= main
+		sideboard[n]+= side
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	archetypes:= make([]*deckData.CardArchetype, 0)
+	for n, a:= range byName{
+		a.Share = float64(a.Playing) / float64(a.Decks)
+		a.AverageMaindeck = float64(maindeck[n]) / float64(a.Playing)
+		a.AverageSideboard = float64(sideboard[n]) / float64(a.Playing)
+		a.AverageCopies = a.AverageMaindeck + a.AverageSideboard
+
+		archetypes = append(archetypes, a)
+	}
+
+	sort.Sort(byShare(archetypes))
+
+	return archetypes, nil
+
+}
+
+// Sorts archetypes by how widely they play a card, then by name
+type byShare []*deckData.CardArchetype
+
+func (a byShare) Len() int { return len(a) }
+func (a byShare) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byShare) Less(i, j int) bool {
+	if a[i].Share != a[j].Share {
+		return a[i].Share > a[j].Share
+	}
+	return a[i].Archetype < a[j].Archetype
+}
@@ -0,0 +1,248 @@
+package deckDB
+
+import(
+
+	"./deckData"
+	"./nameNorm"
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"sort"
+	"time"
+
+)
+
+// Legal sizes for a consensus list, it plays exactly MaindeckSize
+// and at most SideboardSize
+const(
+	MaindeckSize int64 = 60
+	SideboardSize int64 = 15
+)
+
+// The most copies of a card a list may play across both boards,
+// basic lands aside
+const MaxCopies int64 = 4
+
+// Cards played by at least this fraction of decks are staples,
+// anything less is a flex slot
+const CoreInclusion float64 = 0.75
+
+var ErrNoDecks error = fmt.Errorf("no decks in window")
+
+// Basic lands are the only cards exempt from MaxCopies
+var basicLands = map[string]bool{
+	"Plains": true, "Island": true, "Swamp": true,
+	"Mountain": true, "Forest": true, "Wastes": true,
+	"Snow-Covered Plains": true, "Snow-Covered Island": true,
+	"Snow-Covered Swamp": true, "Snow-Covered Mountain": true,
+	"Snow-Covered Forest": true,
+}
+
+// Given an archetype name standardized by nameNorm, returns the list
+// a typical deck of that archetype played at major events happening
+// from 'from' until 'to'.
+//
+// Each board is filled with the cards played by the most decks, each
+// at its median copies, until it reaches its legal size. Cards which
+// are not staples or which didn't fit at their median are flagged as
+// flex slots.
+//
+// Should too few distinct cards have been played to fill the maindeck
+// the list is returned anyway, marked Short.
+func GetArchetypeConsensus(pool *pgx.ConnPool, name string,
+	from, to time.Time) (*deckData.ConsensusDeck, error) {
+
+	// Translate our clean names into mtgtop8 names
+	// with associated metadata
+	archetypes, presentCards, excludedCards, err:= nameNorm.Invert(name)
+	if err!=nil {
+		return nil, err
+	}
+
+	rows, err := pool.Query(archetypeConsensus, archetypes,
+		excludedCards, presentCards, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Keyed by deckid then card name
+	maindecks:= make(map[string]map[string]int64)
+	sideboards:= make(map[string]map[string]int64)
+	for rows.Next() {
+		var parent, card string
+		var quantity int64
+		var sideboard bool
+
+		err = rows.Scan(&parent, &card, &quantity, &sideboard)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		if _, ok:= maindecks[parent]; !ok {
+			maindecks[parent] = make(map[string]int64)
+			sideboards[parent] = make(map[string]int64)
+		}
+
+		if sideboard {
+			sideboards[parent][card]+= quantity
+		}else{
+			maindecks[parent][card]+= quantity
+		}
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	if len(maindecks) == 0 {
+		return nil, ErrNoDecks
+	}
+
+	c:= deckData.ConsensusDeck{
+		Archetype: name,
+		From: deckData.Timestamp(from),
+		To: deckData.Timestamp(to),
+		Decks: int64(len(maindecks)),
+	}
+
+	// Copies already played, shared so the sideboard
+	// respects the maindeck
+	played:= make(map[string]int64)
+
+	var size int64
+	c.Maindeck, size = fillBoard(boardStats(maindecks),
+		MaindeckSize, played)
+	c.Short = size < MaindeckSize
+	c.Sideboard, _ = fillBoard(boardStats(sideboards),
+		SideboardSize, played)
+
+	return &c, nil
+
+}
+
+// How widely each card in a board is played and its median copies
+// when played, staples first.
+func boardStats(boards map[string]map[string]int64) []*deckData.ConsensusCard {
+
+	copies:= make(map[string][]int64)
+	for _, board:= range boards{
+		for card, quantity:= range board{
+			copies[card] = append(copies[card], quantity)
+		}
+	}
+
+	cards:= make([]*deckData.ConsensusCard, 0, len(copies))
+	for card, quantities:= range copies{
+		cards = append(cards, &deckData.ConsensusCard{
+			Name: card,
+			Inclusion: float64(len(quantities)) / float64(len(boards)),
+			MedianCopies: median(quantities),
+		})
+	}
+	sort.Sort(byInclusion(cards))
+
+	return cards
+
+}
+
+// The lower median of a set of quantities, we'd rather
+// play a copy short than invent one
+func median(quantities []int64) int64 {
+
+	sorted:= make([]int64, len(quantities))
+	copy(sorted, quantities)
+	sort.Sort(int64s(sorted))
+
+	return sorted[(len(sorted) - 1) / 2]
+
+}
+
+// Fills a board of up to size cards from candidates in order, each at
+// its median copies while respecting MaxCopies across boards.
+//
+// Should the medians fall short of size the cards already chosen are
+// topped up to MaxCopies as flex slots. The board can still fall short
+// when too few cards are candidates, so how many it holds is returned
+// alongside it.
+func fillBoard(candidates []*deckData.ConsensusCard,
+	size int64, played map[string]int64) ([]*deckData.ConsensusCard, int64) {
+
+	var board []*deckData.ConsensusCard
+	var total int64
+
+	for _, c:= range candidates{
+		if total >= size {
+			break
+		}
+
+		quantity:= boundCopies(c.Name, c.MedianCopies, size - total, played)
+		if quantity < 1 {
+			continue
+		}
+
+		c.Quantity = quantity
+		c.Flex = c.Inclusion < CoreInclusion || quantity < c.MedianCopies
+		total+= quantity
+		played[c.Name]+= quantity
+
+		board = append(board, c)
+	}
+
+	for _, c:= range board{
+		if total >= size {
+			break
+		}
+
+		quantity:= boundCopies(c.Name, size - total, size - total, played)
+		if quantity < 1 {
+			continue
+		}
+
+		c.Quantity+= quantity
+		c.Flex = true
+		total+= quantity
+		played[c.Name]+= quantity
+	}
+
+	return board, total
+
+}
+
+// The copies of a card we may add, at most wanted and room and never
+// more than MaxCopies across boards unless it's a basic land
+func boundCopies(name string, wanted, room int64,
+	played map[string]int64) int64 {
+
+	if wanted > room {
+		wanted = room
+	}
+
+	if !basicLands[name] && wanted > MaxCopies - played[name] {
+		wanted = MaxCopies - played[name]
+	}
+
+	return wanted
+
+}
+
+// Sorts cards most widely played first, then by
+// median copies, then by name
+type byInclusion []*deckData.ConsensusCard
+
+func (a byInclusion) Len() int { return len(a) }
+func (a byInclusion) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byInclusion) Less(i, j int) bool {
+	if a[i].Inclusion != a[j].Inclusion {
+		return a[i].Inclusion > a[j].Inclusion
+	}
+	if a[i].MedianCopies != a[j].MedianCopies {
+		return a[i].MedianCopies > a[j].MedianCopies
+	}
+	return a[i].Name < a[j].Name
+}
+
+type int64s []int64
+
+func (a int64s) Len() int { return len(a) }
+func (a int64s) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a int64s) Less(i, j int) bool { return a[i] < a[j] }
@@ -0,0 +1,171 @@
+package deckDB
+
+import(
+
+	"./deckData"
+
+	"testing"
+
+)
+
+func TestMedian(t *testing.T) {
+
+	cases:= []struct{
+		quantities []int64
+		want int64
+	}{
+		{[]int64{4}, 4},
+		{[]int64{3, 1, 2}, 2},
+		// Even counts take the lower middle
+		{[]int64{1, 4}, 1},
+		{[]int64{4, 4, 1, 2}, 2},
+	}
+
+	for _, c:= range cases{
+		quantities:= append([]int64{}, c.quantities...)
+		got:= median(quantities)
+		if got != c.want {
+			t.Fatal("wrong median", c.quantities, got, c.want)
+		}
+		for i:= range quantities{
+			if quantities[i] != c.quantities[i] {
+				t.Fatal("median reordered its input", quantities)
+			}
+		}
+	}
+
+}
+
+// A staple of the given name played at median copies.
+func staple(name string, median int64) *deckData.ConsensusCard {
+	return &deckData.ConsensusCard{
+		Name: name, Inclusion: 1, MedianCopies: median,
+	}
+}
+
+func TestFillBoard(t *testing.T) {
+
+	cases:= []struct{
+		name string
+		candidates []*deckData.ConsensusCard
+		size int64
+		// Copies already played in another board
+		played map[string]int64
+
+		want map[string]int64
+		flex map[string]bool
+		total int64
+	}{
+		{
+			name: "medians fill the board",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 4), staple("Helix", 2),
+			},
+			size: 6,
+			want: map[string]int64{"Bolt": 4, "Helix": 2},
+			flex: map[string]bool{"Bolt": false, "Helix": false},
+			total: 6,
+		},
+		{
+			name: "the cap is shared across boards",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 4), staple("Helix", 2),
+			},
+			size: 3,
+			played: map[string]int64{"Bolt": 3},
+			want: map[string]int64{"Bolt": 1, "Helix": 2},
+			flex: map[string]bool{"Bolt": true, "Helix": false},
+			total: 3,
+		},
+		{
+			name: "cards at the cap are left out",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 2), staple("Helix", 2),
+			},
+			size: 2,
+			played: map[string]int64{"Bolt": 4},
+			want: map[string]int64{"Helix": 2},
+			flex: map[string]bool{"Helix": false},
+			total: 2,
+		},
+		{
+			name: "basics ignore the cap",
+			candidates: []*deckData.ConsensusCard{
+				staple("Mountain", 12), staple("Bolt", 4),
+			},
+			size: 20,
+			played: map[string]int64{"Mountain": 4},
+			want: map[string]int64{"Mountain": 16, "Bolt": 4},
+			flex: map[string]bool{"Mountain": true, "Bolt": false},
+			total: 20,
+		},
+		{
+			name: "short medians are topped up",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 2), staple("Helix", 2),
+			},
+			size: 6,
+			want: map[string]int64{"Bolt": 4, "Helix": 2},
+			flex: map[string]bool{"Bolt": true, "Helix": false},
+			total: 6,
+		},
+		{
+			name: "contested cards are flex",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 4),
+				&deckData.ConsensusCard{
+					Name: "Helix", Inclusion: CoreInclusion / 2, MedianCopies: 2,
+				},
+			},
+			size: 6,
+			want: map[string]int64{"Bolt": 4, "Helix": 2},
+			flex: map[string]bool{"Bolt": false, "Helix": true},
+			total: 6,
+		},
+		{
+			name: "too few cards fall short",
+			candidates: []*deckData.ConsensusCard{
+				staple("Bolt", 4), staple("Helix", 1),
+			},
+			size: MaindeckSize,
+			want: map[string]int64{"Bolt": 4, "Helix": 4},
+			flex: map[string]bool{"Bolt": false, "Helix": true},
+			total: 8,
+		},
+	}
+
+	for _, c:= range cases{
+		played:= make(map[string]int64)
+		for name, quantity:= range c.played{
+			played[name] = quantity
+		}
+
+		board, total:= fillBoard(c.candidates, c.size, played)
+		if total != c.total {
+			t.Fatal(c.name, "wrong total", total, c.total)
+		}
+		if len(board) != len(c.want) {
+			t.Fatal(c.name, "wrong cards", len(board), len(c.want))
+		}
+
+		var sum int64
+		for _, card:= range board{
+			if card.Quantity != c.want[card.Name] {
+				t.Fatal(c.name, "wrong copies", card.Name,
+					card.Quantity, c.want[card.Name])
+			}
+			if card.Flex != c.flex[card.Name] {
+				t.Fatal(c.name, "wrong flex", card.Name, card.Flex)
+			}
+			if played[card.Name] != c.played[card.Name] + card.Quantity {
+				t.Fatal(c.name, "played not updated", card.Name,
+					played[card.Name])
+			}
+			sum+= card.Quantity
+		}
+		if sum != total {
+			t.Fatal(c.name, "total disagrees with the board", sum, total)
+		}
+	}
+
+}
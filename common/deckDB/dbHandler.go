@@ -29,22 +29,35 @@ const eventInsert string = "addEvent"
 
 const archetypeContents string = "contentsArchetype"
 const archetypeLatest string = "latestArchetype"
+const archetypePerformance string = "performanceArchetype"
+const archetypeConsensus string = "consensusArchetype"
 
 const deckContents string = "contentsDeck"
 const deckMeta string = "metaDeck"
+const deckSearch string = "searchDecks"
+const deckSearchCards string = "searchCards"
 
 const eventExists string = "existsEvent"
+const eventList string = "listEvents"
+const eventMeta string = "metaEvent"
+const eventDecks string = "eventDecks"
+const eventCards string = "eventCards"
 
 const cardArchetypes string = "cardArchetypes"
+const cardArchetypeStats string = "cardArchetypeStats"
+
+const metagameShare string = "metagameShare"
 
 
 
 var statements = []string{
 	cardInsert, deckInsert, eventInsert,
-	archetypeContents, archetypeLatest,
-	deckContents, deckMeta,
-	eventExists,
-	cardArchetypes,
+	archetypeContents, archetypeLatest, archetypePerformance,
+	archetypeConsensus,
+	deckContents, deckMeta, deckSearch, deckSearchCards,
+	eventExists, eventList, eventMeta, eventDecks, eventCards,
+	cardArchetypes, cardArchetypeStats,
+	metagameShare,
 }
 
 const statementLoc string = "sql"
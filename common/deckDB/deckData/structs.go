@@ -24,6 +24,102 @@ type TaggedDeck struct{
 }
 
 
+// How a card is played within a single archetype.
+type CardArchetype struct{
+	Archetype string
+
+	// Decks of the archetype we know of and how many play the card
+	Decks, Playing int64
+	// The fraction of the archetype's decks that play the card
+	Share float64
+
+	// Copies per deck that plays the card, overall and split between
+	// the maindeck and sideboard
+	AverageCopies float64
+	AverageMaindeck, AverageSideboard float64
+}
+
+// The archetypes that placed during a single period of the metagame.
+type MetaPeriod struct{
+	// When the period began
+	Start Timestamp
+	// Every deck that placed during the period
+	Decks int64
+
+	// Most played first
+	Archetypes []*ArchetypeShare
+}
+
+// How much of a period's metagame an archetype made up.
+type ArchetypeShare struct{
+	Archetype string
+	Decks int64
+	// The fraction of the period's decks
+	Share float64
+}
+
+// How well an archetype finished at major events over a window.
+type ArchetypePerformance struct{
+	Archetype string
+	From, To Timestamp
+
+	// Decks that placed and how many of them we know the finish of
+	Decks, Ranked int64
+	// Ranked decks finishing in the top 8 and those finishing first
+	Top8, Wins int64
+	// The fraction of ranked decks which made the top 8
+	Top8Conversion float64
+	// The mean finish of ranked decks, a tied band counting as its middle
+	AverageFinish float64
+}
+
+// A card in a consensus list and how the archetype plays it.
+type ConsensusCard struct{
+	Name string
+	// Copies the consensus plays
+	Quantity int64
+
+	// The fraction of decks playing the card in this board
+	Inclusion float64
+	// The median copies among decks playing the card in this board
+	MedianCopies int64
+
+	// Whether the slot is contested rather than a staple
+	Flex bool
+}
+
+// The typical list of an archetype over a window.
+type ConsensusDeck struct{
+	Archetype string
+	From, To Timestamp
+
+	// How many decks the consensus was drawn from
+	Decks int64
+
+	Maindeck []*ConsensusCard
+	Sideboard []*ConsensusCard
+	// Whether the maindeck falls short of 60 cards as too few distinct
+	// cards were played to fill it
+	Short bool
+}
+
+// A deck found by a search, without its contents.
+type DeckSummary struct{
+	// Named as the archetype it belongs to
+	Name string
+	DeckID string
+	Player string
+
+	RankLow int `json:",omitempty"`
+	RankHigh int `json:",omitempty"`
+	Record string `json:",omitempty"`
+
+	// The event the deck placed in
+	EventID string
+	EventName string
+	Happened Timestamp
+}
+
 // Bidirectional structs
 
 type Card struct {
@@ -57,6 +153,13 @@ type Deck struct {
 	// The specific person piloting the deck
 	Player string
 
+	// Where the deck finished, zero when unknown. Tied finishes share a
+	// band so 3-4 is a RankLow of 3 and a RankHigh of 4
+	RankLow int `json:",omitempty"`
+	RankHigh int `json:",omitempty"`
+	// Matches won, lost, and drawn such as 6-2-1, empty when unknown
+	Record string `json:",omitempty"`
+
 	// Deck contents
 	Maindeck  []*Card
 	Sideboard []*Card
@@ -2,10 +2,21 @@ package deckDB
 
 import(
 
+	"./deckData"
+	"./nameNorm"
 	"github.com/jackc/pgx"
 
+	"fmt"
+	"strings"
+	"time"
+
 )
 
+// The most events a single page may hold.
+const MaxEventPage int = 100
+
+var ErrNoEvent error = fmt.Errorf("no such major event")
+
 // Fetch the contents of a deck denoted by a deckid
 func HasEvent(pool *pgx.ConnPool,
 	eventid string) (bool, error) {
@@ -18,4 +29,170 @@ func HasEvent(pool *pgx.ConnPool,
 	}
 
 	return present, nil
-}
\ No newline at end of file
+}
+
+// Narrows which major events are listed.
+//
+// Zero times and an empty name match everything.
+type EventQuery struct{
+	From, To time.Time
+	// Matches a substring of the event's name regardless of case
+	Name string
+
+	Limit, Offset int
+}
+
+// Returns a page of major events matching the query, most recent first.
+//
+// Events are listed without their decks.
+func ListEvents(pool *pgx.ConnPool,
+	q EventQuery) ([]*deckData.Event, error) {
+
+	if q.Limit < 1 || q.Limit > MaxEventPage {
+		q.Limit = MaxEventPage
+	}
+
+	rows, err := pool.Query(eventList, optionalTime(q.From),
+		optionalTime(q.To), containsPattern(q.Name), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events:= make([]*deckData.Event, 0)
+	for rows.Next() {
+		e:= deckData.Event{}
+		var t time.Time
+
+		err = rows.Scan(&e.Name, &e.EventID, &t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		e.Happened = deckData.Timestamp(t)
+
+		events = append(events, &e)
+	}
+
+	return events, rows.Err()
+
+}
+
+// A time to filter by, NULL when unset.
+func optionalTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+
+	return t
+}
+
+// An ILIKE pattern matching names containing s literally.
+func containsPattern(s string) string {
+	escaper:= strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + escaper.Replace(s) + "%"
+}
+
+// Fetch a major event alongside every deck that placed in it.
+//
+// Decks are named as GetDeck names them, those we can't name are
+// given OtherArchetype.
+func GetEvent(pool *pgx.ConnPool,
+	eventid string) (*deckData.Event, error) {
+
+	e:= deckData.Event{EventID: eventid}
+	var t time.Time
+
+	err:= pool.QueryRow(eventMeta, eventid).Scan(&e.Name, &t)
+	if err == pgx.ErrNoRows {
+		return nil, ErrNoEvent
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.Happened = deckData.Timestamp(t)
+
+	decks, err:= getEventDecks(pool, eventid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill every deck with its contents in one pass
+	byID:= make(map[string]*deckData.Deck)
+	for _, d:= range decks{
+		byID[d.DeckID] = d
+	}
+
+	rows, err := pool.Query(eventCards, eventid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c:= deckData.Card{}
+		var parent string
+		var sideboard bool
+
+		err = rows.Scan(&parent, &c.Name, &c.Quantity, &sideboard)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		d, ok:= byID[parent]
+		if !ok {
+			continue
+		}
+
+		if sideboard {
+			d.Sideboard = append(d.Sideboard, &c)
+		}else{
+			d.Maindeck = append(d.Maindeck, &c)
+		}
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	// Names depend upon contents so are only cleaned once they're present
+	for _, d:= range decks{
+		err = nameNorm.Clean(d)
+		if err != nil {
+			d.Name = OtherArchetype
+		}
+	}
+
+	e.Decks = decks
+
+	return &e, nil
+
+}
+
+// Acquire the metadata of every deck placing in an event, best finishes
+// first
+func getEventDecks(pool *pgx.ConnPool,
+	eventid string) ([]*deckData.Deck, error) {
+
+	rows, err := pool.Query(eventDecks, eventid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decks:= make([]*deckData.Deck, 0)
+	for rows.Next() {
+		d:= deckData.Deck{}
+		var low, high int64
+
+		err = rows.Scan(&d.DeckID, &d.Player, &d.Name,
+			&low, &high, &d.Record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		d.RankLow, d.RankHigh = int(low), int(high)
+
+		decks = append(decks, &d)
+	}
+
+	return decks, rows.Err()
+
+}
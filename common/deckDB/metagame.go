@@ -0,0 +1,155 @@
+package deckDB
+
+import(
+
+	"./deckData"
+	"./nameNorm"
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"sort"
+	"time"
+
+)
+
+// Periods the metagame may be divided into
+const(
+	PeriodWeek string = "week"
+	PeriodMonth string = "month"
+)
+
+// Where archetypes outside the top and decks we can't name are counted.
+const OtherArchetype string = "Other"
+
+var ErrBadPeriod error = fmt.Errorf("unknown period")
+
+// Whether the provided period is one we divide the metagame by.
+func ValidPeriod(period string) bool {
+	return period == PeriodWeek || period == PeriodMonth
+}
+
+// Returns each archetype's share of the decks placing at major events
+// during every period from 'from' until 'to', oldest period first.
+//
+// When top is positive only the top most played archetypes across the
+// entire range are kept, the rest are counted as OtherArchetype so
+// each period names the same archetypes. Periods without events are
+// omitted.
+func GetMetagame(pool *pgx.ConnPool, period string,
+	from, to time.Time, top int) ([]*deckData.MetaPeriod, error) {
+
+	if !ValidPeriod(period) {
+		return nil, ErrBadPeriod
+	}
+
+	rows, err := pool.Query(metagameShare, period, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var starts []time.Time
+	// Keyed by the Unix time each period started
+	counts:= make(map[int64]map[string]int64)
+	totals:= make(map[string]int64)
+	for rows.Next() {
+		var start time.Time
+		var dirty string
+		var decks int64
+
+		err = rows.Scan(&start, &dirty, &decks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		// Decks we can't name still took up part of the metagame
+		n, err:= nameNorm.CleanEst(dirty)
+		if err !=nil {
+			n = OtherArchetype
+		}
+
+		if _, ok:= counts[start.Unix()]; !ok {
+			starts = append(starts, start)
+			counts[start.Unix()] = make(map[string]int64)
+		}
+		counts[start.Unix()][n]+= decks
+		totals[n]+= decks
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	kept:= topArchetypes(totals, top)
+
+	periods:= make([]*deckData.MetaPeriod, 0, len(starts))
+	for _, start:= range starts{
+		p:= &deckData.MetaPeriod{Start: deckData.Timestamp(start)}
+
+		folded:= make(map[string]int64)
+		for n, decks:= range counts[start.Unix()]{
+			if !kept[n] {
+				n = OtherArchetype
+			}
+			folded[n]+= decks
+			p.Decks+= decks
+		}
+
+		for n, decks:= range folded{
+			p.Archetypes = append(p.Archetypes, &deckData.ArchetypeShare{
+				Archetype: n,
+				Decks: decks,
+				Share: float64(decks) / float64(p.Decks),
+			})
+		}
+		sort.Sort(byDecks(p.Archetypes))
+
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+
+}
+
+// The top most played archetypes, every archetype if top isn't positive.
+//
+// OtherArchetype is never among them.
+func topArchetypes(totals map[string]int64, top int) map[string]bool {
+
+	shares:= make([]*deckData.ArchetypeShare, 0, len(totals))
+	for n, decks:= range totals{
+		if n == OtherArchetype {
+			continue
+		}
+		shares = append(shares,
+			&deckData.ArchetypeShare{Archetype: n, Decks: decks})
+	}
+	sort.Sort(byDecks(shares))
+
+	if top > 0 && top < len(shares) {
+		shares = shares[:top]
+	}
+
+	kept:= make(map[string]bool)
+	for _, s:= range shares{
+		kept[s.Archetype] = true
+	}
+
+	return kept
+
+}
+
+// Sorts archetypes most played first, then by name, with
+// OtherArchetype last regardless
+type byDecks []*deckData.ArchetypeShare
+
+func (a byDecks) Len() int { return len(a) }
+func (a byDecks) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byDecks) Less(i, j int) bool {
+	if (a[i].Archetype == OtherArchetype) != (a[j].Archetype == OtherArchetype) {
+		return a[j].Archetype == OtherArchetype
+	}
+	if a[i].Decks != a[j].Decks {
+		return a[i].Decks > a[j].Decks
+	}
+	return a[i].Archetype < a[j].Archetype
+}
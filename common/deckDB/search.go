@@ -0,0 +1,204 @@
+package deckDB
+
+import(
+
+	"./deckData"
+	"./nameNorm"
+	"github.com/jackc/pgx"
+
+	"fmt"
+	"time"
+
+)
+
+// Boards a search may look for cards in
+const(
+	BoardAny string = "any"
+	BoardMain string = "main"
+	BoardSide string = "side"
+)
+
+// The most decks a single page of results may hold.
+const MaxSearchPage int = 100
+
+var ErrBadBoard error = fmt.Errorf("unknown board")
+
+// Whether the provided board is one searches look in.
+func ValidBoard(board string) bool {
+	return board == BoardAny || board == BoardMain || board == BoardSide
+}
+
+// Narrows which decks a search finds.
+//
+// Zero values match everything.
+type DeckSearch struct{
+	// Cards every deck must play and cards none may play
+	Include, Exclude []string
+	// Which board each of Include and Exclude look in, BoardAny
+	// when empty
+	IncludeBoard, ExcludeBoard string
+
+	// A name standardized by nameNorm
+	Archetype string
+	// Matches a substring of the player's name regardless of case
+	Player string
+	EventID string
+	From, To time.Time
+
+	Limit, Offset int
+}
+
+// Returns a page of decks from major events matching the search,
+// most recent first.
+//
+// Decks are named by their contents as events name theirs, those we
+// can't name are given OtherArchetype. When the search asks for an
+// archetype every deck is given that name.
+func SearchDecks(pool *pgx.ConnPool,
+	q DeckSearch) ([]*deckData.DeckSummary, error) {
+
+	includeSide, err:= boardFilter(q.IncludeBoard)
+	if err!=nil {
+		return nil, err
+	}
+	excludeSide, err:= boardFilter(q.ExcludeBoard)
+	if err!=nil {
+		return nil, err
+	}
+
+	if q.Limit < 1 || q.Limit > MaxSearchPage {
+		q.Limit = MaxSearchPage
+	}
+
+	include:= append([]string{}, q.Include...)
+	exclude:= append([]string{}, q.Exclude...)
+
+	// NULL accepts every archetype
+	var archetypes, presentCards, excludedCards interface{}
+	if q.Archetype != "" {
+		a, p, e, err:= nameNorm.Invert(q.Archetype)
+		if err!=nil {
+			return nil, err
+		}
+		archetypes, presentCards, excludedCards = a, p, e
+	}
+
+	rows, err := pool.Query(deckSearch, include, exclude,
+		includeSide, excludeSide,
+		archetypes, excludedCards, presentCards,
+		containsPattern(q.Player), q.EventID,
+		optionalTime(q.From), optionalTime(q.To),
+		q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decks:= make([]*deckData.DeckSummary, 0)
+	for rows.Next() {
+		d:= deckData.DeckSummary{}
+		var low, high int64
+		var t time.Time
+
+		err = rows.Scan(&d.Name, &d.DeckID, &d.Player,
+			&low, &high, &d.Record,
+			&d.EventID, &d.EventName, &t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		d.RankLow, d.RankHigh = int(low), int(high)
+		d.Happened = deckData.Timestamp(t)
+
+		decks = append(decks, &d)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	// Every deck found already belongs to the archetype asked for
+	if q.Archetype != "" {
+		for _, d:= range decks{
+			d.Name = q.Archetype
+		}
+		return decks, nil
+	}
+
+	err = nameSummaries(pool, decks)
+	if err != nil {
+		return nil, err
+	}
+
+	return decks, nil
+
+}
+
+// Names summaries by their contents as GetEvent names its decks,
+// those we can't name are given OtherArchetype.
+func nameSummaries(pool *pgx.ConnPool,
+	summaries []*deckData.DeckSummary) error {
+
+	ids:= make([]string, 0, len(summaries))
+	byID:= make(map[string]*deckData.Deck)
+	for _, s:= range summaries{
+		ids = append(ids, s.DeckID)
+		byID[s.DeckID] = &deckData.Deck{DeckID: s.DeckID, Name: s.Name}
+	}
+
+	rows, err := pool.Query(deckSearchCards, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c:= deckData.Card{}
+		var parent string
+		var sideboard bool
+
+		err = rows.Scan(&parent, &c.Name, &c.Quantity, &sideboard)
+		if err != nil {
+			return fmt.Errorf("failed to scan row: %v", err)
+		}
+
+		d, ok:= byID[parent]
+		if !ok {
+			continue
+		}
+
+		if sideboard {
+			d.Sideboard = append(d.Sideboard, &c)
+		}else{
+			d.Maindeck = append(d.Maindeck, &c)
+		}
+	}
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	for _, s:= range summaries{
+		d:= byID[s.DeckID]
+		err = nameNorm.Clean(d)
+		if err != nil {
+			d.Name = OtherArchetype
+		}
+		s.Name = d.Name
+	}
+
+	return nil
+
+}
+
+// Translates a board into whether cards must be in the sideboard,
+// NULL when either board will do.
+func boardFilter(board string) (interface{}, error) {
+
+	if board == "" || board == BoardAny {
+		return nil, nil
+	}
+	if !ValidBoard(board) {
+		return nil, ErrBadBoard
+	}
+
+	return board == BoardSide, nil
+
+}
@@ -88,6 +88,22 @@ CREATE TABLE mtgtop8.decks (
 
 	parent TEXT NOT NULL REFERENCES mtgtop8.events(eventid),
 
+	/*
+		Where the deck finished as a band, 3 and 4 for a 3-4 tie,
+		and its record such as 6-2-1. NULL when mtgtop8 didn't say.
+
+		Decks written before placements were recorded are all NULL,
+		existing deployments can migrate with
+
+		ALTER TABLE mtgtop8.decks
+			ADD COLUMN rankLow INT,
+			ADD COLUMN rankHigh INT,
+			ADD COLUMN record TEXT;
+	*/
+	rankLow INT,
+	rankHigh INT,
+	record TEXT,
+
 	/* There can only be one of a single deck! */
 	CONSTRAINT uniqueDecksKey UNIQUE (deckid)
 );
@@ -3,9 +3,11 @@
 Adds a new deck with all of its necessary metadata to
 mtgtop8.decks
 
+Placement, $5 through $7, is NULL when unknown
+
 */
 
 INSERT INTO mtgtop8.decks
-(name, player, deckid, parent)
+(name, player, deckid, parent, rankLow, rankHigh, record)
 values
-($1, $2, $3, $4)
\ No newline at end of file
+($1, $2, $3, $4, $5, $6, $7)
\ No newline at end of file
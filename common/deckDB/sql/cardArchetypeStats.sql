@@ -0,0 +1,34 @@
+/*
+
+Returns, for each mtgtop8 archetype a card has appeared in at major
+events, how many decks of that archetype there were, how many of them
+played the card, and how many copies they played in the maindeck and
+sideboard.
+
+Takes
+	$1 the full, normalized name of the card
+
+*/
+
+with majors as (
+	select * from mtgtop8.major_events()
+),
+playing as (
+	select parent,
+		sum(case when sideboard then 0 else quantity end) as maindeck,
+		sum(case when sideboard then quantity else 0 end) as sideboard
+	from mtgtop8.cards
+	where
+		name = $1
+	group by parent
+)
+
+select decks.name, count(*)::bigint, count(playing.parent)::bigint,
+	coalesce(sum(playing.maindeck), 0)::bigint,
+	coalesce(sum(playing.sideboard), 0)::bigint
+from mtgtop8.decks decks
+	left join playing on playing.parent = decks.deckid
+	where
+		decks.parent in (select * from majors)
+group by decks.name
+having count(playing.parent) > 0;
\ No newline at end of file
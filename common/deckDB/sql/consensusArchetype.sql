@@ -0,0 +1,24 @@
+/*
+
+Returns every card in every deck of an archetype placing at major
+events which happened within a window.
+
+Takes
+	$1 array of normalized mtgtop8 archetype names
+	$2 array of card names to explicitly require not be present in decks
+	$3 array of card names to explicitly require in decks, if 'Default'
+	   is present inside the array then the filter is ignored.
+	$4 the start of the window, inclusive
+	$5 the end of the window, exclusive
+
+*/
+
+select cards.parent, cards.name, cards.quantity::bigint, cards.sideboard
+from mtgtop8.cards cards
+	join mtgtop8.decks decks on decks.deckid = cards.parent
+	join mtgtop8.events events on events.eventid = decks.parent
+	where
+		cards.parent in
+			(select * from mtgtop8.archetype_decks($1, $2, $3))
+	and
+		events.happened >= $4 and events.happened < $5;
\ No newline at end of file
@@ -0,0 +1,12 @@
+/*
+
+Returns the contents of every deck that placed in an event given its
+eventid
+
+*/
+
+select cards.parent, cards.name, cards.quantity::bigint, cards.sideboard
+from mtgtop8.cards cards
+	join mtgtop8.decks decks on decks.deckid = cards.parent
+	where
+		decks.parent = $1;
\ No newline at end of file
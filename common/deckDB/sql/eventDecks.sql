@@ -0,0 +1,12 @@
+/*
+
+Returns the metadata of every deck that placed in an event given its
+eventid, best finishes first
+
+*/
+
+select deckid, player, name,
+	coalesce(rankLow, 0)::bigint, coalesce(rankHigh, 0)::bigint,
+	coalesce(record, '')
+from mtgtop8.decks where parent=$1
+order by rankLow nulls last, deckid;
\ No newline at end of file
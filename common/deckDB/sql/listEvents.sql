@@ -0,0 +1,24 @@
+/*
+
+Returns major events, most recent first.
+
+Takes
+	$1 the earliest an event may have happened, NULL for no limit
+	$2 the time events must have happened before, NULL for no limit
+	$3 ILIKE pattern the event's name must match
+	$4 the most events to return
+	$5 how many matching events to skip
+
+*/
+
+select name, eventid, happened from mtgtop8.events
+	where
+		eventid in (select * from mtgtop8.major_events())
+	and
+		($1::timestamp is null or happened >= $1::timestamp)
+	and
+		($2::timestamp is null or happened < $2::timestamp)
+	and
+		name ilike $3::text
+order by happened desc, eventid desc
+limit $4 offset $5;
\ No newline at end of file
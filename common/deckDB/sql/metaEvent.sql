@@ -0,0 +1,11 @@
+/*
+
+Returns the metadata of a major event given its eventid
+
+*/
+
+select name, happened from mtgtop8.events
+	where
+		eventid = $1
+	and
+		eventid in (select * from mtgtop8.major_events());
\ No newline at end of file
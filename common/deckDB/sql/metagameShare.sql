@@ -0,0 +1,22 @@
+/*
+
+Returns how many decks of each mtgtop8 archetype placed at major events
+within each period of a range, oldest period first.
+
+Takes
+	$1 the period to bucket by, 'week' or 'month'
+	$2 the start of the range, inclusive
+	$3 the end of the range, exclusive
+
+*/
+
+select date_trunc($1::text, events.happened) as period,
+	decks.name, count(*)::bigint
+from mtgtop8.decks decks
+	join mtgtop8.events events on events.eventid = decks.parent
+	where
+		events.eventid in (select * from mtgtop8.major_events())
+	and
+		events.happened >= $2 and events.happened < $3
+group by period, decks.name
+order by period;
\ No newline at end of file
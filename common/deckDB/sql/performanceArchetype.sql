@@ -0,0 +1,26 @@
+/*
+
+Returns how well an archetype finished at major events which happened
+within a window.
+
+Takes
+	$1 array of normalized mtgtop8 archetype names
+	$2 array of card names to explicitly require not be present in decks
+	$3 array of card names to explicitly require in decks, if 'Default'
+	   is present inside the array then the filter is ignored.
+	$4 the start of the window, inclusive
+	$5 the end of the window, exclusive
+
+*/
+
+select count(*)::bigint, count(decks.rankLow)::bigint,
+	coalesce(sum(case when decks.rankHigh <= 8 then 1 else 0 end), 0)::bigint,
+	coalesce(sum(case when decks.rankHigh = 1 then 1 else 0 end), 0)::bigint,
+	coalesce(avg((decks.rankLow + decks.rankHigh) / 2.0), 0)::float8
+from mtgtop8.decks decks
+	join mtgtop8.events events on events.eventid = decks.parent
+	where
+		decks.deckid in
+			(select * from mtgtop8.archetype_decks($1, $2, $3))
+	and
+		events.happened >= $4 and events.happened < $5;
\ No newline at end of file
@@ -0,0 +1,8 @@
+/*
+
+Returns the contents of every deck given an array of their deckids
+
+*/
+
+select parent, name, quantity::bigint, sideboard from mtgtop8.cards
+	where parent = any($1::text[]);
\ No newline at end of file
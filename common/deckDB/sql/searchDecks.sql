@@ -0,0 +1,67 @@
+/*
+
+Returns decks from major events matching a search, most recent first.
+
+Takes
+	$1 array of card names every deck must play
+	$2 array of card names no deck may play
+	$3 which board $1 is looked for in, true for sideboard,
+	   false for maindeck, NULL for either
+	$4 which board $2 is looked for in, as $3
+	$5 array of normalized mtgtop8 archetype names, NULL for any
+	$6 array of card names to explicitly require not be present in
+	   the archetype's decks
+	$7 array of card names to explicitly require in the archetype's
+	   decks, if 'Default' is present inside the array then the filter
+	   is ignored.
+	$8 ILIKE pattern the player's name must match
+	$9 eventid the deck placed in, empty for any
+	$10 the earliest the event may have happened, NULL for no limit
+	$11 the time the event must have happened before, NULL for no limit
+	$12 the most decks to return
+	$13 how many matching decks to skip
+
+*/
+
+select decks.name, decks.deckid, decks.player,
+	coalesce(decks.rankLow, 0)::bigint, coalesce(decks.rankHigh, 0)::bigint,
+	coalesce(decks.record, ''),
+	events.eventid, events.name, events.happened
+from mtgtop8.decks decks
+	join mtgtop8.events events on events.eventid = decks.parent
+	where
+		events.eventid in (select * from mtgtop8.major_events())
+	and
+		/* Every card we want is present */
+		not exists
+			(
+				select unnest($1::text[])
+				except
+				select name from mtgtop8.cards cards
+					where cards.parent = decks.deckid and
+					($3::boolean is null or cards.sideboard = $3::boolean)
+			)
+	and
+		/* No card we don't want is present */
+		not exists
+			(
+				select unnest($2::text[])
+				intersect
+				select name from mtgtop8.cards cards
+					where cards.parent = decks.deckid and
+					($4::boolean is null or cards.sideboard = $4::boolean)
+			)
+	and
+		($5::text[] is null or decks.deckid in
+			(select * from mtgtop8.archetype_decks($5::text[],
+				$6::text[], $7::text[])))
+	and
+		decks.player ilike $8::text
+	and
+		($9::text = '' or events.eventid = $9::text)
+	and
+		($10::timestamp is null or events.happened >= $10::timestamp)
+	and
+		($11::timestamp is null or events.happened < $11::timestamp)
+order by events.happened desc, decks.deckid desc
+limit $12 offset $13;
\ No newline at end of file
@@ -66,7 +66,9 @@ func insertDeck(tx *pgx.Tx, d *deckData.Deck, EventID string) (err error) {
 
 	// Insert the meta row
 	_, err = tx.Exec(deckInsert, d.Name, d.Player,
-		d.DeckID, EventID)
+		d.DeckID, EventID,
+		optionalRank(d.RankLow), optionalRank(d.RankHigh),
+		optionalRecord(d.Record))
 	if err!=nil {
 		return err
 	}
@@ -111,4 +113,22 @@ func insertCard(tx *pgx.Tx, c *deckData.Card,
 
 	return err
 
+}
+
+// A rank to store, NULL when unknown
+func optionalRank(rank int) interface{} {
+	if rank < 1 {
+		return nil
+	}
+
+	return rank
+}
+
+// A record to store, NULL when unknown
+func optionalRecord(record string) interface{} {
+	if record == "" {
+		return nil
+	}
+
+	return record
 }
\ No newline at end of file
@@ -49,7 +49,7 @@ func FetchEvent(id string) (*deckData.Event, error) {
 	}
 
 	// Deck ids for easier fetching
-	ids, err:= eventDecks(doc)
+	placements, err:= eventDecks(doc)
 	if err!=nil {
 		return nil, fmt.Errorf("failed to read decks", err)
 	}
@@ -58,13 +58,15 @@ func FetchEvent(id string) (*deckData.Event, error) {
 	// Fetch each decklist
 	decks:= make([]*deckData.Deck, 0)
 
-	for _, dID:= range ids{
-		d, err:= FetchDeck(dID)
+	for _, p:= range placements{
+		d, err:= FetchDeck(p.id)
 		if err!=nil {
 			continue
 		}
 
-		d.DeckID = dID
+		d.DeckID = p.id
+		d.RankLow, d.RankHigh = p.rankLow, p.rankHigh
+		d.Record = p.record
 
 		decks = append(decks, d)
 	}
@@ -124,10 +126,10 @@ func eventTime(doc *gq.Document) (time.Time, error) {
 
 // Finds all decks in a specific event
 //
-// Returns ids and corrresponding mtgtop8 deck names
-func eventDecks(doc *gq.Document) ([]string, error) {
+// Returns ids and where each deck finished
+func eventDecks(doc *gq.Document) ([]placement, error) {
 
-	ids:= make([]string, 0)
+	placements:= make([]placement, 0)
 
 	sel:= selectEventAnchors(doc)
 
@@ -142,25 +144,27 @@ func eventDecks(doc *gq.Document) ([]string, error) {
 			return
 		}
 
-		id:= u.Query().Get("d")
-		ids = append(ids, id)
+		p:= placement{id: u.Query().Get("d")}
+		p.rankLow, p.rankHigh, p.record = anchorPlacement(a)
+
+		placements = append(placements, p)
 	})
 
 	// Optional decks can fail but are nice to have
-	optIds, err:= optionalDecks(doc)
+	optPlacements, err:= optionalDecks(doc)
 	if err == nil {
-		ids = append(ids, optIds...)
+		placements = append(placements, optPlacements...)
 	}
 
-	return ids, nil
+	return placements, nil
 }
 
 // Finds all decks which appear for large but not small events
 //
-// Also returns deck names
-func optionalDecks(doc *gq.Document) ([]string, error) {
+// Their finish leads each option when known, such as '#9-16 Burn'
+func optionalDecks(doc *gq.Document) ([]placement, error) {
 
-	ids:= make([]string, 0)
+	placements:= make([]placement, 0)
 
 	sel:= doc.Find("[name=sel_deck]").Find("optgroup").Find("option")
 	if sel.Length() == 0 {
@@ -174,8 +178,14 @@ func optionalDecks(doc *gq.Document) ([]string, error) {
 			return
 		}
 
-		ids = append(ids, id)
+		p:= placement{id: id}
+		fields:= strings.Fields(o.Text())
+		if len(fields) > 0 {
+			p.rankLow, p.rankHigh, _ = parseRank(fields[0])
+		}
+
+		placements = append(placements, p)
 	})
 
-	return ids, nil
+	return placements, nil
 }
\ No newline at end of file
@@ -0,0 +1,96 @@
+package main
+
+import(
+
+	gq "github.com/PuerkitoBio/goquery"
+
+	"strconv"
+	"strings"
+
+	"regexp"
+
+)
+
+// Match a finish such as 1 or a tied band such as 3-4
+var rankPattern = regexp.MustCompile(`^#?([0-9]+)(?:-([0-9]+))?$`)
+
+// Match a record such as (6-2) or (6-2-1)
+var recordPattern = regexp.MustCompile(`\(([0-9]+-[0-9]+(?:-[0-9]+)?)\)`)
+
+// How many elements out from an anchor we look for its finish,
+// any further and we'd find the finish of another deck
+const placementDepth int = 3
+
+// Where a deck finished in an event, zero ranks when unknown
+type placement struct{
+	id string
+
+	rankLow, rankHigh int
+	record string
+}
+
+// Parses a finish into the band of ranks it covers
+//
+// A lone rank covers only itself
+func parseRank(raw string) (low, high int, ok bool) {
+
+	match:= rankPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if len(match) == 0 {
+		return 0, 0, false
+	}
+
+	low, err:= strconv.Atoi(match[1])
+	if err!=nil {
+		return 0, 0, false
+	}
+
+	high = low
+	if match[2] != "" {
+		high, err = strconv.Atoi(match[2])
+		if err!=nil {
+			return 0, 0, false
+		}
+	}
+
+	// Nobody finishes zeroth and bands don't run backwards
+	if low < 1 || high < low {
+		return 0, 0, false
+	}
+
+	return low, high, true
+}
+
+// Finds the placement of the deck a given anchor links to
+//
+// mtgtop8 places the finish in its own element beside the anchor
+// so we walk out from the anchor until a sibling holds one. The
+// record, when present, sits somewhere in that same row.
+func anchorPlacement(a *gq.Selection) (low, high int, record string) {
+
+	parents:= a.Parents()
+	if parents.Length() > placementDepth {
+		parents = parents.Slice(0, placementDepth)
+	}
+
+	parents.EachWithBreak(func(i int, p *gq.Selection) bool{
+
+		p.Children().EachWithBreak(func(j int, c *gq.Selection) bool{
+			var ok bool
+			low, high, ok = parseRank(c.Text())
+			return !ok
+		})
+
+		if low == 0 {
+			return true
+		}
+
+		match:= recordPattern.FindStringSubmatch(p.Text())
+		if len(match) > 0 {
+			record = match[1]
+		}
+
+		return false
+	})
+
+	return
+}
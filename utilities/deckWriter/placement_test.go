@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	"strings"
+
+	gq "github.com/PuerkitoBio/goquery"
+)
+
+func TestParseRank(t *testing.T) {
+
+	cases:= []struct{
+		raw string
+		low, high int
+		ok bool
+	}{
+		{"1", 1, 1, true},
+		{" 3-4\n", 3, 4, true},
+		{"#9-16", 9, 16, true},
+		{"0", 0, 0, false},
+		{"4-3", 0, 0, false},
+		{"Burn", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, c:= range cases{
+		low, high, ok:= parseRank(c.raw)
+		if low != c.low || high != c.high || ok != c.ok {
+			t.Error("bad rank", c.raw, low, high, ok)
+		}
+	}
+
+}
+
+// Event rows as mtgtop8 lays them out, the last missing its finish
+const placementPage string = `<html><body><table>
+<tr><td><div>
+	<div class="S14">1</div>
+	<div class="S14"><a href="event?e=1&d=10&f=MO">Burn</a> (6-2-1)</div>
+	<div class="G11">Someone</div>
+</div></td></tr>
+<tr><td><div>
+	<div class="S14">3-4</div>
+	<div class="S14"><a href="event?e=1&d=11&f=MO">Affinity</a></div>
+</div></td></tr>
+<tr><td><div>
+	<div class="S14"><a href="event?e=1&d=12&f=MO">Tron</a></div>
+</div></td></tr>
+</table></body></html>`
+
+func TestAnchorPlacement(t *testing.T) {
+
+	doc, err:= gq.NewDocumentFromReader(strings.NewReader(placementPage))
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	placements, err:= eventDecks(doc)
+	if err!=nil {
+		t.Fatal(err)
+	}
+
+	expected:= []placement{
+		{id: "10", rankLow: 1, rankHigh: 1, record: "6-2-1"},
+		{id: "11", rankLow: 3, rankHigh: 4},
+		{id: "12"},
+	}
+	if len(placements) != len(expected) {
+		t.Fatal("wrong number of decks", placements)
+	}
+	for i, p:= range placements{
+		if p != expected[i] {
+			t.Error("bad placement", p, expected[i])
+		}
+	}
+
+}